@@ -0,0 +1,41 @@
+package gohtml
+
+import "strings"
+
+// Return adversarial inputs of about n bytes each, keyed by name, for
+// benchmarking that parsing takes time linear in the size of its input.  Each
+// repeats a pattern found by fuzzing to make lexing or entity expansion scan
+// the same bytes over and over, e.g. a run of '&' or many "<?" without "?>",
+// or to make building the tree search the open elements over and over, e.g.
+// many unmatched closing tags.  Parsing any of them at sizes n and 2n should
+// take about twice as long.
+func AdversarialInputs(n int) map[string][]byte {
+	return map[string][]byte{
+		"ampersands":            repeatTo("", "&", "", n),
+		"empty-references":      repeatTo("", "&;", "", n),
+		"numeric-prefixes":      repeatTo("", "&#x", "", n),
+		"legacy-prefixes":       repeatTo("", "&notit", "", n),
+		"named-prefixes":        repeatTo("", "&CounterClockwiseContourIntegra ", "", n),
+		"less-thans":            repeatTo("", "<", "", n),
+		"bogus-declarations":    repeatTo("", "<!", "", n),
+		"unclosed-conditionals": repeatTo("", "<!--[if a]>-->", "", n),
+		"unclosed-openings":     repeatTo("", "<!--[if a -->", "", n),
+		"unclosed-pis":          repeatTo("", "<?a>", "", n),
+		"attributes":            repeatTo("<a ", "x ", ">", n),
+		"duplicate-attributes":  repeatTo("<a ", "x=&; ", ">", n),
+		"attribute-references":  repeatTo("<a x='", "&;", "'>", n),
+		"misnested-closings":    repeatTo("", "<b></i>", "", n),
+		"special-closings":      repeatTo("", "<div></p>", "", n),
+		"blocked-closings":      repeatTo("<i><div>", "<b></i>", "", n),
+		"fostered-text":         repeatTo("", "<table>x", "", n),
+		"fostered-elements":     repeatTo("", "<table><i>x</b>", "", n),
+		"fostered-misnestings":  repeatTo("<table><tr>", "<b></i>", "", n),
+		"nested-elements":       repeatTo("", "<div>\n", "", n),
+	}
+}
+
+// Repeat unit between prefix and suffix to about n bytes in all.
+func repeatTo(prefix, unit, suffix string, n int) []byte {
+	count := max((n-len(prefix)-len(suffix))/len(unit), 1)
+	return []byte(prefix + strings.Repeat(unit, count) + suffix)
+}
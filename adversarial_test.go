@@ -0,0 +1,39 @@
+package gohtml
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Benchmark parsing AdversarialInputs at two sizes, whose MB/s should be about
+// equal if parsing is linear.  Timings are too noisy on a loaded machine to
+// assert on in a test.
+func BenchmarkAdversarialInputs(b *testing.B) {
+	for _, n := range []int{16 << 10, 32 << 10} {
+		for name, data := range AdversarialInputs(n) {
+			b.Run(fmt.Sprintf("%s/%d", name, n), func(b *testing.B) {
+				b.SetBytes(int64(len(data)))
+				for range b.N {
+					Parse(data)
+				}
+			})
+		}
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	for _, data := range AdversarialInputs(64) {
+		f.Add(data)
+	}
+	f.Add([]byte("<table>oops<tr><td>x</table>"))
+	f.Add([]byte("<b><i>x</b>y</i>"))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		node, err, _ := Parse(data)
+		if node == nil {
+			t.Fatalf("Parse(%q) returned a nil node", data)
+		} else if err == nil {
+			node.RenderBytes()
+		}
+	})
+}
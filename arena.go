@@ -0,0 +1,76 @@
+package gohtml
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// Number of nodes per slab allocated by an arena.
+const arenaSlabSize = 256
+
+// Slabs of nodes released by arenas, for reuse.
+var arenaSlabs = sync.Pool{
+	New: func() any {
+		slab := make([]Node, arenaSlabSize)
+		return &slab
+	},
+}
+
+// Allocator of the nodes of a document from slabs, which are freed together
+// by Node.Release.  A nil *arena allocates each node separately.
+type arena struct {
+	slabs []*[]Node
+	used  int // number of nodes used from the last slab
+
+	// Whether node contents may reference the input rather than copies of
+	// it, as for ParseFile with ParseOptions.FileViews, which keeps the input
+	// until the nodes are freed.
+	views bool
+}
+
+// Allocate a zero node.
+func (a *arena) node() *Node {
+	if a == nil {
+		return &Node{}
+	}
+
+	if len(a.slabs) == 0 || a.used == arenaSlabSize {
+		a.slabs = append(a.slabs, arenaSlabs.Get().(*[]Node))
+		a.used = 0
+	}
+	node := &(*a.slabs[len(a.slabs)-1])[a.used]
+	a.used++
+	return node
+}
+
+// Make a string of b, which is part of the input or made from it: a view of b
+// if the arena's nodes may reference the input, or else a copy.
+func (a *arena) string(b []byte) string {
+	if a == nil || !a.views || len(b) == 0 {
+		return string(b)
+	}
+	return unsafe.String(&b[0], len(b))
+}
+
+// Free all nodes allocated by the arena for reuse.
+func (a *arena) release() {
+	for _, slab := range a.slabs {
+		clear(*slab)
+		arenaSlabs.Put(slab)
+	}
+	a.slabs = nil
+	a.used = 0
+}
+
+// Free the nodes of a document parsed with ParseOptions.Arena all at once,
+// for reuse by later parses.  The document is left empty; no node that was in
+// it may be used afterwards, including nodes moved out of it, as their memory
+// may already belong to another document.  Does nothing for any other node.
+func (node *Node) Release() {
+	if node.arena == nil {
+		return
+	}
+	node.arena.release()
+	node.arena = nil
+	node.Children = nil
+}
@@ -0,0 +1,48 @@
+package gohtml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestArena(t *testing.T) {
+	tests := []string{
+		"<p>a &amp; b</p>",
+		strings.Repeat("<div><p>x</p><!--c--></div>", arenaSlabSize),
+		"<table><tr><td>1</td></tr></table><svg><circle r='1'/></svg>",
+	}
+
+	for _, src := range tests {
+		want, _, _ := Parse([]byte(src))
+		node, err, _ := ParseWithOptions([]byte(src), WithArena())
+		if err != nil {
+			t.Fatalf("Parse(%.40q) with arena error: %v", src, err)
+		}
+		if got, want := rendered(t, node), rendered(t, want); got != want {
+			t.Errorf("Parse(%.40q) with arena = %.40q, want %.40q", src, got, want)
+		}
+		checkParents(t, node)
+		if node.arena == nil {
+			t.Fatalf("Parse(%.40q) with arena: document has no arena", src)
+		}
+
+		node.Release()
+		if node.arena != nil || node.Children != nil {
+			t.Errorf("Parse(%.40q) with arena: Release left %d children", src, len(node.Children))
+		}
+		node.Release()
+	}
+}
+
+func TestReleaseWithoutArena(t *testing.T) {
+	src := "<p>a</p><p>b</p>"
+	node, _, _ := Parse([]byte(src))
+	node.Release()
+	if got := len(node.Children); got != 2 {
+		t.Errorf("Release of %q parsed without arena left %d children, want 2", src, got)
+	}
+	node.Children[0].Release()
+	if got := rendered(t, node); got != src {
+		t.Errorf("Release of %q parsed without arena = %q, want %q", src, got, src)
+	}
+}
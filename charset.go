@@ -0,0 +1,133 @@
+package gohtml
+
+import (
+	"bytes"
+	"strings"
+)
+
+var (
+	utf8BOM    = []byte("\xef\xbb\xbf")
+	utf16BEBOM = []byte("\xfe\xff")
+	utf16LEBOM = []byte("\xff\xfe")
+	metaStart  = []byte("<meta")
+)
+
+// Number of bytes at the start of a document searched for a <meta> element
+// declaring its character encoding.
+const charsetPrescanLen = 1024
+
+// Detect the character encoding of an HTML document, per the WHATWG HTML
+// standard: from its byte order mark, if any, or else from a <meta charset>
+// or <meta http-equiv="Content-Type"> element within its first 1024 bytes.
+// Returns the encoding's lowercase label (e.g. "utf-8" or "windows-1252"), or
+// "" if the document doesn't declare one.
+func DetectCharset(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, utf8BOM):
+		return "utf-8"
+	case bytes.HasPrefix(data, utf16BEBOM):
+		return "utf-16be"
+	case bytes.HasPrefix(data, utf16LEBOM):
+		return "utf-16le"
+	}
+
+	data = data[:min(len(data), charsetPrescanLen)]
+	for pos := 0; pos < len(data); {
+		i := bytes.IndexByte(data[pos:], '<')
+		if i < 0 {
+			break
+		}
+		pos += i
+		rest := data[pos:]
+
+		if bytes.HasPrefix(rest, commentStart) {
+			end := bytes.Index(rest[len(commentStart):], commentEnd)
+			if end < 0 {
+				break
+			}
+			pos += len(commentStart) + end + len(commentEnd)
+			continue
+		} else if !hasTagPrefix(rest, metaStart) {
+			pos++
+			continue
+		}
+
+		tok, loc, err := lexTagOpen(data, Location{Pos: pos})
+		if err != nil {
+			break
+		}
+		pos = loc.Pos
+		if node, err, _ := parseOpenTag(tok, 0, nil); err == nil {
+			if charset := metaCharset(node); charset != "" {
+				return charset
+			}
+		}
+	}
+
+	return ""
+}
+
+// Character encoding label declared by a <meta> element, or "" if it doesn't
+// declare one.
+func metaCharset(node *Node) string {
+	if charset, ok := attrFold(node, "charset"); ok {
+		return normalizeCharset(charset)
+	}
+
+	httpEquiv, _ := attrFold(node, "http-equiv")
+	if !strings.EqualFold(strings.TrimSpace(httpEquiv), "content-type") {
+		return ""
+	}
+	content, _ := attrFold(node, "content")
+	return normalizeCharset(contentTypeCharset(content))
+}
+
+// Charset parameter of a Content-Type value, e.g. "utf-8" for
+// "text/html; charset=utf-8", or "" if there is none.
+func contentTypeCharset(content string) string {
+	i := strings.Index(strings.ToLower(content), "charset")
+	if i < 0 {
+		return ""
+	}
+	rest := strings.TrimLeftFunc(content[i+len("charset"):], isSpaceR)
+	if !strings.HasPrefix(rest, "=") {
+		return contentTypeCharset(rest)
+	}
+	rest = strings.TrimLeftFunc(rest[1:], isSpaceR)
+
+	if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+		charset, _, found := strings.Cut(rest[1:], rest[:1])
+		if !found {
+			return ""
+		}
+		return charset
+	}
+	end := strings.IndexFunc(rest, func(r rune) bool {
+		return isSpaceR(r) || r == ';'
+	})
+	if end >= 0 {
+		rest = rest[:end]
+	}
+	return rest
+}
+
+// Normalize an encoding label declared by a <meta> element.  Declarations of
+// UTF-16 are treated as UTF-8, since a document that could declare it isn't
+// actually UTF-16 encoded.
+func normalizeCharset(label string) string {
+	label = strings.ToLower(strings.TrimFunc(label, isSpaceR))
+	switch label {
+	case "unicode-1-1-utf-8", "unicode11utf8", "unicode20utf8", "utf8", "x-unicode20utf8",
+		"utf-16", "utf-16be", "utf-16le", "unicode", "unicodefffe", "csunicode", "iso-10646-ucs-2", "ucs-2":
+		return "utf-8"
+	case "x-user-defined":
+		return "windows-1252"
+	}
+	return label
+}
+
+// Whether a charset label returned by DetectCharset denotes UTF-8, as the
+// parser assumes input to be; an empty label does, by default.
+func isUTF8Charset(label string) bool {
+	return label == "" || label == "utf-8"
+}
@@ -0,0 +1,57 @@
+package gohtml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDetectCharset(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"\xef\xbb\xbf<meta charset=latin1>", "utf-8"},
+		{"\xfe\xff\x00<", "utf-16be"},
+		{"\xff\xfe<\x00", "utf-16le"},
+		{`<meta charset="Windows-1252">`, "windows-1252"},
+		{`<META CHARSET=shift_jis>`, "shift_jis"},
+		{`<meta http-equiv="Content-Type" content="text/html; charset=iso-8859-2">`, "iso-8859-2"},
+		{`<meta content='text/html;charset="koi8-r"' http-equiv=content-type>`, "koi8-r"},
+		{`<meta http-equiv=refresh content="charset=koi8-r">`, ""},
+		{`<!-- <meta charset=big5> --><meta charset=euc-kr>`, "euc-kr"},
+		{`<meta name=x><meta charset=gbk>`, "gbk"},
+		{`<meta charset=utf-16>`, "utf-8"},
+		{`<meta charset=x-user-defined>`, "windows-1252"},
+		{`<metadata charset=big5>`, ""},
+		{"<p>" + strings.Repeat("x", 1024) + "<meta charset=big5>", ""},
+		{"<p>no declaration</p>", ""},
+	}
+
+	for _, test := range tests {
+		if got := DetectCharset([]byte(test.in)); got != test.want {
+			t.Errorf("DetectCharset(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestParseDetectedCharset(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<meta charset=windows-1252><p>x", "windows-1252"},
+		{"\xef\xbb\xbf<p>x", "utf-8"},
+		{"<p>x", ""},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if doc.Charset != test.want {
+			t.Errorf("Parse(%q).Charset = %q, want %q", test.in, doc.Charset, test.want)
+		}
+	}
+}
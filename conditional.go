@@ -0,0 +1,105 @@
+package gohtml
+
+import (
+	"bytes"
+	"strings"
+)
+
+var (
+	conditionalIf        = []byte("[if")
+	conditionalIfEnd     = []byte("]>")
+	conditionalReveal    = []byte("<!-->")
+	conditionalEndif     = []byte("<![endif]")
+	conditionalEnd       = []byte("<![endif]-->")
+	conditionalRevealEnd = []byte("<!--<![endif]-->")
+)
+
+// Length of the opening of a conditional comment at the offset pos in data,
+// e.g. "<!--[if mso]>" or "<!--[if !mso]><!-->", or 0 if there is none.  The
+// opening only counts if the conditional comment is closed later in data.
+// The searches ahead for the end of the opening and the closing are memoized
+// in ifEnds and endifs, if not nil.
+func conditionalStart(data []byte, pos int, ifEnds, endifs *search) int {
+	rest := data[pos:]
+	if !bytes.HasPrefix(rest, commentStart) {
+		return 0
+	}
+	rest = rest[len(commentStart):]
+	n := len(conditionalIf)
+	if len(rest) <= n || !bytes.EqualFold(rest[:n], conditionalIf) || !isSpace(rest[n]) {
+		return 0
+	}
+
+	end := ifEnds.index(data, conditionalIfEnd, pos+len(commentStart))
+	if end < 0 {
+		return 0
+	}
+	end += len(conditionalIfEnd)
+	if bytes.HasPrefix(data[end:], conditionalReveal) {
+		end += len(conditionalReveal)
+	}
+
+	if endifs.index(data, conditionalEndif, end) < 0 {
+		return 0
+	}
+	return end - pos
+}
+
+// Length of the closing of a conditional comment at the start of data, e.g.
+// "<![endif]-->" or "<!--<![endif]-->", or 0 if there is none.
+func conditionalStop(data []byte) int {
+	if bytes.HasPrefix(data, conditionalEnd) {
+		return len(conditionalEnd)
+	} else if bytes.HasPrefix(data, conditionalRevealEnd) {
+		return len(conditionalRevealEnd)
+	}
+	return 0
+}
+
+// Lex the opening of a conditional comment, which is n bytes long.  The token
+// data is the opening without the leading "<!--", e.g. "[if mso]>".
+func lexConditionalStart(data []byte, loc Location, n int) (token, Location) {
+	tok := token{Kind: conditionalStartToken, Loc: loc}
+	tok.Data = data[loc.Pos+len(commentStart) : loc.Pos+n]
+	return tok, stepN(loc, data, n)
+}
+
+// Lex the closing of a conditional comment, which is n bytes long.
+func lexConditionalStop(data []byte, loc Location, n int) (token, Location) {
+	tok := token{Kind: conditionalStopToken, Loc: loc}
+	return tok, stepN(loc, data, n)
+}
+
+func parseConditionalStart(tok token, a *arena) (*Node, error) {
+	node := a.node()
+	*node = Node{Kind: ConditionalCommentNode, Loc: tok.Loc, Children: make([]*Node, 0, 4)}
+
+	data := string(tok.Data)
+	node.Revealed = strings.HasSuffix(data, string(conditionalReveal))
+	data = data[len(conditionalIf):strings.Index(data, string(conditionalIfEnd))]
+	node.Content = strings.TrimFunc(data, isSpaceR)
+
+	return node, nil
+}
+
+// Number of the topmost open nodes that closing a conditional comment closes,
+// down to the innermost open ConditionalCommentNode, or 0 if there is none.
+func conditionalCloseCount(tags stack[*Node]) int {
+	for i := len(tags) - 1; i > 0; i-- {
+		if tags[i].Kind == ConditionalCommentNode {
+			return len(tags) - i
+		}
+	}
+	return 0
+}
+
+// Opening and closing of a conditional comment, as rendered.
+func conditionalDelims(node *Node) (open string, close string) {
+	open = "<!--[if " + node.Content + "]>"
+	close = string(conditionalEnd)
+	if node.Revealed {
+		open += string(conditionalReveal)
+		close = string(conditionalRevealEnd)
+	}
+	return open, close
+}
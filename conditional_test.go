@@ -0,0 +1,51 @@
+package gohtml
+
+import (
+	"testing"
+)
+
+func TestConditionalComments(t *testing.T) {
+	tests := []struct {
+		in       string
+		cond     string
+		revealed bool
+		inner    string
+	}{
+		{"<!--[if mso]><table><tr><td>a</td></tr></table><![endif]-->", "mso", false, "<table><tr><td>a</td></tr></table>"},
+		{"<!--[if gte mso 9]><xml><o:pixelsperinch>96</o:pixelsperinch></xml><![endif]-->", "gte mso 9", false, "<xml><o:pixelsperinch>96</o:pixelsperinch></xml>"},
+		{"<!--[if !mso]><!--><p>y</p><!--<![endif]-->", "!mso", true, "<p>y</p>"},
+		{"<!--[if IE]><![endif]-->", "IE", false, ""},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in + "x"))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		node := doc.Children[0]
+		if node.Kind != ConditionalCommentNode || node.Content != test.cond || node.Revealed != test.revealed {
+			t.Errorf("Parse(%q) = %v %q (revealed %v), want ConditionalCommentNode %q (revealed %v)",
+				test.in, node.Kind, node.Content, node.Revealed, test.cond, test.revealed)
+			continue
+		}
+		if got := string(node.InnerHTMLBytes()); got != test.inner {
+			t.Errorf("Parse(%q) inner = %q, want %q", test.in, got, test.inner)
+		}
+		if got := string(doc.RenderBytes()); got != test.in+"x" {
+			t.Errorf("Parse(%q) = %q, want it unchanged", test.in, got)
+		}
+	}
+}
+
+func TestConditionalCommentScope(t *testing.T) {
+	src := "<div><!--[if mso]><span>a</div><![endif]-->b</div>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	div := doc.Find("div")
+	if len(div.Children) != 2 || div.Children[0].Kind != ConditionalCommentNode || div.Children[1].Content != "b" {
+		t.Errorf("Parse(%q) = %q, want the closing tag within the conditional comment not to close the <div>", src, doc.RenderBytes())
+	}
+}
@@ -0,0 +1,116 @@
+package gohtml
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/transform"
+)
+
+// Transcode data in the character encoding labeled charset to UTF-8.  Also
+// returns, for each byte of the decoded data and one past its end, the offset
+// in data of the character it was decoded from.
+func decode(data []byte, charset string) (decoded []byte, offsets []int, err error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, nil, err
+	}
+	t := enc.NewDecoder()
+
+	decoded = make([]byte, 0, len(data))
+	offsets = make([]int, 0, len(data)+1)
+	var buf [64]byte
+	start := 0
+	// feed one more byte at a time, so that each character is decoded on its own
+	for end := 1; end <= len(data); end++ {
+		nDst, nSrc, err := t.Transform(buf[:], data[start:end], end == len(data))
+		if err != nil && !errors.Is(err, transform.ErrShortSrc) {
+			return nil, nil, err
+		}
+		for range nDst {
+			offsets = append(offsets, start)
+		}
+		decoded = append(decoded, buf[:nDst]...)
+		start += nSrc
+	}
+	offsets = append(offsets, len(data))
+
+	return decoded, offsets, nil
+}
+
+var replacementChar = []byte(string(utf8.RuneError))
+
+// Replace each byte of data that isn't part of a valid UTF-8 sequence with
+// U+FFFD, warning once per run of invalid bytes, with locations stepped from
+// start.  Also returns offsets the same way as decode, or nil if data is valid
+// UTF-8 and is returned as is.
+func replaceInvalidUTF8(data []byte, start Location) (valid []byte, offsets []int, warns []error) {
+	if utf8.Valid(data) {
+		return data, nil, nil
+	}
+
+	valid = make([]byte, 0, len(data)+16)
+	offsets = make([]int, 0, len(data)+17)
+	loc := start
+	for pos := 0; pos < len(data); {
+		r, size := utf8.DecodeRune(data[pos:])
+		if r != utf8.RuneError || size > 1 {
+			for i := range size {
+				offsets = append(offsets, pos+i)
+			}
+			valid = append(valid, data[pos:pos+size]...)
+			pos += size
+			continue
+		}
+
+		start := pos
+		for pos < len(data) {
+			if r, size := utf8.DecodeRune(data[pos:]); r != utf8.RuneError || size > 1 {
+				break
+			}
+			for range replacementChar {
+				offsets = append(offsets, pos)
+			}
+			valid = append(valid, replacementChar...)
+			pos++
+		}
+
+		loc = stepTo(loc, data, start)
+		warn := errorAt(loc, "error decoding input: %w: %q", UTF8Err, data[start:pos])
+		warns = append(warns, warn)
+	}
+	offsets = append(offsets, len(data))
+
+	return valid, offsets, warns
+}
+
+// Map the locations in a tree parsed from decoded data back to offsets in the
+// original data; see decode.
+func remapLocations(node *Node, offsets []int) {
+	remap := func(loc *Location) {
+		if loc.Pos >= 0 && loc.Pos < len(offsets) {
+			loc.Pos = offsets[loc.Pos]
+		}
+	}
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		remap(&node.Loc)
+		remap(&node.EndLoc)
+		for key, loc := range node.AttrLocs {
+			remap(&loc.Key.Start)
+			remap(&loc.Key.End)
+			remap(&loc.Val.Start)
+			remap(&loc.Val.End)
+			node.AttrLocs[key] = loc
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+}
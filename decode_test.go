@@ -0,0 +1,106 @@
+package gohtml
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestParseEncoding(t *testing.T) {
+	tests := []struct {
+		in      string
+		charset string
+		text    string
+		title   string
+	}{
+		{"<p title=\"caf\xe9\">\x93quoted\x94 \xa9</p>", "windows-1252", "“quoted” ©", "café"},
+		{"<meta charset=iso-8859-1><p title=\"caf\xe9\">na\xefve</p>", "", "naïve", "café"},
+		{"<meta charset=shift_jis><p title=a>\x93\xfa\x96{</p>", "", "日本", "a"},
+		{"<p title=\"caf\xc3\xa9\">\xc3\xa9</p>", "utf-8", "é", "café"},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := ParseWithOptions([]byte(test.in), WithEncoding(test.charset))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if len(warns) > 0 {
+			t.Errorf("Parse(%q): unexpected warnings %v", test.in, warns)
+		}
+		p := doc.Find("p")
+		if got := p.Text(); got != test.text {
+			t.Errorf("Parse(%q) text = %q, want %q", test.in, got, test.text)
+		}
+		if got, _ := p.Attr("title"); got != test.title {
+			t.Errorf("Parse(%q) title = %q, want %q", test.in, got, test.title)
+		}
+
+		// locations are offsets into the original input
+		if got := test.in[p.Loc.Pos:p.EndLoc.Pos]; got[:2] != "<p" || got[len(got)-4:] != "</p>" {
+			t.Errorf("Parse(%q): <p> spans %q", test.in, got)
+		}
+		if text := p.Children[0]; test.in[text.EndLoc.Pos:] != "</p>" {
+			t.Errorf("Parse(%q): text ends at %d, want %d", test.in, text.EndLoc.Pos, len(test.in)-len("</p>"))
+		}
+	}
+}
+
+func TestParseEncodingErrors(t *testing.T) {
+	doc, err, warns := ParseWithOptions([]byte("<p>caf\xc3\xa9</p>"), WithEncoding("no-such-encoding"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.Text(); got != "café" || !slices.ContainsFunc(warns, func(err error) bool { return errors.Is(err, CharsetErr) }) {
+		t.Errorf("unknown encoding: text %q with warnings %v, want UTF-8 and a %v", got, warns, CharsetErr)
+	}
+
+	src := "<p>a\xffb</p>"
+	doc, err, warns = Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := doc.Find("p").Children[0]
+	if text.Content != "a�b" || src[text.Loc.Pos:text.EndLoc.Pos] != "a\xffb" {
+		t.Errorf("invalid UTF-8: text %q spanning %q, want %q", text.Content, src[text.Loc.Pos:text.EndLoc.Pos], "a�b")
+	}
+	if !slices.ContainsFunc(warns, func(err error) bool { return errors.Is(err, UTF8Err) }) {
+		t.Errorf("invalid UTF-8: warnings %v, want %v", warns, UTF8Err)
+	}
+}
+
+func TestInvalidUTF8(t *testing.T) {
+	src := "<p title=\"a\xc3\">b\xff\xfec</p>\n<!--\xe2\x82-->\xf0\x9f\x98\x80"
+	doc, err, warns := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := doc.Find("p")
+	if title, _ := p.Attr("title"); title != "a�" {
+		t.Errorf("title = %q, want %q", title, "a�")
+	}
+	if got := p.Text(); got != "b��c" {
+		t.Errorf("text = %q, want %q", got, "b��c")
+	}
+	if comment := doc.Children[2]; comment.Content != "��" {
+		t.Errorf("comment = %q, want %q", comment.Content, "��")
+	}
+	if out := doc.RenderBytes(); !utf8.Valid(out) {
+		t.Errorf("RenderBytes = %q, want valid UTF-8", out)
+	}
+
+	// one warning per run of invalid bytes, at its location in the input
+	want := []string{"1:12", "1:16", "2:5"}
+	got := make([]string, 0, len(warns))
+	for _, warn := range warns {
+		var parseErr *ParseError
+		if errors.Is(warn, UTF8Err) && errors.As(warn, &parseErr) {
+			got = append(got, parseErr.Loc.String())
+		}
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("UTF-8 warnings at %v, want %v (warnings %v)", got, want, warns)
+	}
+}
@@ -0,0 +1,210 @@
+package gohtml
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// Replacement of a range of a document with new text.  Insertions have an
+// empty Span.
+type Edit struct {
+	Span    Span   // Range in the original document to replace
+	NewText string // Replacement text
+}
+
+// Compute the edits that transform src, the document node was parsed from, to
+// match node as modified since parsing.  Only the byte ranges of modified,
+// inserted, and removed nodes are edited, so that all other bytes of src stay
+// identical.  Edits are returned in document order.
+func Edits(src []byte, node *Node) []Edit {
+	return (&Renderer{Source: src}).Edits(node)
+}
+
+// Compute the edits that transform r.Source to match node as modified since
+// parsing; see Edits.  Modified nodes are rendered according to r.
+func (r *Renderer) Edits(node *Node) []Edit {
+	e := editor{r: *r, edits: make([]Edit, 0, 4)}
+	if e.r.inSource(node) {
+		e.editNode(node, nil)
+	} else {
+		e.replace(Location{Line: 1, Col: 1, Pos: 0}, len(r.Source), node)
+	}
+	return e.edits
+}
+
+// Apply edits, as returned by Edits, to src, returning the result.  Edits must
+// be in document order and may not overlap.
+func ApplyEdits(src []byte, edits []Edit) ([]byte, error) {
+	sorted := sort.SliceIsSorted(edits, func(i, j int) bool {
+		return edits[i].Span.Start.Pos < edits[j].Span.Start.Pos
+	})
+	if !sorted {
+		return nil, fmt.Errorf("error applying edits: %w: edits out of order", EditErr)
+	}
+
+	buf := bytes.Buffer{}
+	buf.Grow(len(src))
+
+	prev := 0
+	for _, edit := range edits {
+		start, end := edit.Span.Start.Pos, edit.Span.End.Pos
+		if start < prev || end < start || end > len(src) {
+			err := fmt.Errorf("%s: error applying edits: %w: range %d-%d", edit.Span.Start, EditErr, start, end)
+			return nil, err
+		}
+		buf.Write(src[prev:start])
+		buf.WriteString(edit.NewText)
+		prev = end
+	}
+	buf.Write(src[prev:])
+
+	return buf.Bytes(), nil
+}
+
+type editor struct {
+	r     Renderer
+	edits []Edit
+}
+
+// Location of the byte offset pos in the source, counting from loc.
+func (e *editor) locate(loc Location, pos int) Location {
+	if pos <= loc.Pos {
+		return loc
+	}
+	return stepN(loc, e.r.Source, pos-loc.Pos)
+}
+
+// Add an edit replacing source bytes from loc to end with node rendered,
+// along with the nodes foster parented out of it, if an element.
+func (e *editor) replace(loc Location, end int, node *Node, fostered ...*Node) {
+	buf := bytes.Buffer{}
+	if len(fostered) > 0 && node.Kind == ElementNode {
+		e.r.renderElement(&buf, node, fostered)
+	} else {
+		e.r.Render(&buf, node)
+	}
+	e.edits = append(e.edits, Edit{
+		Span:    Span{Start: loc, End: e.locate(loc, end)},
+		NewText: buf.String(),
+	})
+}
+
+// Add an edit inserting node rendered at loc.
+func (e *editor) insert(loc Location, node *Node) {
+	buf := bytes.Buffer{}
+	e.r.Render(&buf, node)
+	e.edits = append(e.edits, Edit{Span: Span{Start: loc, End: loc}, NewText: buf.String()})
+}
+
+// Add an edit removing source bytes start to end, unless they are only
+// whitespace dropped by the parser.
+func (e *editor) remove(loc Location, start int, end int) {
+	if len(bytes.TrimSpace(e.r.Source[start:end])) == 0 {
+		return
+	}
+	startLoc := e.locate(loc, start)
+	e.edits = append(e.edits, Edit{Span: Span{Start: startLoc, End: e.locate(startLoc, end)}})
+}
+
+// Add the edits for a node that has a valid range in the source, along with
+// the nodes foster parented out of it; see Renderer.sourceChildren.
+func (e *editor) editNode(node *Node, fostered []*Node) {
+	switch node.Kind {
+	case DocumentNode:
+		e.editChildren(node, node.Loc.Pos, node.EndLoc.Pos, nil)
+	case ElementNode:
+		e.editElement(node, fostered)
+	case ConditionalCommentNode:
+		open, close := conditionalDelims(node)
+		src := e.r.Source[node.Loc.Pos:node.EndLoc.Pos]
+		if len(src) >= len(open)+len(close) && bytes.HasPrefix(src, []byte(open)) && bytes.HasSuffix(src, []byte(close)) {
+			e.editChildren(node, node.Loc.Pos+len(open), node.EndLoc.Pos-len(close), nil)
+		} else {
+			e.replace(node.Loc, node.EndLoc.Pos, node)
+		}
+	default:
+		buf := bytes.Buffer{}
+		e.r.Render(&buf, node)
+		if !bytes.Equal(buf.Bytes(), e.r.Source[node.Loc.Pos:node.EndLoc.Pos]) {
+			e.replace(node.Loc, node.EndLoc.Pos, node)
+		}
+	}
+}
+
+func (e *editor) editElement(node *Node, fostered []*Node) {
+	src := e.r.Source
+
+	openEnd := e.r.sourceOpenTagEnd(node)
+	if openEnd >= 0 && node.Namespace != HTMLNamespace && bytes.HasSuffix(src[:openEnd], tagSelfcloseEnd) && len(node.Children) > 0 {
+		// self-closing foreign element given children
+		e.replace(node.Loc, node.EndLoc.Pos, node, fostered...)
+		return
+	} else if openEnd < 0 {
+		// opening tag was modified; find where it ended
+		if !bytes.HasPrefix(src[node.Loc.Pos:], tagStart) {
+			e.replace(node.Loc, node.EndLoc.Pos, node, fostered...)
+			return
+		}
+		_, loc, err := lexTagOpen(src[:node.EndLoc.Pos], node.Loc)
+		if err != nil {
+			e.replace(node.Loc, node.EndLoc.Pos, node, fostered...)
+			return
+		}
+		openEnd = loc.Pos
+
+		// keep self-closing foreign tags self-closing
+		selfclosing := node.Namespace != HTMLNamespace && bytes.HasSuffix(src[:openEnd], tagSelfcloseEnd)
+		if selfclosing && len(node.Children) > 0 {
+			e.replace(node.Loc, node.EndLoc.Pos, node, fostered...)
+			return
+		}
+
+		// keep the rest of the tag as written if only attributes changed
+		buf := bytes.Buffer{}
+		if tag, _ := e.r.sourceOpenTag(node); tag != nil {
+			buf.Write(tag)
+		} else {
+			e.r.renderOpenTag(&buf, node, selfclosing)
+		}
+		e.edits = append(e.edits, Edit{
+			Span:    Span{Start: node.Loc, End: e.locate(node.Loc, openEnd)},
+			NewText: buf.String(),
+		})
+	}
+
+	if e.r.isVoid(node) {
+		// void elements given children need a closing tag
+		if len(node.Children) > 0 {
+			e.replace(node.Loc, node.EndLoc.Pos, node, fostered...)
+		}
+		return
+	}
+
+	closeStart := e.r.sourceCloseTagStart(node)
+	if closeStart < 0 {
+		closeStart = node.EndLoc.Pos
+	}
+	e.editChildren(node, openEnd, closeStart, fostered)
+}
+
+// Add the edits for the children of node, which span source bytes start to
+// end, along with the nodes foster parented out of node.
+func (e *editor) editChildren(node *Node, start int, end int, fostered []*Node) {
+	prev := start
+	prevLoc := e.locate(node.Loc, start)
+
+	children, into := e.r.sourceChildren(node, fostered)
+	for _, child := range children {
+		if e.r.inSource(child) && child.Loc.Pos >= prev && child.EndLoc.Pos <= end {
+			// bytes between children belonged to removed nodes
+			e.remove(prevLoc, prev, child.Loc.Pos)
+			e.editNode(child, into[child])
+			prev, prevLoc = child.EndLoc.Pos, child.EndLoc
+		} else {
+			e.insert(prevLoc, child)
+		}
+	}
+
+	e.remove(prevLoc, prev, end)
+}
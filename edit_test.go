@@ -0,0 +1,141 @@
+package gohtml
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+// Parse src, modify it, and apply the edits for the modification to src.
+func applyModified(t *testing.T, src string, modify func(doc *Node)) string {
+	t.Helper()
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	modify(doc)
+	out, err := ApplyEdits([]byte(src), Edits([]byte(src), doc))
+	if err != nil {
+		t.Fatalf("ApplyEdits: %v", err)
+	}
+	return string(out)
+}
+
+func TestEditsFostered(t *testing.T) {
+	src := "<table>oops<tr><td>x</td></tr></table>"
+
+	if got := applyModified(t, src, func(doc *Node) {}); got != src {
+		t.Errorf("unmodified: got %q, want %q", got, src)
+	}
+
+	got := applyModified(t, src, func(doc *Node) {
+		doc.Children[0].Content = "OOPS"
+	})
+	if want := "<table>OOPS<tr><td>x</td></tr></table>"; got != want {
+		t.Errorf("modified text: got %q, want %q", got, want)
+	}
+
+	got = applyModified(t, src, func(doc *Node) {
+		doc.Children = doc.Children[:1]
+	})
+	if want := "oops"; got != want {
+		t.Errorf("removed table: got %q, want %q", got, want)
+	}
+}
+
+func TestEditsVoidTags(t *testing.T) {
+	voidTags := maps.Clone(DefaultVoidTags)
+	delete(voidTags, "wbr")
+
+	src := "<div><wbr>caption</wbr></div>"
+	doc, err, _ := ParseWithOptions([]byte(src), WithVoidTags(voidTags))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wbr := doc.Children[0].Children[0]
+	wbr.Children[0].Content = "label"
+
+	r := Renderer{Source: []byte(src), VoidTags: voidTags}
+	out, err := ApplyEdits([]byte(src), r.Edits(doc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(out), "<div><wbr>label</wbr></div>"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestEditsVoidWithChildren(t *testing.T) {
+	got := applyModified(t, "<p><br></p>", func(doc *Node) {
+		br := doc.Children[0].Children[0]
+		br.Children = append(br.Children, &Node{Kind: TextNode, Content: "x", Parent: br})
+	})
+	if want := "<p><br>x</br></p>"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestEdits(t *testing.T) {
+	src := "<DIV  class = 'a'>\n  <p>one<P>two\n  <!-- note -->\n</DIV >"
+	tests := []struct {
+		name   string
+		modify func(doc *Node)
+		want   string
+	}{
+		{"unmodified", func(doc *Node) {}, src},
+		{"text", func(doc *Node) {
+			doc.Find("p").Children[0].Content = "ONE"
+		}, "<DIV  class = 'a'>\n  <p>ONE<P>two\n  <!-- note -->\n</DIV >"},
+		{"attribute", func(doc *Node) {
+			doc.Find("div").SetAttr("id", "x")
+		}, "<DIV  class = 'a' id=\"x\">\n  <p>one<P>two\n  <!-- note -->\n</DIV >"},
+		{"removed", func(doc *Node) {
+			div := doc.Find("div")
+			div.Children = div.Children[:2]
+		}, "<DIV  class = 'a'>\n  <p>one</DIV >"},
+		{"inserted", func(doc *Node) {
+			div := doc.Find("div")
+			b := &Node{Kind: ElementNode, Content: "b", Children: []*Node{{Kind: TextNode, Content: "<3"}}}
+			div.Children = append([]*Node{b}, div.Children...)
+		}, "<DIV  class = 'a'><b>&lt;3</b>\n  <p>one<P>two\n  <!-- note -->\n</DIV >"},
+	}
+
+	for _, test := range tests {
+		if got := applyModified(t, src, test.modify); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	doc, _, _ := Parse([]byte(src))
+	if edits := Edits([]byte(src), doc); len(edits) != 0 {
+		t.Errorf("unmodified: Edits = %v, want none", edits)
+	}
+	doc.Find("p").Children[0].Content = "ONE"
+	if edits := Edits([]byte(src), doc); len(edits) != 1 || src[edits[0].Span.Start.Pos:edits[0].Span.End.Pos] != "one" {
+		t.Errorf("modified text: Edits = %v, want one edit of %q", edits, "one")
+	}
+}
+
+func TestApplyEditsInvalid(t *testing.T) {
+	src := []byte("abcdef")
+	span := func(start, end int) Span {
+		return Span{Start: Location{Pos: start}, End: Location{Pos: end}}
+	}
+	tests := [][]Edit{
+		{{Span: span(3, 4)}, {Span: span(0, 1)}},
+		{{Span: span(0, 3)}, {Span: span(2, 4)}},
+		{{Span: span(4, 2)}},
+		{{Span: span(4, 7)}},
+	}
+
+	for _, edits := range tests {
+		if _, err := ApplyEdits(src, edits); !errors.Is(err, EditErr) {
+			t.Errorf("ApplyEdits(%v) = %v, want %v", edits, err, EditErr)
+		}
+	}
+
+	got, err := ApplyEdits(src, []Edit{{Span: span(0, 1), NewText: "A"}, {Span: span(3, 3), NewText: "-"}, {Span: span(5, 6)}})
+	if want := "Abc-de"; err != nil || string(got) != want {
+		t.Errorf("ApplyEdits = %q, %v, want %q", got, err, want)
+	}
+}
@@ -0,0 +1,150 @@
+package gohtml
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Writes escaped HTML directly to a writer as it is generated, without
+// building a node tree.
+//
+// After the first write error, all methods return that error and write
+// nothing further.
+type Encoder struct {
+	// Void elements, keyed by lowercase tag name, which StartElement doesn't
+	// leave open, so that they take no call to EndElement.  If nil,
+	// DefaultVoidTags is used.
+	VoidTags map[string]bool
+
+	w    io.Writer
+	tags stack[string]
+	err  error
+}
+
+// Make a new Encoder writing to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w, tags: make(stack[string], 0, 16)}
+}
+
+func (enc *Encoder) write(s string) error {
+	if enc.err != nil {
+		return enc.err
+	}
+	_, enc.err = io.WriteString(enc.w, s)
+	return enc.err
+}
+
+// Write an opening tag with the given name and attributes.  Unless it is a
+// void element (e.g. <br>), the element stays open until the matching call to
+// EndElement.
+func (enc *Encoder) StartElement(name string, attrs map[string]string) error {
+	if enc.err != nil {
+		return enc.err
+	}
+
+	if !validTagName(name) {
+		return fmt.Errorf("error encoding opening tag: %w: %q", TagNameErr, name)
+	}
+	node := &Node{Kind: ElementNode, Content: name, Attrs: attrs}
+	for _, key := range node.AttrKeys() {
+		if !validAttrKey(key) {
+			return fmt.Errorf("error encoding opening tag: %w: %q", AttrKeyErr, key)
+		}
+	}
+
+	buf := strings.Builder{}
+	(&Renderer{}).renderOpenTag(&buf, node, false)
+	if err := enc.write(buf.String()); err != nil {
+		return err
+	}
+
+	voidTags := enc.VoidTags
+	if voidTags == nil {
+		voidTags = DefaultVoidTags
+	}
+	if !voidTags[strings.ToLower(name)] {
+		enc.tags.Push(name)
+	}
+	return nil
+}
+
+// Write the closing tag of the most recently started element.
+func (enc *Encoder) EndElement() error {
+	name, ok := enc.tags.Pop()
+	if !ok {
+		return fmt.Errorf("error encoding closing tag: %w", EmptyTagStackErr)
+	}
+	return enc.write("</" + name + ">")
+}
+
+// Write text, escaping it as needed.  Within raw text elements (e.g.
+// <script>), text is written as is, and must not contain the element's
+// closing tag.
+func (enc *Encoder) Text(s string) error {
+	name, ok := enc.tags.Peek()
+	if !ok || !DefaultRawTextTags[strings.ToLower(name)] {
+		return enc.write(textEscaper.Replace(s))
+	}
+
+	if strings.Contains(strings.ToLower(s), "</"+strings.ToLower(name)) {
+		return fmt.Errorf("error encoding text: %w: closing tag in %q", ContentErr, name)
+	}
+	return enc.write(s)
+}
+
+// Write a comment.  The comment must not contain "-->".
+func (enc *Encoder) Comment(s string) error {
+	if strings.Contains(s, string(commentEnd)) {
+		return fmt.Errorf("error encoding comment: %w: %q", ContentErr, commentEnd)
+	}
+	return enc.write("<!--" + s + "-->")
+}
+
+// Write a declaration (e.g. Declaration("DOCTYPE html") writes
+// <!DOCTYPE html>).  The declaration must not contain '>'.
+func (enc *Encoder) Declaration(s string) error {
+	if strings.Contains(s, string(tagEnd)) {
+		return fmt.Errorf("error encoding declaration: %w: %q", ContentErr, tagEnd)
+	}
+	return enc.write("<!" + s + ">")
+}
+
+// Close all elements that are still open.
+func (enc *Encoder) Close() error {
+	for enc.tags.Len() > 0 {
+		if err := enc.EndElement(); err != nil {
+			return err
+		}
+	}
+	return enc.err
+}
+
+// Whether name can be written as a tag name: an ASCII letter followed by
+// characters other than whitespace, controls, quotes, '/', '<', '=', and '>'.
+func validTagName(name string) bool {
+	if name == "" || !('a' <= name[0] && name[0] <= 'z' || 'A' <= name[0] && name[0] <= 'Z') {
+		return false
+	}
+	return !strings.ContainsFunc(name, func(r rune) bool {
+		return isSpaceR(r) || unicode.IsControl(r) || strings.ContainsRune("\"'/<=>", r)
+	})
+}
+
+// Whether key can be written as an attribute key: one or more characters other
+// than whitespace, controls, noncharacters, quotes, '/', '=', and '>'.
+func validAttrKey(key string) bool {
+	if key == "" || !utf8.ValidString(key) {
+		return false
+	}
+	return !strings.ContainsFunc(key, func(r rune) bool {
+		return isSpaceR(r) || unicode.IsControl(r) || isNoncharacter(r) || strings.ContainsRune("\"'/=>", r)
+	})
+}
+
+// Whether r is a Unicode noncharacter (e.g. U+FFFE).
+func isNoncharacter(r rune) bool {
+	return r >= 0xfdd0 && r <= 0xfdef || r&0xfffe == 0xfffe
+}
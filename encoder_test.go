@@ -0,0 +1,72 @@
+package gohtml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncoderVoidTags(t *testing.T) {
+	buf := strings.Builder{}
+	enc := NewEncoder(&buf)
+	enc.VoidTags = map[string]bool{"x-icon": true}
+
+	enc.StartElement("p", nil)
+	enc.StartElement("x-icon", nil)
+	enc.StartElement("br", nil)
+	enc.Text("x")
+	enc.EndElement()
+	if err := enc.EndElement(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := buf.String(), "<p><x-icon><br>x</br></p>"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestEncoderInvalidNames(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs map[string]string
+		err   error
+	}{
+		{"", nil, TagNameErr},
+		{"1p", nil, TagNameErr},
+		{"p onclick=alert(1)", nil, TagNameErr},
+		{"p><script", nil, TagNameErr},
+		{"p/", nil, TagNameErr},
+		{"p", map[string]string{"": "x"}, AttrKeyErr},
+		{"p", map[string]string{"a b": "x"}, AttrKeyErr},
+		{"p", map[string]string{`x"`: "x"}, AttrKeyErr},
+		{"p", map[string]string{"x>": "x"}, AttrKeyErr},
+		{"p", map[string]string{"x=y": "x"}, AttrKeyErr},
+		{"p", map[string]string{"x\x00": "x"}, AttrKeyErr},
+		{"p", map[string]string{"x￾": "x"}, AttrKeyErr},
+	}
+
+	for _, test := range tests {
+		buf := strings.Builder{}
+		enc := NewEncoder(&buf)
+		if err := enc.StartElement(test.name, test.attrs); !errors.Is(err, test.err) {
+			t.Errorf("StartElement(%q, %q) = %v, want %v", test.name, test.attrs, err, test.err)
+		}
+		if buf.Len() > 0 {
+			t.Errorf("StartElement(%q, %q) wrote %q", test.name, test.attrs, buf.String())
+		}
+	}
+}
+
+func TestEncoderValidNames(t *testing.T) {
+	buf := strings.Builder{}
+	enc := NewEncoder(&buf)
+	if err := enc.StartElement("x-widget", map[string]string{"data-x": "1", "@click": "f", "xlink:href": "#a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), `<x-widget @click="f" data-x="1" xlink:href="#a"></x-widget>`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
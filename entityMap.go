@@ -1,2238 +1,2257 @@
+// Code generated by gen_entities.go; DO NOT EDIT.
+
 package gohtml
 
-// this map was adapted from the standard lib html module
+import "strings"
+
+// Named entities, including the legacy ones without a terminating semicolon,
+// encoded as consecutive records of the form "name\x00expansion\x00" with the
+// leading '&' of each name omitted.
 // for a complete list of valid HTML named entities, see:
 // <https://html.spec.whatwg.org/multipage/named-characters.html#named-character-references>
-var entityMap = map[string]string{
-	"&AElig;":                           "\U000000C6",
-	"&AMP;":                             "\U00000026",
-	"&Aacute;":                          "\U000000C1",
-	"&Abreve;":                          "\U00000102",
-	"&Acirc;":                           "\U000000C2",
-	"&Acy;":                             "\U00000410",
-	"&Afr;":                             "\U0001D504",
-	"&Agrave;":                          "\U000000C0",
-	"&Alpha;":                           "\U00000391",
-	"&Amacr;":                           "\U00000100",
-	"&And;":                             "\U00002A53",
-	"&Aogon;":                           "\U00000104",
-	"&Aopf;":                            "\U0001D538",
-	"&ApplyFunction;":                   "\U00002061",
-	"&Aring;":                           "\U000000C5",
-	"&Ascr;":                            "\U0001D49C",
-	"&Assign;":                          "\U00002254",
-	"&Atilde;":                          "\U000000C3",
-	"&Auml;":                            "\U000000C4",
-	"&Backslash;":                       "\U00002216",
-	"&Barv;":                            "\U00002AE7",
-	"&Barwed;":                          "\U00002306",
-	"&Bcy;":                             "\U00000411",
-	"&Because;":                         "\U00002235",
-	"&Bernoullis;":                      "\U0000212C",
-	"&Beta;":                            "\U00000392",
-	"&Bfr;":                             "\U0001D505",
-	"&Bopf;":                            "\U0001D539",
-	"&Breve;":                           "\U000002D8",
-	"&Bscr;":                            "\U0000212C",
-	"&Bumpeq;":                          "\U0000224E",
-	"&CHcy;":                            "\U00000427",
-	"&COPY;":                            "\U000000A9",
-	"&Cacute;":                          "\U00000106",
-	"&Cap;":                             "\U000022D2",
-	"&CapitalDifferentialD;":            "\U00002145",
-	"&Cayleys;":                         "\U0000212D",
-	"&Ccaron;":                          "\U0000010C",
-	"&Ccedil;":                          "\U000000C7",
-	"&Ccirc;":                           "\U00000108",
-	"&Cconint;":                         "\U00002230",
-	"&Cdot;":                            "\U0000010A",
-	"&Cedilla;":                         "\U000000B8",
-	"&CenterDot;":                       "\U000000B7",
-	"&Cfr;":                             "\U0000212D",
-	"&Chi;":                             "\U000003A7",
-	"&CircleDot;":                       "\U00002299",
-	"&CircleMinus;":                     "\U00002296",
-	"&CirclePlus;":                      "\U00002295",
-	"&CircleTimes;":                     "\U00002297",
-	"&ClockwiseContourIntegral;":        "\U00002232",
-	"&CloseCurlyDoubleQuote;":           "\U0000201D",
-	"&CloseCurlyQuote;":                 "\U00002019",
-	"&Colon;":                           "\U00002237",
-	"&Colone;":                          "\U00002A74",
-	"&Congruent;":                       "\U00002261",
-	"&Conint;":                          "\U0000222F",
-	"&ContourIntegral;":                 "\U0000222E",
-	"&Copf;":                            "\U00002102",
-	"&Coproduct;":                       "\U00002210",
-	"&CounterClockwiseContourIntegral;": "\U00002233",
-	"&Cross;":                           "\U00002A2F",
-	"&Cscr;":                            "\U0001D49E",
-	"&Cup;":                             "\U000022D3",
-	"&CupCap;":                          "\U0000224D",
-	"&DD;":                              "\U00002145",
-	"&DDotrahd;":                        "\U00002911",
-	"&DJcy;":                            "\U00000402",
-	"&DScy;":                            "\U00000405",
-	"&DZcy;":                            "\U0000040F",
-	"&Dagger;":                          "\U00002021",
-	"&Darr;":                            "\U000021A1",
-	"&Dashv;":                           "\U00002AE4",
-	"&Dcaron;":                          "\U0000010E",
-	"&Dcy;":                             "\U00000414",
-	"&Del;":                             "\U00002207",
-	"&Delta;":                           "\U00000394",
-	"&Dfr;":                             "\U0001D507",
-	"&DiacriticalAcute;":                "\U000000B4",
-	"&DiacriticalDot;":                  "\U000002D9",
-	"&DiacriticalDoubleAcute;":          "\U000002DD",
-	"&DiacriticalGrave;":                "\U00000060",
-	"&DiacriticalTilde;":                "\U000002DC",
-	"&Diamond;":                         "\U000022C4",
-	"&DifferentialD;":                   "\U00002146",
-	"&Dopf;":                            "\U0001D53B",
-	"&Dot;":                             "\U000000A8",
-	"&DotDot;":                          "\U000020DC",
-	"&DotEqual;":                        "\U00002250",
-	"&DoubleContourIntegral;":           "\U0000222F",
-	"&DoubleDot;":                       "\U000000A8",
-	"&DoubleDownArrow;":                 "\U000021D3",
-	"&DoubleLeftArrow;":                 "\U000021D0",
-	"&DoubleLeftRightArrow;":            "\U000021D4",
-	"&DoubleLeftTee;":                   "\U00002AE4",
-	"&DoubleLongLeftArrow;":             "\U000027F8",
-	"&DoubleLongLeftRightArrow;":        "\U000027FA",
-	"&DoubleLongRightArrow;":            "\U000027F9",
-	"&DoubleRightArrow;":                "\U000021D2",
-	"&DoubleRightTee;":                  "\U000022A8",
-	"&DoubleUpArrow;":                   "\U000021D1",
-	"&DoubleUpDownArrow;":               "\U000021D5",
-	"&DoubleVerticalBar;":               "\U00002225",
-	"&DownArrow;":                       "\U00002193",
-	"&DownArrowBar;":                    "\U00002913",
-	"&DownArrowUpArrow;":                "\U000021F5",
-	"&DownBreve;":                       "\U00000311",
-	"&DownLeftRightVector;":             "\U00002950",
-	"&DownLeftTeeVector;":               "\U0000295E",
-	"&DownLeftVector;":                  "\U000021BD",
-	"&DownLeftVectorBar;":               "\U00002956",
-	"&DownRightTeeVector;":              "\U0000295F",
-	"&DownRightVector;":                 "\U000021C1",
-	"&DownRightVectorBar;":              "\U00002957",
-	"&DownTee;":                         "\U000022A4",
-	"&DownTeeArrow;":                    "\U000021A7",
-	"&Downarrow;":                       "\U000021D3",
-	"&Dscr;":                            "\U0001D49F",
-	"&Dstrok;":                          "\U00000110",
-	"&ENG;":                             "\U0000014A",
-	"&ETH;":                             "\U000000D0",
-	"&Eacute;":                          "\U000000C9",
-	"&Ecaron;":                          "\U0000011A",
-	"&Ecirc;":                           "\U000000CA",
-	"&Ecy;":                             "\U0000042D",
-	"&Edot;":                            "\U00000116",
-	"&Efr;":                             "\U0001D508",
-	"&Egrave;":                          "\U000000C8",
-	"&Element;":                         "\U00002208",
-	"&Emacr;":                           "\U00000112",
-	"&EmptySmallSquare;":                "\U000025FB",
-	"&EmptyVerySmallSquare;":            "\U000025AB",
-	"&Eogon;":                           "\U00000118",
-	"&Eopf;":                            "\U0001D53C",
-	"&Epsilon;":                         "\U00000395",
-	"&Equal;":                           "\U00002A75",
-	"&EqualTilde;":                      "\U00002242",
-	"&Equilibrium;":                     "\U000021CC",
-	"&Escr;":                            "\U00002130",
-	"&Esim;":                            "\U00002A73",
-	"&Eta;":                             "\U00000397",
-	"&Euml;":                            "\U000000CB",
-	"&Exists;":                          "\U00002203",
-	"&ExponentialE;":                    "\U00002147",
-	"&Fcy;":                             "\U00000424",
-	"&Ffr;":                             "\U0001D509",
-	"&FilledSmallSquare;":               "\U000025FC",
-	"&FilledVerySmallSquare;":           "\U000025AA",
-	"&Fopf;":                            "\U0001D53D",
-	"&ForAll;":                          "\U00002200",
-	"&Fouriertrf;":                      "\U00002131",
-	"&Fscr;":                            "\U00002131",
-	"&GJcy;":                            "\U00000403",
-	"&GT;":                              "\U0000003E",
-	"&Gamma;":                           "\U00000393",
-	"&Gammad;":                          "\U000003DC",
-	"&Gbreve;":                          "\U0000011E",
-	"&Gcedil;":                          "\U00000122",
-	"&Gcirc;":                           "\U0000011C",
-	"&Gcy;":                             "\U00000413",
-	"&Gdot;":                            "\U00000120",
-	"&Gfr;":                             "\U0001D50A",
-	"&Gg;":                              "\U000022D9",
-	"&Gopf;":                            "\U0001D53E",
-	"&GreaterEqual;":                    "\U00002265",
-	"&GreaterEqualLess;":                "\U000022DB",
-	"&GreaterFullEqual;":                "\U00002267",
-	"&GreaterGreater;":                  "\U00002AA2",
-	"&GreaterLess;":                     "\U00002277",
-	"&GreaterSlantEqual;":               "\U00002A7E",
-	"&GreaterTilde;":                    "\U00002273",
-	"&Gscr;":                            "\U0001D4A2",
-	"&Gt;":                              "\U0000226B",
-	"&HARDcy;":                          "\U0000042A",
-	"&Hacek;":                           "\U000002C7",
-	"&Hat;":                             "\U0000005E",
-	"&Hcirc;":                           "\U00000124",
-	"&Hfr;":                             "\U0000210C",
-	"&HilbertSpace;":                    "\U0000210B",
-	"&Hopf;":                            "\U0000210D",
-	"&HorizontalLine;":                  "\U00002500",
-	"&Hscr;":                            "\U0000210B",
-	"&Hstrok;":                          "\U00000126",
-	"&HumpDownHump;":                    "\U0000224E",
-	"&HumpEqual;":                       "\U0000224F",
-	"&IEcy;":                            "\U00000415",
-	"&IJlig;":                           "\U00000132",
-	"&IOcy;":                            "\U00000401",
-	"&Iacute;":                          "\U000000CD",
-	"&Icirc;":                           "\U000000CE",
-	"&Icy;":                             "\U00000418",
-	"&Idot;":                            "\U00000130",
-	"&Ifr;":                             "\U00002111",
-	"&Igrave;":                          "\U000000CC",
-	"&Im;":                              "\U00002111",
-	"&Imacr;":                           "\U0000012A",
-	"&ImaginaryI;":                      "\U00002148",
-	"&Implies;":                         "\U000021D2",
-	"&Int;":                             "\U0000222C",
-	"&Integral;":                        "\U0000222B",
-	"&Intersection;":                    "\U000022C2",
-	"&InvisibleComma;":                  "\U00002063",
-	"&InvisibleTimes;":                  "\U00002062",
-	"&Iogon;":                           "\U0000012E",
-	"&Iopf;":                            "\U0001D540",
-	"&Iota;":                            "\U00000399",
-	"&Iscr;":                            "\U00002110",
-	"&Itilde;":                          "\U00000128",
-	"&Iukcy;":                           "\U00000406",
-	"&Iuml;":                            "\U000000CF",
-	"&Jcirc;":                           "\U00000134",
-	"&Jcy;":                             "\U00000419",
-	"&Jfr;":                             "\U0001D50D",
-	"&Jopf;":                            "\U0001D541",
-	"&Jscr;":                            "\U0001D4A5",
-	"&Jsercy;":                          "\U00000408",
-	"&Jukcy;":                           "\U00000404",
-	"&KHcy;":                            "\U00000425",
-	"&KJcy;":                            "\U0000040C",
-	"&Kappa;":                           "\U0000039A",
-	"&Kcedil;":                          "\U00000136",
-	"&Kcy;":                             "\U0000041A",
-	"&Kfr;":                             "\U0001D50E",
-	"&Kopf;":                            "\U0001D542",
-	"&Kscr;":                            "\U0001D4A6",
-	"&LJcy;":                            "\U00000409",
-	"&LT;":                              "\U0000003C",
-	"&Lacute;":                          "\U00000139",
-	"&Lambda;":                          "\U0000039B",
-	"&Lang;":                            "\U000027EA",
-	"&Laplacetrf;":                      "\U00002112",
-	"&Larr;":                            "\U0000219E",
-	"&Lcaron;":                          "\U0000013D",
-	"&Lcedil;":                          "\U0000013B",
-	"&Lcy;":                             "\U0000041B",
-	"&LeftAngleBracket;":                "\U000027E8",
-	"&LeftArrow;":                       "\U00002190",
-	"&LeftArrowBar;":                    "\U000021E4",
-	"&LeftArrowRightArrow;":             "\U000021C6",
-	"&LeftCeiling;":                     "\U00002308",
-	"&LeftDoubleBracket;":               "\U000027E6",
-	"&LeftDownTeeVector;":               "\U00002961",
-	"&LeftDownVector;":                  "\U000021C3",
-	"&LeftDownVectorBar;":               "\U00002959",
-	"&LeftFloor;":                       "\U0000230A",
-	"&LeftRightArrow;":                  "\U00002194",
-	"&LeftRightVector;":                 "\U0000294E",
-	"&LeftTee;":                         "\U000022A3",
-	"&LeftTeeArrow;":                    "\U000021A4",
-	"&LeftTeeVector;":                   "\U0000295A",
-	"&LeftTriangle;":                    "\U000022B2",
-	"&LeftTriangleBar;":                 "\U000029CF",
-	"&LeftTriangleEqual;":               "\U000022B4",
-	"&LeftUpDownVector;":                "\U00002951",
-	"&LeftUpTeeVector;":                 "\U00002960",
-	"&LeftUpVector;":                    "\U000021BF",
-	"&LeftUpVectorBar;":                 "\U00002958",
-	"&LeftVector;":                      "\U000021BC",
-	"&LeftVectorBar;":                   "\U00002952",
-	"&Leftarrow;":                       "\U000021D0",
-	"&Leftrightarrow;":                  "\U000021D4",
-	"&LessEqualGreater;":                "\U000022DA",
-	"&LessFullEqual;":                   "\U00002266",
-	"&LessGreater;":                     "\U00002276",
-	"&LessLess;":                        "\U00002AA1",
-	"&LessSlantEqual;":                  "\U00002A7D",
-	"&LessTilde;":                       "\U00002272",
-	"&Lfr;":                             "\U0001D50F",
-	"&Ll;":                              "\U000022D8",
-	"&Lleftarrow;":                      "\U000021DA",
-	"&Lmidot;":                          "\U0000013F",
-	"&LongLeftArrow;":                   "\U000027F5",
-	"&LongLeftRightArrow;":              "\U000027F7",
-	"&LongRightArrow;":                  "\U000027F6",
-	"&Longleftarrow;":                   "\U000027F8",
-	"&Longleftrightarrow;":              "\U000027FA",
-	"&Longrightarrow;":                  "\U000027F9",
-	"&Lopf;":                            "\U0001D543",
-	"&LowerLeftArrow;":                  "\U00002199",
-	"&LowerRightArrow;":                 "\U00002198",
-	"&Lscr;":                            "\U00002112",
-	"&Lsh;":                             "\U000021B0",
-	"&Lstrok;":                          "\U00000141",
-	"&Lt;":                              "\U0000226A",
-	"&Map;":                             "\U00002905",
-	"&Mcy;":                             "\U0000041C",
-	"&MediumSpace;":                     "\U0000205F",
-	"&Mellintrf;":                       "\U00002133",
-	"&Mfr;":                             "\U0001D510",
-	"&MinusPlus;":                       "\U00002213",
-	"&Mopf;":                            "\U0001D544",
-	"&Mscr;":                            "\U00002133",
-	"&Mu;":                              "\U0000039C",
-	"&NJcy;":                            "\U0000040A",
-	"&Nacute;":                          "\U00000143",
-	"&Ncaron;":                          "\U00000147",
-	"&Ncedil;":                          "\U00000145",
-	"&Ncy;":                             "\U0000041D",
-	"&NegativeMediumSpace;":             "\U0000200B",
-	"&NegativeThickSpace;":              "\U0000200B",
-	"&NegativeThinSpace;":               "\U0000200B",
-	"&NegativeVeryThinSpace;":           "\U0000200B",
-	"&NestedGreaterGreater;":            "\U0000226B",
-	"&NestedLessLess;":                  "\U0000226A",
-	"&NewLine;":                         "\U0000000A",
-	"&Nfr;":                             "\U0001D511",
-	"&NoBreak;":                         "\U00002060",
-	"&NonBreakingSpace;":                "\U000000A0",
-	"&Nopf;":                            "\U00002115",
-	"&Not;":                             "\U00002AEC",
-	"&NotCongruent;":                    "\U00002262",
-	"&NotCupCap;":                       "\U0000226D",
-	"&NotDoubleVerticalBar;":            "\U00002226",
-	"&NotElement;":                      "\U00002209",
-	"&NotEqual;":                        "\U00002260",
-	"&NotExists;":                       "\U00002204",
-	"&NotGreater;":                      "\U0000226F",
-	"&NotGreaterEqual;":                 "\U00002271",
-	"&NotGreaterLess;":                  "\U00002279",
-	"&NotGreaterTilde;":                 "\U00002275",
-	"&NotLeftTriangle;":                 "\U000022EA",
-	"&NotLeftTriangleEqual;":            "\U000022EC",
-	"&NotLess;":                         "\U0000226E",
-	"&NotLessEqual;":                    "\U00002270",
-	"&NotLessGreater;":                  "\U00002278",
-	"&NotLessTilde;":                    "\U00002274",
-	"&NotPrecedes;":                     "\U00002280",
-	"&NotPrecedesSlantEqual;":           "\U000022E0",
-	"&NotReverseElement;":               "\U0000220C",
-	"&NotRightTriangle;":                "\U000022EB",
-	"&NotRightTriangleEqual;":           "\U000022ED",
-	"&NotSquareSubsetEqual;":            "\U000022E2",
-	"&NotSquareSupersetEqual;":          "\U000022E3",
-	"&NotSubsetEqual;":                  "\U00002288",
-	"&NotSucceeds;":                     "\U00002281",
-	"&NotSucceedsSlantEqual;":           "\U000022E1",
-	"&NotSupersetEqual;":                "\U00002289",
-	"&NotTilde;":                        "\U00002241",
-	"&NotTildeEqual;":                   "\U00002244",
-	"&NotTildeFullEqual;":               "\U00002247",
-	"&NotTildeTilde;":                   "\U00002249",
-	"&NotVerticalBar;":                  "\U00002224",
-	"&Nscr;":                            "\U0001D4A9",
-	"&Ntilde;":                          "\U000000D1",
-	"&Nu;":                              "\U0000039D",
-	"&OElig;":                           "\U00000152",
-	"&Oacute;":                          "\U000000D3",
-	"&Ocirc;":                           "\U000000D4",
-	"&Ocy;":                             "\U0000041E",
-	"&Odblac;":                          "\U00000150",
-	"&Ofr;":                             "\U0001D512",
-	"&Ograve;":                          "\U000000D2",
-	"&Omacr;":                           "\U0000014C",
-	"&Omega;":                           "\U000003A9",
-	"&Omicron;":                         "\U0000039F",
-	"&Oopf;":                            "\U0001D546",
-	"&OpenCurlyDoubleQuote;":            "\U0000201C",
-	"&OpenCurlyQuote;":                  "\U00002018",
-	"&Or;":                              "\U00002A54",
-	"&Oscr;":                            "\U0001D4AA",
-	"&Oslash;":                          "\U000000D8",
-	"&Otilde;":                          "\U000000D5",
-	"&Otimes;":                          "\U00002A37",
-	"&Ouml;":                            "\U000000D6",
-	"&OverBar;":                         "\U0000203E",
-	"&OverBrace;":                       "\U000023DE",
-	"&OverBracket;":                     "\U000023B4",
-	"&OverParenthesis;":                 "\U000023DC",
-	"&PartialD;":                        "\U00002202",
-	"&Pcy;":                             "\U0000041F",
-	"&Pfr;":                             "\U0001D513",
-	"&Phi;":                             "\U000003A6",
-	"&Pi;":                              "\U000003A0",
-	"&PlusMinus;":                       "\U000000B1",
-	"&Poincareplane;":                   "\U0000210C",
-	"&Popf;":                            "\U00002119",
-	"&Pr;":                              "\U00002ABB",
-	"&Precedes;":                        "\U0000227A",
-	"&PrecedesEqual;":                   "\U00002AAF",
-	"&PrecedesSlantEqual;":              "\U0000227C",
-	"&PrecedesTilde;":                   "\U0000227E",
-	"&Prime;":                           "\U00002033",
-	"&Product;":                         "\U0000220F",
-	"&Proportion;":                      "\U00002237",
-	"&Proportional;":                    "\U0000221D",
-	"&Pscr;":                            "\U0001D4AB",
-	"&Psi;":                             "\U000003A8",
-	"&QUOT;":                            "\U00000022",
-	"&Qfr;":                             "\U0001D514",
-	"&Qopf;":                            "\U0000211A",
-	"&Qscr;":                            "\U0001D4AC",
-	"&RBarr;":                           "\U00002910",
-	"&REG;":                             "\U000000AE",
-	"&Racute;":                          "\U00000154",
-	"&Rang;":                            "\U000027EB",
-	"&Rarr;":                            "\U000021A0",
-	"&Rarrtl;":                          "\U00002916",
-	"&Rcaron;":                          "\U00000158",
-	"&Rcedil;":                          "\U00000156",
-	"&Rcy;":                             "\U00000420",
-	"&Re;":                              "\U0000211C",
-	"&ReverseElement;":                  "\U0000220B",
-	"&ReverseEquilibrium;":              "\U000021CB",
-	"&ReverseUpEquilibrium;":            "\U0000296F",
-	"&Rfr;":                             "\U0000211C",
-	"&Rho;":                             "\U000003A1",
-	"&RightAngleBracket;":               "\U000027E9",
-	"&RightArrow;":                      "\U00002192",
-	"&RightArrowBar;":                   "\U000021E5",
-	"&RightArrowLeftArrow;":             "\U000021C4",
-	"&RightCeiling;":                    "\U00002309",
-	"&RightDoubleBracket;":              "\U000027E7",
-	"&RightDownTeeVector;":              "\U0000295D",
-	"&RightDownVector;":                 "\U000021C2",
-	"&RightDownVectorBar;":              "\U00002955",
-	"&RightFloor;":                      "\U0000230B",
-	"&RightTee;":                        "\U000022A2",
-	"&RightTeeArrow;":                   "\U000021A6",
-	"&RightTeeVector;":                  "\U0000295B",
-	"&RightTriangle;":                   "\U000022B3",
-	"&RightTriangleBar;":                "\U000029D0",
-	"&RightTriangleEqual;":              "\U000022B5",
-	"&RightUpDownVector;":               "\U0000294F",
-	"&RightUpTeeVector;":                "\U0000295C",
-	"&RightUpVector;":                   "\U000021BE",
-	"&RightUpVectorBar;":                "\U00002954",
-	"&RightVector;":                     "\U000021C0",
-	"&RightVectorBar;":                  "\U00002953",
-	"&Rightarrow;":                      "\U000021D2",
-	"&Ropf;":                            "\U0000211D",
-	"&RoundImplies;":                    "\U00002970",
-	"&Rrightarrow;":                     "\U000021DB",
-	"&Rscr;":                            "\U0000211B",
-	"&Rsh;":                             "\U000021B1",
-	"&RuleDelayed;":                     "\U000029F4",
-	"&SHCHcy;":                          "\U00000429",
-	"&SHcy;":                            "\U00000428",
-	"&SOFTcy;":                          "\U0000042C",
-	"&Sacute;":                          "\U0000015A",
-	"&Sc;":                              "\U00002ABC",
-	"&Scaron;":                          "\U00000160",
-	"&Scedil;":                          "\U0000015E",
-	"&Scirc;":                           "\U0000015C",
-	"&Scy;":                             "\U00000421",
-	"&Sfr;":                             "\U0001D516",
-	"&ShortDownArrow;":                  "\U00002193",
-	"&ShortLeftArrow;":                  "\U00002190",
-	"&ShortRightArrow;":                 "\U00002192",
-	"&ShortUpArrow;":                    "\U00002191",
-	"&Sigma;":                           "\U000003A3",
-	"&SmallCircle;":                     "\U00002218",
-	"&Sopf;":                            "\U0001D54A",
-	"&Sqrt;":                            "\U0000221A",
-	"&Square;":                          "\U000025A1",
-	"&SquareIntersection;":              "\U00002293",
-	"&SquareSubset;":                    "\U0000228F",
-	"&SquareSubsetEqual;":               "\U00002291",
-	"&SquareSuperset;":                  "\U00002290",
-	"&SquareSupersetEqual;":             "\U00002292",
-	"&SquareUnion;":                     "\U00002294",
-	"&Sscr;":                            "\U0001D4AE",
-	"&Star;":                            "\U000022C6",
-	"&Sub;":                             "\U000022D0",
-	"&Subset;":                          "\U000022D0",
-	"&SubsetEqual;":                     "\U00002286",
-	"&Succeeds;":                        "\U0000227B",
-	"&SucceedsEqual;":                   "\U00002AB0",
-	"&SucceedsSlantEqual;":              "\U0000227D",
-	"&SucceedsTilde;":                   "\U0000227F",
-	"&SuchThat;":                        "\U0000220B",
-	"&Sum;":                             "\U00002211",
-	"&Sup;":                             "\U000022D1",
-	"&Superset;":                        "\U00002283",
-	"&SupersetEqual;":                   "\U00002287",
-	"&Supset;":                          "\U000022D1",
-	"&THORN;":                           "\U000000DE",
-	"&TRADE;":                           "\U00002122",
-	"&TSHcy;":                           "\U0000040B",
-	"&TScy;":                            "\U00000426",
-	"&Tab;":                             "\U00000009",
-	"&Tau;":                             "\U000003A4",
-	"&Tcaron;":                          "\U00000164",
-	"&Tcedil;":                          "\U00000162",
-	"&Tcy;":                             "\U00000422",
-	"&Tfr;":                             "\U0001D517",
-	"&Therefore;":                       "\U00002234",
-	"&Theta;":                           "\U00000398",
-	"&ThinSpace;":                       "\U00002009",
-	"&Tilde;":                           "\U0000223C",
-	"&TildeEqual;":                      "\U00002243",
-	"&TildeFullEqual;":                  "\U00002245",
-	"&TildeTilde;":                      "\U00002248",
-	"&Topf;":                            "\U0001D54B",
-	"&TripleDot;":                       "\U000020DB",
-	"&Tscr;":                            "\U0001D4AF",
-	"&Tstrok;":                          "\U00000166",
-	"&Uacute;":                          "\U000000DA",
-	"&Uarr;":                            "\U0000219F",
-	"&Uarrocir;":                        "\U00002949",
-	"&Ubrcy;":                           "\U0000040E",
-	"&Ubreve;":                          "\U0000016C",
-	"&Ucirc;":                           "\U000000DB",
-	"&Ucy;":                             "\U00000423",
-	"&Udblac;":                          "\U00000170",
-	"&Ufr;":                             "\U0001D518",
-	"&Ugrave;":                          "\U000000D9",
-	"&Umacr;":                           "\U0000016A",
-	"&UnderBar;":                        "\U0000005F",
-	"&UnderBrace;":                      "\U000023DF",
-	"&UnderBracket;":                    "\U000023B5",
-	"&UnderParenthesis;":                "\U000023DD",
-	"&Union;":                           "\U000022C3",
-	"&UnionPlus;":                       "\U0000228E",
-	"&Uogon;":                           "\U00000172",
-	"&Uopf;":                            "\U0001D54C",
-	"&UpArrow;":                         "\U00002191",
-	"&UpArrowBar;":                      "\U00002912",
-	"&UpArrowDownArrow;":                "\U000021C5",
-	"&UpDownArrow;":                     "\U00002195",
-	"&UpEquilibrium;":                   "\U0000296E",
-	"&UpTee;":                           "\U000022A5",
-	"&UpTeeArrow;":                      "\U000021A5",
-	"&Uparrow;":                         "\U000021D1",
-	"&Updownarrow;":                     "\U000021D5",
-	"&UpperLeftArrow;":                  "\U00002196",
-	"&UpperRightArrow;":                 "\U00002197",
-	"&Upsi;":                            "\U000003D2",
-	"&Upsilon;":                         "\U000003A5",
-	"&Uring;":                           "\U0000016E",
-	"&Uscr;":                            "\U0001D4B0",
-	"&Utilde;":                          "\U00000168",
-	"&Uuml;":                            "\U000000DC",
-	"&VDash;":                           "\U000022AB",
-	"&Vbar;":                            "\U00002AEB",
-	"&Vcy;":                             "\U00000412",
-	"&Vdash;":                           "\U000022A9",
-	"&Vdashl;":                          "\U00002AE6",
-	"&Vee;":                             "\U000022C1",
-	"&Verbar;":                          "\U00002016",
-	"&Vert;":                            "\U00002016",
-	"&VerticalBar;":                     "\U00002223",
-	"&VerticalLine;":                    "\U0000007C",
-	"&VerticalSeparator;":               "\U00002758",
-	"&VerticalTilde;":                   "\U00002240",
-	"&VeryThinSpace;":                   "\U0000200A",
-	"&Vfr;":                             "\U0001D519",
-	"&Vopf;":                            "\U0001D54D",
-	"&Vscr;":                            "\U0001D4B1",
-	"&Vvdash;":                          "\U000022AA",
-	"&Wcirc;":                           "\U00000174",
-	"&Wedge;":                           "\U000022C0",
-	"&Wfr;":                             "\U0001D51A",
-	"&Wopf;":                            "\U0001D54E",
-	"&Wscr;":                            "\U0001D4B2",
-	"&Xfr;":                             "\U0001D51B",
-	"&Xi;":                              "\U0000039E",
-	"&Xopf;":                            "\U0001D54F",
-	"&Xscr;":                            "\U0001D4B3",
-	"&YAcy;":                            "\U0000042F",
-	"&YIcy;":                            "\U00000407",
-	"&YUcy;":                            "\U0000042E",
-	"&Yacute;":                          "\U000000DD",
-	"&Ycirc;":                           "\U00000176",
-	"&Ycy;":                             "\U0000042B",
-	"&Yfr;":                             "\U0001D51C",
-	"&Yopf;":                            "\U0001D550",
-	"&Yscr;":                            "\U0001D4B4",
-	"&Yuml;":                            "\U00000178",
-	"&ZHcy;":                            "\U00000416",
-	"&Zacute;":                          "\U00000179",
-	"&Zcaron;":                          "\U0000017D",
-	"&Zcy;":                             "\U00000417",
-	"&Zdot;":                            "\U0000017B",
-	"&ZeroWidthSpace;":                  "\U0000200B",
-	"&Zeta;":                            "\U00000396",
-	"&Zfr;":                             "\U00002128",
-	"&Zopf;":                            "\U00002124",
-	"&Zscr;":                            "\U0001D4B5",
-	"&aacute;":                          "\U000000E1",
-	"&abreve;":                          "\U00000103",
-	"&ac;":                              "\U0000223E",
-	"&acd;":                             "\U0000223F",
-	"&acirc;":                           "\U000000E2",
-	"&acute;":                           "\U000000B4",
-	"&acy;":                             "\U00000430",
-	"&aelig;":                           "\U000000E6",
-	"&af;":                              "\U00002061",
-	"&afr;":                             "\U0001D51E",
-	"&agrave;":                          "\U000000E0",
-	"&alefsym;":                         "\U00002135",
-	"&aleph;":                           "\U00002135",
-	"&alpha;":                           "\U000003B1",
-	"&amacr;":                           "\U00000101",
-	"&amalg;":                           "\U00002A3F",
-	"&amp;":                             "\U00000026",
-	"&and;":                             "\U00002227",
-	"&andand;":                          "\U00002A55",
-	"&andd;":                            "\U00002A5C",
-	"&andslope;":                        "\U00002A58",
-	"&andv;":                            "\U00002A5A",
-	"&ang;":                             "\U00002220",
-	"&ange;":                            "\U000029A4",
-	"&angle;":                           "\U00002220",
-	"&angmsd;":                          "\U00002221",
-	"&angmsdaa;":                        "\U000029A8",
-	"&angmsdab;":                        "\U000029A9",
-	"&angmsdac;":                        "\U000029AA",
-	"&angmsdad;":                        "\U000029AB",
-	"&angmsdae;":                        "\U000029AC",
-	"&angmsdaf;":                        "\U000029AD",
-	"&angmsdag;":                        "\U000029AE",
-	"&angmsdah;":                        "\U000029AF",
-	"&angrt;":                           "\U0000221F",
-	"&angrtvb;":                         "\U000022BE",
-	"&angrtvbd;":                        "\U0000299D",
-	"&angsph;":                          "\U00002222",
-	"&angst;":                           "\U000000C5",
-	"&angzarr;":                         "\U0000237C",
-	"&aogon;":                           "\U00000105",
-	"&aopf;":                            "\U0001D552",
-	"&ap;":                              "\U00002248",
-	"&apE;":                             "\U00002A70",
-	"&apacir;":                          "\U00002A6F",
-	"&ape;":                             "\U0000224A",
-	"&apid;":                            "\U0000224B",
-	"&apos;":                            "\U00000027",
-	"&approx;":                          "\U00002248",
-	"&approxeq;":                        "\U0000224A",
-	"&aring;":                           "\U000000E5",
-	"&ascr;":                            "\U0001D4B6",
-	"&ast;":                             "\U0000002A",
-	"&asymp;":                           "\U00002248",
-	"&asympeq;":                         "\U0000224D",
-	"&atilde;":                          "\U000000E3",
-	"&auml;":                            "\U000000E4",
-	"&awconint;":                        "\U00002233",
-	"&awint;":                           "\U00002A11",
-	"&bNot;":                            "\U00002AED",
-	"&backcong;":                        "\U0000224C",
-	"&backepsilon;":                     "\U000003F6",
-	"&backprime;":                       "\U00002035",
-	"&backsim;":                         "\U0000223D",
-	"&backsimeq;":                       "\U000022CD",
-	"&barvee;":                          "\U000022BD",
-	"&barwed;":                          "\U00002305",
-	"&barwedge;":                        "\U00002305",
-	"&bbrk;":                            "\U000023B5",
-	"&bbrktbrk;":                        "\U000023B6",
-	"&bcong;":                           "\U0000224C",
-	"&bcy;":                             "\U00000431",
-	"&bdquo;":                           "\U0000201E",
-	"&becaus;":                          "\U00002235",
-	"&because;":                         "\U00002235",
-	"&bemptyv;":                         "\U000029B0",
-	"&bepsi;":                           "\U000003F6",
-	"&bernou;":                          "\U0000212C",
-	"&beta;":                            "\U000003B2",
-	"&beth;":                            "\U00002136",
-	"&between;":                         "\U0000226C",
-	"&bfr;":                             "\U0001D51F",
-	"&bigcap;":                          "\U000022C2",
-	"&bigcirc;":                         "\U000025EF",
-	"&bigcup;":                          "\U000022C3",
-	"&bigodot;":                         "\U00002A00",
-	"&bigoplus;":                        "\U00002A01",
-	"&bigotimes;":                       "\U00002A02",
-	"&bigsqcup;":                        "\U00002A06",
-	"&bigstar;":                         "\U00002605",
-	"&bigtriangledown;":                 "\U000025BD",
-	"&bigtriangleup;":                   "\U000025B3",
-	"&biguplus;":                        "\U00002A04",
-	"&bigvee;":                          "\U000022C1",
-	"&bigwedge;":                        "\U000022C0",
-	"&bkarow;":                          "\U0000290D",
-	"&blacklozenge;":                    "\U000029EB",
-	"&blacksquare;":                     "\U000025AA",
-	"&blacktriangle;":                   "\U000025B4",
-	"&blacktriangledown;":               "\U000025BE",
-	"&blacktriangleleft;":               "\U000025C2",
-	"&blacktriangleright;":              "\U000025B8",
-	"&blank;":                           "\U00002423",
-	"&blk12;":                           "\U00002592",
-	"&blk14;":                           "\U00002591",
-	"&blk34;":                           "\U00002593",
-	"&block;":                           "\U00002588",
-	"&bnot;":                            "\U00002310",
-	"&bopf;":                            "\U0001D553",
-	"&bot;":                             "\U000022A5",
-	"&bottom;":                          "\U000022A5",
-	"&bowtie;":                          "\U000022C8",
-	"&boxDL;":                           "\U00002557",
-	"&boxDR;":                           "\U00002554",
-	"&boxDl;":                           "\U00002556",
-	"&boxDr;":                           "\U00002553",
-	"&boxH;":                            "\U00002550",
-	"&boxHD;":                           "\U00002566",
-	"&boxHU;":                           "\U00002569",
-	"&boxHd;":                           "\U00002564",
-	"&boxHu;":                           "\U00002567",
-	"&boxUL;":                           "\U0000255D",
-	"&boxUR;":                           "\U0000255A",
-	"&boxUl;":                           "\U0000255C",
-	"&boxUr;":                           "\U00002559",
-	"&boxV;":                            "\U00002551",
-	"&boxVH;":                           "\U0000256C",
-	"&boxVL;":                           "\U00002563",
-	"&boxVR;":                           "\U00002560",
-	"&boxVh;":                           "\U0000256B",
-	"&boxVl;":                           "\U00002562",
-	"&boxVr;":                           "\U0000255F",
-	"&boxbox;":                          "\U000029C9",
-	"&boxdL;":                           "\U00002555",
-	"&boxdR;":                           "\U00002552",
-	"&boxdl;":                           "\U00002510",
-	"&boxdr;":                           "\U0000250C",
-	"&boxh;":                            "\U00002500",
-	"&boxhD;":                           "\U00002565",
-	"&boxhU;":                           "\U00002568",
-	"&boxhd;":                           "\U0000252C",
-	"&boxhu;":                           "\U00002534",
-	"&boxminus;":                        "\U0000229F",
-	"&boxplus;":                         "\U0000229E",
-	"&boxtimes;":                        "\U000022A0",
-	"&boxuL;":                           "\U0000255B",
-	"&boxuR;":                           "\U00002558",
-	"&boxul;":                           "\U00002518",
-	"&boxur;":                           "\U00002514",
-	"&boxv;":                            "\U00002502",
-	"&boxvH;":                           "\U0000256A",
-	"&boxvL;":                           "\U00002561",
-	"&boxvR;":                           "\U0000255E",
-	"&boxvh;":                           "\U0000253C",
-	"&boxvl;":                           "\U00002524",
-	"&boxvr;":                           "\U0000251C",
-	"&bprime;":                          "\U00002035",
-	"&breve;":                           "\U000002D8",
-	"&brvbar;":                          "\U000000A6",
-	"&bscr;":                            "\U0001D4B7",
-	"&bsemi;":                           "\U0000204F",
-	"&bsim;":                            "\U0000223D",
-	"&bsime;":                           "\U000022CD",
-	"&bsol;":                            "\U0000005C",
-	"&bsolb;":                           "\U000029C5",
-	"&bsolhsub;":                        "\U000027C8",
-	"&bull;":                            "\U00002022",
-	"&bullet;":                          "\U00002022",
-	"&bump;":                            "\U0000224E",
-	"&bumpE;":                           "\U00002AAE",
-	"&bumpe;":                           "\U0000224F",
-	"&bumpeq;":                          "\U0000224F",
-	"&cacute;":                          "\U00000107",
-	"&cap;":                             "\U00002229",
-	"&capand;":                          "\U00002A44",
-	"&capbrcup;":                        "\U00002A49",
-	"&capcap;":                          "\U00002A4B",
-	"&capcup;":                          "\U00002A47",
-	"&capdot;":                          "\U00002A40",
-	"&caret;":                           "\U00002041",
-	"&caron;":                           "\U000002C7",
-	"&ccaps;":                           "\U00002A4D",
-	"&ccaron;":                          "\U0000010D",
-	"&ccedil;":                          "\U000000E7",
-	"&ccirc;":                           "\U00000109",
-	"&ccups;":                           "\U00002A4C",
-	"&ccupssm;":                         "\U00002A50",
-	"&cdot;":                            "\U0000010B",
-	"&cedil;":                           "\U000000B8",
-	"&cemptyv;":                         "\U000029B2",
-	"&cent;":                            "\U000000A2",
-	"&centerdot;":                       "\U000000B7",
-	"&cfr;":                             "\U0001D520",
-	"&chcy;":                            "\U00000447",
-	"&check;":                           "\U00002713",
-	"&checkmark;":                       "\U00002713",
-	"&chi;":                             "\U000003C7",
-	"&cir;":                             "\U000025CB",
-	"&cirE;":                            "\U000029C3",
-	"&circ;":                            "\U000002C6",
-	"&circeq;":                          "\U00002257",
-	"&circlearrowleft;":                 "\U000021BA",
-	"&circlearrowright;":                "\U000021BB",
-	"&circledR;":                        "\U000000AE",
-	"&circledS;":                        "\U000024C8",
-	"&circledast;":                      "\U0000229B",
-	"&circledcirc;":                     "\U0000229A",
-	"&circleddash;":                     "\U0000229D",
-	"&cire;":                            "\U00002257",
-	"&cirfnint;":                        "\U00002A10",
-	"&cirmid;":                          "\U00002AEF",
-	"&cirscir;":                         "\U000029C2",
-	"&clubs;":                           "\U00002663",
-	"&clubsuit;":                        "\U00002663",
-	"&colon;":                           "\U0000003A",
-	"&colone;":                          "\U00002254",
-	"&coloneq;":                         "\U00002254",
-	"&comma;":                           "\U0000002C",
-	"&commat;":                          "\U00000040",
-	"&comp;":                            "\U00002201",
-	"&compfn;":                          "\U00002218",
-	"&complement;":                      "\U00002201",
-	"&complexes;":                       "\U00002102",
-	"&cong;":                            "\U00002245",
-	"&congdot;":                         "\U00002A6D",
-	"&conint;":                          "\U0000222E",
-	"&copf;":                            "\U0001D554",
-	"&coprod;":                          "\U00002210",
-	"&copy;":                            "\U000000A9",
-	"&copysr;":                          "\U00002117",
-	"&crarr;":                           "\U000021B5",
-	"&cross;":                           "\U00002717",
-	"&cscr;":                            "\U0001D4B8",
-	"&csub;":                            "\U00002ACF",
-	"&csube;":                           "\U00002AD1",
-	"&csup;":                            "\U00002AD0",
-	"&csupe;":                           "\U00002AD2",
-	"&ctdot;":                           "\U000022EF",
-	"&cudarrl;":                         "\U00002938",
-	"&cudarrr;":                         "\U00002935",
-	"&cuepr;":                           "\U000022DE",
-	"&cuesc;":                           "\U000022DF",
-	"&cularr;":                          "\U000021B6",
-	"&cularrp;":                         "\U0000293D",
-	"&cup;":                             "\U0000222A",
-	"&cupbrcap;":                        "\U00002A48",
-	"&cupcap;":                          "\U00002A46",
-	"&cupcup;":                          "\U00002A4A",
-	"&cupdot;":                          "\U0000228D",
-	"&cupor;":                           "\U00002A45",
-	"&curarr;":                          "\U000021B7",
-	"&curarrm;":                         "\U0000293C",
-	"&curlyeqprec;":                     "\U000022DE",
-	"&curlyeqsucc;":                     "\U000022DF",
-	"&curlyvee;":                        "\U000022CE",
-	"&curlywedge;":                      "\U000022CF",
-	"&curren;":                          "\U000000A4",
-	"&curvearrowleft;":                  "\U000021B6",
-	"&curvearrowright;":                 "\U000021B7",
-	"&cuvee;":                           "\U000022CE",
-	"&cuwed;":                           "\U000022CF",
-	"&cwconint;":                        "\U00002232",
-	"&cwint;":                           "\U00002231",
-	"&cylcty;":                          "\U0000232D",
-	"&dArr;":                            "\U000021D3",
-	"&dHar;":                            "\U00002965",
-	"&dagger;":                          "\U00002020",
-	"&daleth;":                          "\U00002138",
-	"&darr;":                            "\U00002193",
-	"&dash;":                            "\U00002010",
-	"&dashv;":                           "\U000022A3",
-	"&dbkarow;":                         "\U0000290F",
-	"&dblac;":                           "\U000002DD",
-	"&dcaron;":                          "\U0000010F",
-	"&dcy;":                             "\U00000434",
-	"&dd;":                              "\U00002146",
-	"&ddagger;":                         "\U00002021",
-	"&ddarr;":                           "\U000021CA",
-	"&ddotseq;":                         "\U00002A77",
-	"&deg;":                             "\U000000B0",
-	"&delta;":                           "\U000003B4",
-	"&demptyv;":                         "\U000029B1",
-	"&dfisht;":                          "\U0000297F",
-	"&dfr;":                             "\U0001D521",
-	"&dharl;":                           "\U000021C3",
-	"&dharr;":                           "\U000021C2",
-	"&diam;":                            "\U000022C4",
-	"&diamond;":                         "\U000022C4",
-	"&diamondsuit;":                     "\U00002666",
-	"&diams;":                           "\U00002666",
-	"&die;":                             "\U000000A8",
-	"&digamma;":                         "\U000003DD",
-	"&disin;":                           "\U000022F2",
-	"&div;":                             "\U000000F7",
-	"&divide;":                          "\U000000F7",
-	"&divideontimes;":                   "\U000022C7",
-	"&divonx;":                          "\U000022C7",
-	"&djcy;":                            "\U00000452",
-	"&dlcorn;":                          "\U0000231E",
-	"&dlcrop;":                          "\U0000230D",
-	"&dollar;":                          "\U00000024",
-	"&dopf;":                            "\U0001D555",
-	"&dot;":                             "\U000002D9",
-	"&doteq;":                           "\U00002250",
-	"&doteqdot;":                        "\U00002251",
-	"&dotminus;":                        "\U00002238",
-	"&dotplus;":                         "\U00002214",
-	"&dotsquare;":                       "\U000022A1",
-	"&doublebarwedge;":                  "\U00002306",
-	"&downarrow;":                       "\U00002193",
-	"&downdownarrows;":                  "\U000021CA",
-	"&downharpoonleft;":                 "\U000021C3",
-	"&downharpoonright;":                "\U000021C2",
-	"&drbkarow;":                        "\U00002910",
-	"&drcorn;":                          "\U0000231F",
-	"&drcrop;":                          "\U0000230C",
-	"&dscr;":                            "\U0001D4B9",
-	"&dscy;":                            "\U00000455",
-	"&dsol;":                            "\U000029F6",
-	"&dstrok;":                          "\U00000111",
-	"&dtdot;":                           "\U000022F1",
-	"&dtri;":                            "\U000025BF",
-	"&dtrif;":                           "\U000025BE",
-	"&duarr;":                           "\U000021F5",
-	"&duhar;":                           "\U0000296F",
-	"&dwangle;":                         "\U000029A6",
-	"&dzcy;":                            "\U0000045F",
-	"&dzigrarr;":                        "\U000027FF",
-	"&eDDot;":                           "\U00002A77",
-	"&eDot;":                            "\U00002251",
-	"&eacute;":                          "\U000000E9",
-	"&easter;":                          "\U00002A6E",
-	"&ecaron;":                          "\U0000011B",
-	"&ecir;":                            "\U00002256",
-	"&ecirc;":                           "\U000000EA",
-	"&ecolon;":                          "\U00002255",
-	"&ecy;":                             "\U0000044D",
-	"&edot;":                            "\U00000117",
-	"&ee;":                              "\U00002147",
-	"&efDot;":                           "\U00002252",
-	"&efr;":                             "\U0001D522",
-	"&eg;":                              "\U00002A9A",
-	"&egrave;":                          "\U000000E8",
-	"&egs;":                             "\U00002A96",
-	"&egsdot;":                          "\U00002A98",
-	"&el;":                              "\U00002A99",
-	"&elinters;":                        "\U000023E7",
-	"&ell;":                             "\U00002113",
-	"&els;":                             "\U00002A95",
-	"&elsdot;":                          "\U00002A97",
-	"&emacr;":                           "\U00000113",
-	"&empty;":                           "\U00002205",
-	"&emptyset;":                        "\U00002205",
-	"&emptyv;":                          "\U00002205",
-	"&emsp;":                            "\U00002003",
-	"&emsp13;":                          "\U00002004",
-	"&emsp14;":                          "\U00002005",
-	"&eng;":                             "\U0000014B",
-	"&ensp;":                            "\U00002002",
-	"&eogon;":                           "\U00000119",
-	"&eopf;":                            "\U0001D556",
-	"&epar;":                            "\U000022D5",
-	"&eparsl;":                          "\U000029E3",
-	"&eplus;":                           "\U00002A71",
-	"&epsi;":                            "\U000003B5",
-	"&epsilon;":                         "\U000003B5",
-	"&epsiv;":                           "\U000003F5",
-	"&eqcirc;":                          "\U00002256",
-	"&eqcolon;":                         "\U00002255",
-	"&eqsim;":                           "\U00002242",
-	"&eqslantgtr;":                      "\U00002A96",
-	"&eqslantless;":                     "\U00002A95",
-	"&equals;":                          "\U0000003D",
-	"&equest;":                          "\U0000225F",
-	"&equiv;":                           "\U00002261",
-	"&equivDD;":                         "\U00002A78",
-	"&eqvparsl;":                        "\U000029E5",
-	"&erDot;":                           "\U00002253",
-	"&erarr;":                           "\U00002971",
-	"&escr;":                            "\U0000212F",
-	"&esdot;":                           "\U00002250",
-	"&esim;":                            "\U00002242",
-	"&eta;":                             "\U000003B7",
-	"&eth;":                             "\U000000F0",
-	"&euml;":                            "\U000000EB",
-	"&euro;":                            "\U000020AC",
-	"&excl;":                            "\U00000021",
-	"&exist;":                           "\U00002203",
-	"&expectation;":                     "\U00002130",
-	"&exponentiale;":                    "\U00002147",
-	"&fallingdotseq;":                   "\U00002252",
-	"&fcy;":                             "\U00000444",
-	"&female;":                          "\U00002640",
-	"&ffilig;":                          "\U0000FB03",
-	"&fflig;":                           "\U0000FB00",
-	"&ffllig;":                          "\U0000FB04",
-	"&ffr;":                             "\U0001D523",
-	"&filig;":                           "\U0000FB01",
-	"&flat;":                            "\U0000266D",
-	"&fllig;":                           "\U0000FB02",
-	"&fltns;":                           "\U000025B1",
-	"&fnof;":                            "\U00000192",
-	"&fopf;":                            "\U0001D557",
-	"&forall;":                          "\U00002200",
-	"&fork;":                            "\U000022D4",
-	"&forkv;":                           "\U00002AD9",
-	"&fpartint;":                        "\U00002A0D",
-	"&frac12;":                          "\U000000BD",
-	"&frac13;":                          "\U00002153",
-	"&frac14;":                          "\U000000BC",
-	"&frac15;":                          "\U00002155",
-	"&frac16;":                          "\U00002159",
-	"&frac18;":                          "\U0000215B",
-	"&frac23;":                          "\U00002154",
-	"&frac25;":                          "\U00002156",
-	"&frac34;":                          "\U000000BE",
-	"&frac35;":                          "\U00002157",
-	"&frac38;":                          "\U0000215C",
-	"&frac45;":                          "\U00002158",
-	"&frac56;":                          "\U0000215A",
-	"&frac58;":                          "\U0000215D",
-	"&frac78;":                          "\U0000215E",
-	"&frasl;":                           "\U00002044",
-	"&frown;":                           "\U00002322",
-	"&fscr;":                            "\U0001D4BB",
-	"&gE;":                              "\U00002267",
-	"&gEl;":                             "\U00002A8C",
-	"&gacute;":                          "\U000001F5",
-	"&gamma;":                           "\U000003B3",
-	"&gammad;":                          "\U000003DD",
-	"&gap;":                             "\U00002A86",
-	"&gbreve;":                          "\U0000011F",
-	"&gcirc;":                           "\U0000011D",
-	"&gcy;":                             "\U00000433",
-	"&gdot;":                            "\U00000121",
-	"&ge;":                              "\U00002265",
-	"&gel;":                             "\U000022DB",
-	"&geq;":                             "\U00002265",
-	"&geqq;":                            "\U00002267",
-	"&geqslant;":                        "\U00002A7E",
-	"&ges;":                             "\U00002A7E",
-	"&gescc;":                           "\U00002AA9",
-	"&gesdot;":                          "\U00002A80",
-	"&gesdoto;":                         "\U00002A82",
-	"&gesdotol;":                        "\U00002A84",
-	"&gesles;":                          "\U00002A94",
-	"&gfr;":                             "\U0001D524",
-	"&gg;":                              "\U0000226B",
-	"&ggg;":                             "\U000022D9",
-	"&gimel;":                           "\U00002137",
-	"&gjcy;":                            "\U00000453",
-	"&gl;":                              "\U00002277",
-	"&glE;":                             "\U00002A92",
-	"&gla;":                             "\U00002AA5",
-	"&glj;":                             "\U00002AA4",
-	"&gnE;":                             "\U00002269",
-	"&gnap;":                            "\U00002A8A",
-	"&gnapprox;":                        "\U00002A8A",
-	"&gne;":                             "\U00002A88",
-	"&gneq;":                            "\U00002A88",
-	"&gneqq;":                           "\U00002269",
-	"&gnsim;":                           "\U000022E7",
-	"&gopf;":                            "\U0001D558",
-	"&grave;":                           "\U00000060",
-	"&gscr;":                            "\U0000210A",
-	"&gsim;":                            "\U00002273",
-	"&gsime;":                           "\U00002A8E",
-	"&gsiml;":                           "\U00002A90",
-	"&gt;":                              "\U0000003E",
-	"&gtcc;":                            "\U00002AA7",
-	"&gtcir;":                           "\U00002A7A",
-	"&gtdot;":                           "\U000022D7",
-	"&gtlPar;":                          "\U00002995",
-	"&gtquest;":                         "\U00002A7C",
-	"&gtrapprox;":                       "\U00002A86",
-	"&gtrarr;":                          "\U00002978",
-	"&gtrdot;":                          "\U000022D7",
-	"&gtreqless;":                       "\U000022DB",
-	"&gtreqqless;":                      "\U00002A8C",
-	"&gtrless;":                         "\U00002277",
-	"&gtrsim;":                          "\U00002273",
-	"&hArr;":                            "\U000021D4",
-	"&hairsp;":                          "\U0000200A",
-	"&half;":                            "\U000000BD",
-	"&hamilt;":                          "\U0000210B",
-	"&hardcy;":                          "\U0000044A",
-	"&harr;":                            "\U00002194",
-	"&harrcir;":                         "\U00002948",
-	"&harrw;":                           "\U000021AD",
-	"&hbar;":                            "\U0000210F",
-	"&hcirc;":                           "\U00000125",
-	"&hearts;":                          "\U00002665",
-	"&heartsuit;":                       "\U00002665",
-	"&hellip;":                          "\U00002026",
-	"&hercon;":                          "\U000022B9",
-	"&hfr;":                             "\U0001D525",
-	"&hksearow;":                        "\U00002925",
-	"&hkswarow;":                        "\U00002926",
-	"&hoarr;":                           "\U000021FF",
-	"&homtht;":                          "\U0000223B",
-	"&hookleftarrow;":                   "\U000021A9",
-	"&hookrightarrow;":                  "\U000021AA",
-	"&hopf;":                            "\U0001D559",
-	"&horbar;":                          "\U00002015",
-	"&hscr;":                            "\U0001D4BD",
-	"&hslash;":                          "\U0000210F",
-	"&hstrok;":                          "\U00000127",
-	"&hybull;":                          "\U00002043",
-	"&hyphen;":                          "\U00002010",
-	"&iacute;":                          "\U000000ED",
-	"&ic;":                              "\U00002063",
-	"&icirc;":                           "\U000000EE",
-	"&icy;":                             "\U00000438",
-	"&iecy;":                            "\U00000435",
-	"&iexcl;":                           "\U000000A1",
-	"&iff;":                             "\U000021D4",
-	"&ifr;":                             "\U0001D526",
-	"&igrave;":                          "\U000000EC",
-	"&ii;":                              "\U00002148",
-	"&iiiint;":                          "\U00002A0C",
-	"&iiint;":                           "\U0000222D",
-	"&iinfin;":                          "\U000029DC",
-	"&iiota;":                           "\U00002129",
-	"&ijlig;":                           "\U00000133",
-	"&imacr;":                           "\U0000012B",
-	"&image;":                           "\U00002111",
-	"&imagline;":                        "\U00002110",
-	"&imagpart;":                        "\U00002111",
-	"&imath;":                           "\U00000131",
-	"&imof;":                            "\U000022B7",
-	"&imped;":                           "\U000001B5",
-	"&in;":                              "\U00002208",
-	"&incare;":                          "\U00002105",
-	"&infin;":                           "\U0000221E",
-	"&infintie;":                        "\U000029DD",
-	"&inodot;":                          "\U00000131",
-	"&int;":                             "\U0000222B",
-	"&intcal;":                          "\U000022BA",
-	"&integers;":                        "\U00002124",
-	"&intercal;":                        "\U000022BA",
-	"&intlarhk;":                        "\U00002A17",
-	"&intprod;":                         "\U00002A3C",
-	"&iocy;":                            "\U00000451",
-	"&iogon;":                           "\U0000012F",
-	"&iopf;":                            "\U0001D55A",
-	"&iota;":                            "\U000003B9",
-	"&iprod;":                           "\U00002A3C",
-	"&iquest;":                          "\U000000BF",
-	"&iscr;":                            "\U0001D4BE",
-	"&isin;":                            "\U00002208",
-	"&isinE;":                           "\U000022F9",
-	"&isindot;":                         "\U000022F5",
-	"&isins;":                           "\U000022F4",
-	"&isinsv;":                          "\U000022F3",
-	"&isinv;":                           "\U00002208",
-	"&it;":                              "\U00002062",
-	"&itilde;":                          "\U00000129",
-	"&iukcy;":                           "\U00000456",
-	"&iuml;":                            "\U000000EF",
-	"&jcirc;":                           "\U00000135",
-	"&jcy;":                             "\U00000439",
-	"&jfr;":                             "\U0001D527",
-	"&jmath;":                           "\U00000237",
-	"&jopf;":                            "\U0001D55B",
-	"&jscr;":                            "\U0001D4BF",
-	"&jsercy;":                          "\U00000458",
-	"&jukcy;":                           "\U00000454",
-	"&kappa;":                           "\U000003BA",
-	"&kappav;":                          "\U000003F0",
-	"&kcedil;":                          "\U00000137",
-	"&kcy;":                             "\U0000043A",
-	"&kfr;":                             "\U0001D528",
-	"&kgreen;":                          "\U00000138",
-	"&khcy;":                            "\U00000445",
-	"&kjcy;":                            "\U0000045C",
-	"&kopf;":                            "\U0001D55C",
-	"&kscr;":                            "\U0001D4C0",
-	"&lAarr;":                           "\U000021DA",
-	"&lArr;":                            "\U000021D0",
-	"&lAtail;":                          "\U0000291B",
-	"&lBarr;":                           "\U0000290E",
-	"&lE;":                              "\U00002266",
-	"&lEg;":                             "\U00002A8B",
-	"&lHar;":                            "\U00002962",
-	"&lacute;":                          "\U0000013A",
-	"&laemptyv;":                        "\U000029B4",
-	"&lagran;":                          "\U00002112",
-	"&lambda;":                          "\U000003BB",
-	"&lang;":                            "\U000027E8",
-	"&langd;":                           "\U00002991",
-	"&langle;":                          "\U000027E8",
-	"&lap;":                             "\U00002A85",
-	"&laquo;":                           "\U000000AB",
-	"&larr;":                            "\U00002190",
-	"&larrb;":                           "\U000021E4",
-	"&larrbfs;":                         "\U0000291F",
-	"&larrfs;":                          "\U0000291D",
-	"&larrhk;":                          "\U000021A9",
-	"&larrlp;":                          "\U000021AB",
-	"&larrpl;":                          "\U00002939",
-	"&larrsim;":                         "\U00002973",
-	"&larrtl;":                          "\U000021A2",
-	"&lat;":                             "\U00002AAB",
-	"&latail;":                          "\U00002919",
-	"&late;":                            "\U00002AAD",
-	"&lbarr;":                           "\U0000290C",
-	"&lbbrk;":                           "\U00002772",
-	"&lbrace;":                          "\U0000007B",
-	"&lbrack;":                          "\U0000005B",
-	"&lbrke;":                           "\U0000298B",
-	"&lbrksld;":                         "\U0000298F",
-	"&lbrkslu;":                         "\U0000298D",
-	"&lcaron;":                          "\U0000013E",
-	"&lcedil;":                          "\U0000013C",
-	"&lceil;":                           "\U00002308",
-	"&lcub;":                            "\U0000007B",
-	"&lcy;":                             "\U0000043B",
-	"&ldca;":                            "\U00002936",
-	"&ldquo;":                           "\U0000201C",
-	"&ldquor;":                          "\U0000201E",
-	"&ldrdhar;":                         "\U00002967",
-	"&ldrushar;":                        "\U0000294B",
-	"&ldsh;":                            "\U000021B2",
-	"&le;":                              "\U00002264",
-	"&leftarrow;":                       "\U00002190",
-	"&leftarrowtail;":                   "\U000021A2",
-	"&leftharpoondown;":                 "\U000021BD",
-	"&leftharpoonup;":                   "\U000021BC",
-	"&leftleftarrows;":                  "\U000021C7",
-	"&leftrightarrow;":                  "\U00002194",
-	"&leftrightarrows;":                 "\U000021C6",
-	"&leftrightharpoons;":               "\U000021CB",
-	"&leftrightsquigarrow;":             "\U000021AD",
-	"&leftthreetimes;":                  "\U000022CB",
-	"&leg;":                             "\U000022DA",
-	"&leq;":                             "\U00002264",
-	"&leqq;":                            "\U00002266",
-	"&leqslant;":                        "\U00002A7D",
-	"&les;":                             "\U00002A7D",
-	"&lescc;":                           "\U00002AA8",
-	"&lesdot;":                          "\U00002A7F",
-	"&lesdoto;":                         "\U00002A81",
-	"&lesdotor;":                        "\U00002A83",
-	"&lesges;":                          "\U00002A93",
-	"&lessapprox;":                      "\U00002A85",
-	"&lessdot;":                         "\U000022D6",
-	"&lesseqgtr;":                       "\U000022DA",
-	"&lesseqqgtr;":                      "\U00002A8B",
-	"&lessgtr;":                         "\U00002276",
-	"&lesssim;":                         "\U00002272",
-	"&lfisht;":                          "\U0000297C",
-	"&lfloor;":                          "\U0000230A",
-	"&lfr;":                             "\U0001D529",
-	"&lg;":                              "\U00002276",
-	"&lgE;":                             "\U00002A91",
-	"&lhard;":                           "\U000021BD",
-	"&lharu;":                           "\U000021BC",
-	"&lharul;":                          "\U0000296A",
-	"&lhblk;":                           "\U00002584",
-	"&ljcy;":                            "\U00000459",
-	"&ll;":                              "\U0000226A",
-	"&llarr;":                           "\U000021C7",
-	"&llcorner;":                        "\U0000231E",
-	"&llhard;":                          "\U0000296B",
-	"&lltri;":                           "\U000025FA",
-	"&lmidot;":                          "\U00000140",
-	"&lmoust;":                          "\U000023B0",
-	"&lmoustache;":                      "\U000023B0",
-	"&lnE;":                             "\U00002268",
-	"&lnap;":                            "\U00002A89",
-	"&lnapprox;":                        "\U00002A89",
-	"&lne;":                             "\U00002A87",
-	"&lneq;":                            "\U00002A87",
-	"&lneqq;":                           "\U00002268",
-	"&lnsim;":                           "\U000022E6",
-	"&loang;":                           "\U000027EC",
-	"&loarr;":                           "\U000021FD",
-	"&lobrk;":                           "\U000027E6",
-	"&longleftarrow;":                   "\U000027F5",
-	"&longleftrightarrow;":              "\U000027F7",
-	"&longmapsto;":                      "\U000027FC",
-	"&longrightarrow;":                  "\U000027F6",
-	"&looparrowleft;":                   "\U000021AB",
-	"&looparrowright;":                  "\U000021AC",
-	"&lopar;":                           "\U00002985",
-	"&lopf;":                            "\U0001D55D",
-	"&loplus;":                          "\U00002A2D",
-	"&lotimes;":                         "\U00002A34",
-	"&lowast;":                          "\U00002217",
-	"&lowbar;":                          "\U0000005F",
-	"&loz;":                             "\U000025CA",
-	"&lozenge;":                         "\U000025CA",
-	"&lozf;":                            "\U000029EB",
-	"&lpar;":                            "\U00000028",
-	"&lparlt;":                          "\U00002993",
-	"&lrarr;":                           "\U000021C6",
-	"&lrcorner;":                        "\U0000231F",
-	"&lrhar;":                           "\U000021CB",
-	"&lrhard;":                          "\U0000296D",
-	"&lrm;":                             "\U0000200E",
-	"&lrtri;":                           "\U000022BF",
-	"&lsaquo;":                          "\U00002039",
-	"&lscr;":                            "\U0001D4C1",
-	"&lsh;":                             "\U000021B0",
-	"&lsim;":                            "\U00002272",
-	"&lsime;":                           "\U00002A8D",
-	"&lsimg;":                           "\U00002A8F",
-	"&lsqb;":                            "\U0000005B",
-	"&lsquo;":                           "\U00002018",
-	"&lsquor;":                          "\U0000201A",
-	"&lstrok;":                          "\U00000142",
-	"&lt;":                              "\U0000003C",
-	"&ltcc;":                            "\U00002AA6",
-	"&ltcir;":                           "\U00002A79",
-	"&ltdot;":                           "\U000022D6",
-	"&lthree;":                          "\U000022CB",
-	"&ltimes;":                          "\U000022C9",
-	"&ltlarr;":                          "\U00002976",
-	"&ltquest;":                         "\U00002A7B",
-	"&ltrPar;":                          "\U00002996",
-	"&ltri;":                            "\U000025C3",
-	"&ltrie;":                           "\U000022B4",
-	"&ltrif;":                           "\U000025C2",
-	"&lurdshar;":                        "\U0000294A",
-	"&luruhar;":                         "\U00002966",
-	"&mDDot;":                           "\U0000223A",
-	"&macr;":                            "\U000000AF",
-	"&male;":                            "\U00002642",
-	"&malt;":                            "\U00002720",
-	"&maltese;":                         "\U00002720",
-	"&map;":                             "\U000021A6",
-	"&mapsto;":                          "\U000021A6",
-	"&mapstodown;":                      "\U000021A7",
-	"&mapstoleft;":                      "\U000021A4",
-	"&mapstoup;":                        "\U000021A5",
-	"&marker;":                          "\U000025AE",
-	"&mcomma;":                          "\U00002A29",
-	"&mcy;":                             "\U0000043C",
-	"&mdash;":                           "\U00002014",
-	"&measuredangle;":                   "\U00002221",
-	"&mfr;":                             "\U0001D52A",
-	"&mho;":                             "\U00002127",
-	"&micro;":                           "\U000000B5",
-	"&mid;":                             "\U00002223",
-	"&midast;":                          "\U0000002A",
-	"&midcir;":                          "\U00002AF0",
-	"&middot;":                          "\U000000B7",
-	"&minus;":                           "\U00002212",
-	"&minusb;":                          "\U0000229F",
-	"&minusd;":                          "\U00002238",
-	"&minusdu;":                         "\U00002A2A",
-	"&mlcp;":                            "\U00002ADB",
-	"&mldr;":                            "\U00002026",
-	"&mnplus;":                          "\U00002213",
-	"&models;":                          "\U000022A7",
-	"&mopf;":                            "\U0001D55E",
-	"&mp;":                              "\U00002213",
-	"&mscr;":                            "\U0001D4C2",
-	"&mstpos;":                          "\U0000223E",
-	"&mu;":                              "\U000003BC",
-	"&multimap;":                        "\U000022B8",
-	"&mumap;":                           "\U000022B8",
-	"&nLeftarrow;":                      "\U000021CD",
-	"&nLeftrightarrow;":                 "\U000021CE",
-	"&nRightarrow;":                     "\U000021CF",
-	"&nVDash;":                          "\U000022AF",
-	"&nVdash;":                          "\U000022AE",
-	"&nabla;":                           "\U00002207",
-	"&nacute;":                          "\U00000144",
-	"&nap;":                             "\U00002249",
-	"&napos;":                           "\U00000149",
-	"&napprox;":                         "\U00002249",
-	"&natur;":                           "\U0000266E",
-	"&natural;":                         "\U0000266E",
-	"&naturals;":                        "\U00002115",
-	"&nbsp;":                            "\U000000A0",
-	"&ncap;":                            "\U00002A43",
-	"&ncaron;":                          "\U00000148",
-	"&ncedil;":                          "\U00000146",
-	"&ncong;":                           "\U00002247",
-	"&ncup;":                            "\U00002A42",
-	"&ncy;":                             "\U0000043D",
-	"&ndash;":                           "\U00002013",
-	"&ne;":                              "\U00002260",
-	"&neArr;":                           "\U000021D7",
-	"&nearhk;":                          "\U00002924",
-	"&nearr;":                           "\U00002197",
-	"&nearrow;":                         "\U00002197",
-	"&nequiv;":                          "\U00002262",
-	"&nesear;":                          "\U00002928",
-	"&nexist;":                          "\U00002204",
-	"&nexists;":                         "\U00002204",
-	"&nfr;":                             "\U0001D52B",
-	"&nge;":                             "\U00002271",
-	"&ngeq;":                            "\U00002271",
-	"&ngsim;":                           "\U00002275",
-	"&ngt;":                             "\U0000226F",
-	"&ngtr;":                            "\U0000226F",
-	"&nhArr;":                           "\U000021CE",
-	"&nharr;":                           "\U000021AE",
-	"&nhpar;":                           "\U00002AF2",
-	"&ni;":                              "\U0000220B",
-	"&nis;":                             "\U000022FC",
-	"&nisd;":                            "\U000022FA",
-	"&niv;":                             "\U0000220B",
-	"&njcy;":                            "\U0000045A",
-	"&nlArr;":                           "\U000021CD",
-	"&nlarr;":                           "\U0000219A",
-	"&nldr;":                            "\U00002025",
-	"&nle;":                             "\U00002270",
-	"&nleftarrow;":                      "\U0000219A",
-	"&nleftrightarrow;":                 "\U000021AE",
-	"&nleq;":                            "\U00002270",
-	"&nless;":                           "\U0000226E",
-	"&nlsim;":                           "\U00002274",
-	"&nlt;":                             "\U0000226E",
-	"&nltri;":                           "\U000022EA",
-	"&nltrie;":                          "\U000022EC",
-	"&nmid;":                            "\U00002224",
-	"&nopf;":                            "\U0001D55F",
-	"&not;":                             "\U000000AC",
-	"&notin;":                           "\U00002209",
-	"&notinva;":                         "\U00002209",
-	"&notinvb;":                         "\U000022F7",
-	"&notinvc;":                         "\U000022F6",
-	"&notni;":                           "\U0000220C",
-	"&notniva;":                         "\U0000220C",
-	"&notnivb;":                         "\U000022FE",
-	"&notnivc;":                         "\U000022FD",
-	"&npar;":                            "\U00002226",
-	"&nparallel;":                       "\U00002226",
-	"&npolint;":                         "\U00002A14",
-	"&npr;":                             "\U00002280",
-	"&nprcue;":                          "\U000022E0",
-	"&nprec;":                           "\U00002280",
-	"&nrArr;":                           "\U000021CF",
-	"&nrarr;":                           "\U0000219B",
-	"&nrightarrow;":                     "\U0000219B",
-	"&nrtri;":                           "\U000022EB",
-	"&nrtrie;":                          "\U000022ED",
-	"&nsc;":                             "\U00002281",
-	"&nsccue;":                          "\U000022E1",
-	"&nscr;":                            "\U0001D4C3",
-	"&nshortmid;":                       "\U00002224",
-	"&nshortparallel;":                  "\U00002226",
-	"&nsim;":                            "\U00002241",
-	"&nsime;":                           "\U00002244",
-	"&nsimeq;":                          "\U00002244",
-	"&nsmid;":                           "\U00002224",
-	"&nspar;":                           "\U00002226",
-	"&nsqsube;":                         "\U000022E2",
-	"&nsqsupe;":                         "\U000022E3",
-	"&nsub;":                            "\U00002284",
-	"&nsube;":                           "\U00002288",
-	"&nsubseteq;":                       "\U00002288",
-	"&nsucc;":                           "\U00002281",
-	"&nsup;":                            "\U00002285",
-	"&nsupe;":                           "\U00002289",
-	"&nsupseteq;":                       "\U00002289",
-	"&ntgl;":                            "\U00002279",
-	"&ntilde;":                          "\U000000F1",
-	"&ntlg;":                            "\U00002278",
-	"&ntriangleleft;":                   "\U000022EA",
-	"&ntrianglelefteq;":                 "\U000022EC",
-	"&ntriangleright;":                  "\U000022EB",
-	"&ntrianglerighteq;":                "\U000022ED",
-	"&nu;":                              "\U000003BD",
-	"&num;":                             "\U00000023",
-	"&numero;":                          "\U00002116",
-	"&numsp;":                           "\U00002007",
-	"&nvDash;":                          "\U000022AD",
-	"&nvHarr;":                          "\U00002904",
-	"&nvdash;":                          "\U000022AC",
-	"&nvinfin;":                         "\U000029DE",
-	"&nvlArr;":                          "\U00002902",
-	"&nvrArr;":                          "\U00002903",
-	"&nwArr;":                           "\U000021D6",
-	"&nwarhk;":                          "\U00002923",
-	"&nwarr;":                           "\U00002196",
-	"&nwarrow;":                         "\U00002196",
-	"&nwnear;":                          "\U00002927",
-	"&oS;":                              "\U000024C8",
-	"&oacute;":                          "\U000000F3",
-	"&oast;":                            "\U0000229B",
-	"&ocir;":                            "\U0000229A",
-	"&ocirc;":                           "\U000000F4",
-	"&ocy;":                             "\U0000043E",
-	"&odash;":                           "\U0000229D",
-	"&odblac;":                          "\U00000151",
-	"&odiv;":                            "\U00002A38",
-	"&odot;":                            "\U00002299",
-	"&odsold;":                          "\U000029BC",
-	"&oelig;":                           "\U00000153",
-	"&ofcir;":                           "\U000029BF",
-	"&ofr;":                             "\U0001D52C",
-	"&ogon;":                            "\U000002DB",
-	"&ograve;":                          "\U000000F2",
-	"&ogt;":                             "\U000029C1",
-	"&ohbar;":                           "\U000029B5",
-	"&ohm;":                             "\U000003A9",
-	"&oint;":                            "\U0000222E",
-	"&olarr;":                           "\U000021BA",
-	"&olcir;":                           "\U000029BE",
-	"&olcross;":                         "\U000029BB",
-	"&oline;":                           "\U0000203E",
-	"&olt;":                             "\U000029C0",
-	"&omacr;":                           "\U0000014D",
-	"&omega;":                           "\U000003C9",
-	"&omicron;":                         "\U000003BF",
-	"&omid;":                            "\U000029B6",
-	"&ominus;":                          "\U00002296",
-	"&oopf;":                            "\U0001D560",
-	"&opar;":                            "\U000029B7",
-	"&operp;":                           "\U000029B9",
-	"&oplus;":                           "\U00002295",
-	"&or;":                              "\U00002228",
-	"&orarr;":                           "\U000021BB",
-	"&ord;":                             "\U00002A5D",
-	"&order;":                           "\U00002134",
-	"&orderof;":                         "\U00002134",
-	"&ordf;":                            "\U000000AA",
-	"&ordm;":                            "\U000000BA",
-	"&origof;":                          "\U000022B6",
-	"&oror;":                            "\U00002A56",
-	"&orslope;":                         "\U00002A57",
-	"&orv;":                             "\U00002A5B",
-	"&oscr;":                            "\U00002134",
-	"&oslash;":                          "\U000000F8",
-	"&osol;":                            "\U00002298",
-	"&otilde;":                          "\U000000F5",
-	"&otimes;":                          "\U00002297",
-	"&otimesas;":                        "\U00002A36",
-	"&ouml;":                            "\U000000F6",
-	"&ovbar;":                           "\U0000233D",
-	"&par;":                             "\U00002225",
-	"&para;":                            "\U000000B6",
-	"&parallel;":                        "\U00002225",
-	"&parsim;":                          "\U00002AF3",
-	"&parsl;":                           "\U00002AFD",
-	"&part;":                            "\U00002202",
-	"&pcy;":                             "\U0000043F",
-	"&percnt;":                          "\U00000025",
-	"&period;":                          "\U0000002E",
-	"&permil;":                          "\U00002030",
-	"&perp;":                            "\U000022A5",
-	"&pertenk;":                         "\U00002031",
-	"&pfr;":                             "\U0001D52D",
-	"&phi;":                             "\U000003C6",
-	"&phiv;":                            "\U000003D5",
-	"&phmmat;":                          "\U00002133",
-	"&phone;":                           "\U0000260E",
-	"&pi;":                              "\U000003C0",
-	"&pitchfork;":                       "\U000022D4",
-	"&piv;":                             "\U000003D6",
-	"&planck;":                          "\U0000210F",
-	"&planckh;":                         "\U0000210E",
-	"&plankv;":                          "\U0000210F",
-	"&plus;":                            "\U0000002B",
-	"&plusacir;":                        "\U00002A23",
-	"&plusb;":                           "\U0000229E",
-	"&pluscir;":                         "\U00002A22",
-	"&plusdo;":                          "\U00002214",
-	"&plusdu;":                          "\U00002A25",
-	"&pluse;":                           "\U00002A72",
-	"&plusmn;":                          "\U000000B1",
-	"&plussim;":                         "\U00002A26",
-	"&plustwo;":                         "\U00002A27",
-	"&pm;":                              "\U000000B1",
-	"&pointint;":                        "\U00002A15",
-	"&popf;":                            "\U0001D561",
-	"&pound;":                           "\U000000A3",
-	"&pr;":                              "\U0000227A",
-	"&prE;":                             "\U00002AB3",
-	"&prap;":                            "\U00002AB7",
-	"&prcue;":                           "\U0000227C",
-	"&pre;":                             "\U00002AAF",
-	"&prec;":                            "\U0000227A",
-	"&precapprox;":                      "\U00002AB7",
-	"&preccurlyeq;":                     "\U0000227C",
-	"&preceq;":                          "\U00002AAF",
-	"&precnapprox;":                     "\U00002AB9",
-	"&precneqq;":                        "\U00002AB5",
-	"&precnsim;":                        "\U000022E8",
-	"&precsim;":                         "\U0000227E",
-	"&prime;":                           "\U00002032",
-	"&primes;":                          "\U00002119",
-	"&prnE;":                            "\U00002AB5",
-	"&prnap;":                           "\U00002AB9",
-	"&prnsim;":                          "\U000022E8",
-	"&prod;":                            "\U0000220F",
-	"&profalar;":                        "\U0000232E",
-	"&profline;":                        "\U00002312",
-	"&profsurf;":                        "\U00002313",
-	"&prop;":                            "\U0000221D",
-	"&propto;":                          "\U0000221D",
-	"&prsim;":                           "\U0000227E",
-	"&prurel;":                          "\U000022B0",
-	"&pscr;":                            "\U0001D4C5",
-	"&psi;":                             "\U000003C8",
-	"&puncsp;":                          "\U00002008",
-	"&qfr;":                             "\U0001D52E",
-	"&qint;":                            "\U00002A0C",
-	"&qopf;":                            "\U0001D562",
-	"&qprime;":                          "\U00002057",
-	"&qscr;":                            "\U0001D4C6",
-	"&quaternions;":                     "\U0000210D",
-	"&quatint;":                         "\U00002A16",
-	"&quest;":                           "\U0000003F",
-	"&questeq;":                         "\U0000225F",
-	"&quot;":                            "\U00000022",
-	"&rAarr;":                           "\U000021DB",
-	"&rArr;":                            "\U000021D2",
-	"&rAtail;":                          "\U0000291C",
-	"&rBarr;":                           "\U0000290F",
-	"&rHar;":                            "\U00002964",
-	"&racute;":                          "\U00000155",
-	"&radic;":                           "\U0000221A",
-	"&raemptyv;":                        "\U000029B3",
-	"&rang;":                            "\U000027E9",
-	"&rangd;":                           "\U00002992",
-	"&range;":                           "\U000029A5",
-	"&rangle;":                          "\U000027E9",
-	"&raquo;":                           "\U000000BB",
-	"&rarr;":                            "\U00002192",
-	"&rarrap;":                          "\U00002975",
-	"&rarrb;":                           "\U000021E5",
-	"&rarrbfs;":                         "\U00002920",
-	"&rarrc;":                           "\U00002933",
-	"&rarrfs;":                          "\U0000291E",
-	"&rarrhk;":                          "\U000021AA",
-	"&rarrlp;":                          "\U000021AC",
-	"&rarrpl;":                          "\U00002945",
-	"&rarrsim;":                         "\U00002974",
-	"&rarrtl;":                          "\U000021A3",
-	"&rarrw;":                           "\U0000219D",
-	"&ratail;":                          "\U0000291A",
-	"&ratio;":                           "\U00002236",
-	"&rationals;":                       "\U0000211A",
-	"&rbarr;":                           "\U0000290D",
-	"&rbbrk;":                           "\U00002773",
-	"&rbrace;":                          "\U0000007D",
-	"&rbrack;":                          "\U0000005D",
-	"&rbrke;":                           "\U0000298C",
-	"&rbrksld;":                         "\U0000298E",
-	"&rbrkslu;":                         "\U00002990",
-	"&rcaron;":                          "\U00000159",
-	"&rcedil;":                          "\U00000157",
-	"&rceil;":                           "\U00002309",
-	"&rcub;":                            "\U0000007D",
-	"&rcy;":                             "\U00000440",
-	"&rdca;":                            "\U00002937",
-	"&rdldhar;":                         "\U00002969",
-	"&rdquo;":                           "\U0000201D",
-	"&rdquor;":                          "\U0000201D",
-	"&rdsh;":                            "\U000021B3",
-	"&real;":                            "\U0000211C",
-	"&realine;":                         "\U0000211B",
-	"&realpart;":                        "\U0000211C",
-	"&reals;":                           "\U0000211D",
-	"&rect;":                            "\U000025AD",
-	"&reg;":                             "\U000000AE",
-	"&rfisht;":                          "\U0000297D",
-	"&rfloor;":                          "\U0000230B",
-	"&rfr;":                             "\U0001D52F",
-	"&rhard;":                           "\U000021C1",
-	"&rharu;":                           "\U000021C0",
-	"&rharul;":                          "\U0000296C",
-	"&rho;":                             "\U000003C1",
-	"&rhov;":                            "\U000003F1",
-	"&rightarrow;":                      "\U00002192",
-	"&rightarrowtail;":                  "\U000021A3",
-	"&rightharpoondown;":                "\U000021C1",
-	"&rightharpoonup;":                  "\U000021C0",
-	"&rightleftarrows;":                 "\U000021C4",
-	"&rightleftharpoons;":               "\U000021CC",
-	"&rightrightarrows;":                "\U000021C9",
-	"&rightsquigarrow;":                 "\U0000219D",
-	"&rightthreetimes;":                 "\U000022CC",
-	"&ring;":                            "\U000002DA",
-	"&risingdotseq;":                    "\U00002253",
-	"&rlarr;":                           "\U000021C4",
-	"&rlhar;":                           "\U000021CC",
-	"&rlm;":                             "\U0000200F",
-	"&rmoust;":                          "\U000023B1",
-	"&rmoustache;":                      "\U000023B1",
-	"&rnmid;":                           "\U00002AEE",
-	"&roang;":                           "\U000027ED",
-	"&roarr;":                           "\U000021FE",
-	"&robrk;":                           "\U000027E7",
-	"&ropar;":                           "\U00002986",
-	"&ropf;":                            "\U0001D563",
-	"&roplus;":                          "\U00002A2E",
-	"&rotimes;":                         "\U00002A35",
-	"&rpar;":                            "\U00000029",
-	"&rpargt;":                          "\U00002994",
-	"&rppolint;":                        "\U00002A12",
-	"&rrarr;":                           "\U000021C9",
-	"&rsaquo;":                          "\U0000203A",
-	"&rscr;":                            "\U0001D4C7",
-	"&rsh;":                             "\U000021B1",
-	"&rsqb;":                            "\U0000005D",
-	"&rsquo;":                           "\U00002019",
-	"&rsquor;":                          "\U00002019",
-	"&rthree;":                          "\U000022CC",
-	"&rtimes;":                          "\U000022CA",
-	"&rtri;":                            "\U000025B9",
-	"&rtrie;":                           "\U000022B5",
-	"&rtrif;":                           "\U000025B8",
-	"&rtriltri;":                        "\U000029CE",
-	"&ruluhar;":                         "\U00002968",
-	"&rx;":                              "\U0000211E",
-	"&sacute;":                          "\U0000015B",
-	"&sbquo;":                           "\U0000201A",
-	"&sc;":                              "\U0000227B",
-	"&scE;":                             "\U00002AB4",
-	"&scap;":                            "\U00002AB8",
-	"&scaron;":                          "\U00000161",
-	"&sccue;":                           "\U0000227D",
-	"&sce;":                             "\U00002AB0",
-	"&scedil;":                          "\U0000015F",
-	"&scirc;":                           "\U0000015D",
-	"&scnE;":                            "\U00002AB6",
-	"&scnap;":                           "\U00002ABA",
-	"&scnsim;":                          "\U000022E9",
-	"&scpolint;":                        "\U00002A13",
-	"&scsim;":                           "\U0000227F",
-	"&scy;":                             "\U00000441",
-	"&sdot;":                            "\U000022C5",
-	"&sdotb;":                           "\U000022A1",
-	"&sdote;":                           "\U00002A66",
-	"&seArr;":                           "\U000021D8",
-	"&searhk;":                          "\U00002925",
-	"&searr;":                           "\U00002198",
-	"&searrow;":                         "\U00002198",
-	"&sect;":                            "\U000000A7",
-	"&semi;":                            "\U0000003B",
-	"&seswar;":                          "\U00002929",
-	"&setminus;":                        "\U00002216",
-	"&setmn;":                           "\U00002216",
-	"&sext;":                            "\U00002736",
-	"&sfr;":                             "\U0001D530",
-	"&sfrown;":                          "\U00002322",
-	"&sharp;":                           "\U0000266F",
-	"&shchcy;":                          "\U00000449",
-	"&shcy;":                            "\U00000448",
-	"&shortmid;":                        "\U00002223",
-	"&shortparallel;":                   "\U00002225",
-	"&shy;":                             "\U000000AD",
-	"&sigma;":                           "\U000003C3",
-	"&sigmaf;":                          "\U000003C2",
-	"&sigmav;":                          "\U000003C2",
-	"&sim;":                             "\U0000223C",
-	"&simdot;":                          "\U00002A6A",
-	"&sime;":                            "\U00002243",
-	"&simeq;":                           "\U00002243",
-	"&simg;":                            "\U00002A9E",
-	"&simgE;":                           "\U00002AA0",
-	"&siml;":                            "\U00002A9D",
-	"&simlE;":                           "\U00002A9F",
-	"&simne;":                           "\U00002246",
-	"&simplus;":                         "\U00002A24",
-	"&simrarr;":                         "\U00002972",
-	"&slarr;":                           "\U00002190",
-	"&smallsetminus;":                   "\U00002216",
-	"&smashp;":                          "\U00002A33",
-	"&smeparsl;":                        "\U000029E4",
-	"&smid;":                            "\U00002223",
-	"&smile;":                           "\U00002323",
-	"&smt;":                             "\U00002AAA",
-	"&smte;":                            "\U00002AAC",
-	"&softcy;":                          "\U0000044C",
-	"&sol;":                             "\U0000002F",
-	"&solb;":                            "\U000029C4",
-	"&solbar;":                          "\U0000233F",
-	"&sopf;":                            "\U0001D564",
-	"&spades;":                          "\U00002660",
-	"&spadesuit;":                       "\U00002660",
-	"&spar;":                            "\U00002225",
-	"&sqcap;":                           "\U00002293",
-	"&sqcup;":                           "\U00002294",
-	"&sqsub;":                           "\U0000228F",
-	"&sqsube;":                          "\U00002291",
-	"&sqsubset;":                        "\U0000228F",
-	"&sqsubseteq;":                      "\U00002291",
-	"&sqsup;":                           "\U00002290",
-	"&sqsupe;":                          "\U00002292",
-	"&sqsupset;":                        "\U00002290",
-	"&sqsupseteq;":                      "\U00002292",
-	"&squ;":                             "\U000025A1",
-	"&square;":                          "\U000025A1",
-	"&squarf;":                          "\U000025AA",
-	"&squf;":                            "\U000025AA",
-	"&srarr;":                           "\U00002192",
-	"&sscr;":                            "\U0001D4C8",
-	"&ssetmn;":                          "\U00002216",
-	"&ssmile;":                          "\U00002323",
-	"&sstarf;":                          "\U000022C6",
-	"&star;":                            "\U00002606",
-	"&starf;":                           "\U00002605",
-	"&straightepsilon;":                 "\U000003F5",
-	"&straightphi;":                     "\U000003D5",
-	"&strns;":                           "\U000000AF",
-	"&sub;":                             "\U00002282",
-	"&subE;":                            "\U00002AC5",
-	"&subdot;":                          "\U00002ABD",
-	"&sube;":                            "\U00002286",
-	"&subedot;":                         "\U00002AC3",
-	"&submult;":                         "\U00002AC1",
-	"&subnE;":                           "\U00002ACB",
-	"&subne;":                           "\U0000228A",
-	"&subplus;":                         "\U00002ABF",
-	"&subrarr;":                         "\U00002979",
-	"&subset;":                          "\U00002282",
-	"&subseteq;":                        "\U00002286",
-	"&subseteqq;":                       "\U00002AC5",
-	"&subsetneq;":                       "\U0000228A",
-	"&subsetneqq;":                      "\U00002ACB",
-	"&subsim;":                          "\U00002AC7",
-	"&subsub;":                          "\U00002AD5",
-	"&subsup;":                          "\U00002AD3",
-	"&succ;":                            "\U0000227B",
-	"&succapprox;":                      "\U00002AB8",
-	"&succcurlyeq;":                     "\U0000227D",
-	"&succeq;":                          "\U00002AB0",
-	"&succnapprox;":                     "\U00002ABA",
-	"&succneqq;":                        "\U00002AB6",
-	"&succnsim;":                        "\U000022E9",
-	"&succsim;":                         "\U0000227F",
-	"&sum;":                             "\U00002211",
-	"&sung;":                            "\U0000266A",
-	"&sup;":                             "\U00002283",
-	"&sup1;":                            "\U000000B9",
-	"&sup2;":                            "\U000000B2",
-	"&sup3;":                            "\U000000B3",
-	"&supE;":                            "\U00002AC6",
-	"&supdot;":                          "\U00002ABE",
-	"&supdsub;":                         "\U00002AD8",
-	"&supe;":                            "\U00002287",
-	"&supedot;":                         "\U00002AC4",
-	"&suphsol;":                         "\U000027C9",
-	"&suphsub;":                         "\U00002AD7",
-	"&suplarr;":                         "\U0000297B",
-	"&supmult;":                         "\U00002AC2",
-	"&supnE;":                           "\U00002ACC",
-	"&supne;":                           "\U0000228B",
-	"&supplus;":                         "\U00002AC0",
-	"&supset;":                          "\U00002283",
-	"&supseteq;":                        "\U00002287",
-	"&supseteqq;":                       "\U00002AC6",
-	"&supsetneq;":                       "\U0000228B",
-	"&supsetneqq;":                      "\U00002ACC",
-	"&supsim;":                          "\U00002AC8",
-	"&supsub;":                          "\U00002AD4",
-	"&supsup;":                          "\U00002AD6",
-	"&swArr;":                           "\U000021D9",
-	"&swarhk;":                          "\U00002926",
-	"&swarr;":                           "\U00002199",
-	"&swarrow;":                         "\U00002199",
-	"&swnwar;":                          "\U0000292A",
-	"&szlig;":                           "\U000000DF",
-	"&target;":                          "\U00002316",
-	"&tau;":                             "\U000003C4",
-	"&tbrk;":                            "\U000023B4",
-	"&tcaron;":                          "\U00000165",
-	"&tcedil;":                          "\U00000163",
-	"&tcy;":                             "\U00000442",
-	"&tdot;":                            "\U000020DB",
-	"&telrec;":                          "\U00002315",
-	"&tfr;":                             "\U0001D531",
-	"&there4;":                          "\U00002234",
-	"&therefore;":                       "\U00002234",
-	"&theta;":                           "\U000003B8",
-	"&thetasym;":                        "\U000003D1",
-	"&thetav;":                          "\U000003D1",
-	"&thickapprox;":                     "\U00002248",
-	"&thicksim;":                        "\U0000223C",
-	"&thinsp;":                          "\U00002009",
-	"&thkap;":                           "\U00002248",
-	"&thksim;":                          "\U0000223C",
-	"&thorn;":                           "\U000000FE",
-	"&tilde;":                           "\U000002DC",
-	"&times;":                           "\U000000D7",
-	"&timesb;":                          "\U000022A0",
-	"&timesbar;":                        "\U00002A31",
-	"&timesd;":                          "\U00002A30",
-	"&tint;":                            "\U0000222D",
-	"&toea;":                            "\U00002928",
-	"&top;":                             "\U000022A4",
-	"&topbot;":                          "\U00002336",
-	"&topcir;":                          "\U00002AF1",
-	"&topf;":                            "\U0001D565",
-	"&topfork;":                         "\U00002ADA",
-	"&tosa;":                            "\U00002929",
-	"&tprime;":                          "\U00002034",
-	"&trade;":                           "\U00002122",
-	"&triangle;":                        "\U000025B5",
-	"&triangledown;":                    "\U000025BF",
-	"&triangleleft;":                    "\U000025C3",
-	"&trianglelefteq;":                  "\U000022B4",
-	"&triangleq;":                       "\U0000225C",
-	"&triangleright;":                   "\U000025B9",
-	"&trianglerighteq;":                 "\U000022B5",
-	"&tridot;":                          "\U000025EC",
-	"&trie;":                            "\U0000225C",
-	"&triminus;":                        "\U00002A3A",
-	"&triplus;":                         "\U00002A39",
-	"&trisb;":                           "\U000029CD",
-	"&tritime;":                         "\U00002A3B",
-	"&trpezium;":                        "\U000023E2",
-	"&tscr;":                            "\U0001D4C9",
-	"&tscy;":                            "\U00000446",
-	"&tshcy;":                           "\U0000045B",
-	"&tstrok;":                          "\U00000167",
-	"&twixt;":                           "\U0000226C",
-	"&twoheadleftarrow;":                "\U0000219E",
-	"&twoheadrightarrow;":               "\U000021A0",
-	"&uArr;":                            "\U000021D1",
-	"&uHar;":                            "\U00002963",
-	"&uacute;":                          "\U000000FA",
-	"&uarr;":                            "\U00002191",
-	"&ubrcy;":                           "\U0000045E",
-	"&ubreve;":                          "\U0000016D",
-	"&ucirc;":                           "\U000000FB",
-	"&ucy;":                             "\U00000443",
-	"&udarr;":                           "\U000021C5",
-	"&udblac;":                          "\U00000171",
-	"&udhar;":                           "\U0000296E",
-	"&ufisht;":                          "\U0000297E",
-	"&ufr;":                             "\U0001D532",
-	"&ugrave;":                          "\U000000F9",
-	"&uharl;":                           "\U000021BF",
-	"&uharr;":                           "\U000021BE",
-	"&uhblk;":                           "\U00002580",
-	"&ulcorn;":                          "\U0000231C",
-	"&ulcorner;":                        "\U0000231C",
-	"&ulcrop;":                          "\U0000230F",
-	"&ultri;":                           "\U000025F8",
-	"&umacr;":                           "\U0000016B",
-	"&uml;":                             "\U000000A8",
-	"&uogon;":                           "\U00000173",
-	"&uopf;":                            "\U0001D566",
-	"&uparrow;":                         "\U00002191",
-	"&updownarrow;":                     "\U00002195",
-	"&upharpoonleft;":                   "\U000021BF",
-	"&upharpoonright;":                  "\U000021BE",
-	"&uplus;":                           "\U0000228E",
-	"&upsi;":                            "\U000003C5",
-	"&upsih;":                           "\U000003D2",
-	"&upsilon;":                         "\U000003C5",
-	"&upuparrows;":                      "\U000021C8",
-	"&urcorn;":                          "\U0000231D",
-	"&urcorner;":                        "\U0000231D",
-	"&urcrop;":                          "\U0000230E",
-	"&uring;":                           "\U0000016F",
-	"&urtri;":                           "\U000025F9",
-	"&uscr;":                            "\U0001D4CA",
-	"&utdot;":                           "\U000022F0",
-	"&utilde;":                          "\U00000169",
-	"&utri;":                            "\U000025B5",
-	"&utrif;":                           "\U000025B4",
-	"&uuarr;":                           "\U000021C8",
-	"&uuml;":                            "\U000000FC",
-	"&uwangle;":                         "\U000029A7",
-	"&vArr;":                            "\U000021D5",
-	"&vBar;":                            "\U00002AE8",
-	"&vBarv;":                           "\U00002AE9",
-	"&vDash;":                           "\U000022A8",
-	"&vangrt;":                          "\U0000299C",
-	"&varepsilon;":                      "\U000003F5",
-	"&varkappa;":                        "\U000003F0",
-	"&varnothing;":                      "\U00002205",
-	"&varphi;":                          "\U000003D5",
-	"&varpi;":                           "\U000003D6",
-	"&varpropto;":                       "\U0000221D",
-	"&varr;":                            "\U00002195",
-	"&varrho;":                          "\U000003F1",
-	"&varsigma;":                        "\U000003C2",
-	"&vartheta;":                        "\U000003D1",
-	"&vartriangleleft;":                 "\U000022B2",
-	"&vartriangleright;":                "\U000022B3",
-	"&vcy;":                             "\U00000432",
-	"&vdash;":                           "\U000022A2",
-	"&vee;":                             "\U00002228",
-	"&veebar;":                          "\U000022BB",
-	"&veeeq;":                           "\U0000225A",
-	"&vellip;":                          "\U000022EE",
-	"&verbar;":                          "\U0000007C",
-	"&vert;":                            "\U0000007C",
-	"&vfr;":                             "\U0001D533",
-	"&vltri;":                           "\U000022B2",
-	"&vopf;":                            "\U0001D567",
-	"&vprop;":                           "\U0000221D",
-	"&vrtri;":                           "\U000022B3",
-	"&vscr;":                            "\U0001D4CB",
-	"&vzigzag;":                         "\U0000299A",
-	"&wcirc;":                           "\U00000175",
-	"&wedbar;":                          "\U00002A5F",
-	"&wedge;":                           "\U00002227",
-	"&wedgeq;":                          "\U00002259",
-	"&weierp;":                          "\U00002118",
-	"&wfr;":                             "\U0001D534",
-	"&wopf;":                            "\U0001D568",
-	"&wp;":                              "\U00002118",
-	"&wr;":                              "\U00002240",
-	"&wreath;":                          "\U00002240",
-	"&wscr;":                            "\U0001D4CC",
-	"&xcap;":                            "\U000022C2",
-	"&xcirc;":                           "\U000025EF",
-	"&xcup;":                            "\U000022C3",
-	"&xdtri;":                           "\U000025BD",
-	"&xfr;":                             "\U0001D535",
-	"&xhArr;":                           "\U000027FA",
-	"&xharr;":                           "\U000027F7",
-	"&xi;":                              "\U000003BE",
-	"&xlArr;":                           "\U000027F8",
-	"&xlarr;":                           "\U000027F5",
-	"&xmap;":                            "\U000027FC",
-	"&xnis;":                            "\U000022FB",
-	"&xodot;":                           "\U00002A00",
-	"&xopf;":                            "\U0001D569",
-	"&xoplus;":                          "\U00002A01",
-	"&xotime;":                          "\U00002A02",
-	"&xrArr;":                           "\U000027F9",
-	"&xrarr;":                           "\U000027F6",
-	"&xscr;":                            "\U0001D4CD",
-	"&xsqcup;":                          "\U00002A06",
-	"&xuplus;":                          "\U00002A04",
-	"&xutri;":                           "\U000025B3",
-	"&xvee;":                            "\U000022C1",
-	"&xwedge;":                          "\U000022C0",
-	"&yacute;":                          "\U000000FD",
-	"&yacy;":                            "\U0000044F",
-	"&ycirc;":                           "\U00000177",
-	"&ycy;":                             "\U0000044B",
-	"&yen;":                             "\U000000A5",
-	"&yfr;":                             "\U0001D536",
-	"&yicy;":                            "\U00000457",
-	"&yopf;":                            "\U0001D56A",
-	"&yscr;":                            "\U0001D4CE",
-	"&yucy;":                            "\U0000044E",
-	"&yuml;":                            "\U000000FF",
-	"&zacute;":                          "\U0000017A",
-	"&zcaron;":                          "\U0000017E",
-	"&zcy;":                             "\U00000437",
-	"&zdot;":                            "\U0000017C",
-	"&zeetrf;":                          "\U00002128",
-	"&zeta;":                            "\U000003B6",
-	"&zfr;":                             "\U0001D537",
-	"&zhcy;":                            "\U00000436",
-	"&zigrarr;":                         "\U000021DD",
-	"&zopf;":                            "\U0001D56B",
-	"&zscr;":                            "\U0001D4CF",
-	"&zwj;":                             "\U0000200D",
-	"&zwnj;":                            "\U0000200C",
-	"&AElig":                            "\U000000C6",
-	"&AMP":                              "\U00000026",
-	"&Aacute":                           "\U000000C1",
-	"&Acirc":                            "\U000000C2",
-	"&Agrave":                           "\U000000C0",
-	"&Aring":                            "\U000000C5",
-	"&Atilde":                           "\U000000C3",
-	"&Auml":                             "\U000000C4",
-	"&COPY":                             "\U000000A9",
-	"&Ccedil":                           "\U000000C7",
-	"&ETH":                              "\U000000D0",
-	"&Eacute":                           "\U000000C9",
-	"&Ecirc":                            "\U000000CA",
-	"&Egrave":                           "\U000000C8",
-	"&Euml":                             "\U000000CB",
-	"&GT":                               "\U0000003E",
-	"&Iacute":                           "\U000000CD",
-	"&Icirc":                            "\U000000CE",
-	"&Igrave":                           "\U000000CC",
-	"&Iuml":                             "\U000000CF",
-	"&LT":                               "\U0000003C",
-	"&Ntilde":                           "\U000000D1",
-	"&Oacute":                           "\U000000D3",
-	"&Ocirc":                            "\U000000D4",
-	"&Ograve":                           "\U000000D2",
-	"&Oslash":                           "\U000000D8",
-	"&Otilde":                           "\U000000D5",
-	"&Ouml":                             "\U000000D6",
-	"&QUOT":                             "\U00000022",
-	"&REG":                              "\U000000AE",
-	"&THORN":                            "\U000000DE",
-	"&Uacute":                           "\U000000DA",
-	"&Ucirc":                            "\U000000DB",
-	"&Ugrave":                           "\U000000D9",
-	"&Uuml":                             "\U000000DC",
-	"&Yacute":                           "\U000000DD",
-	"&aacute":                           "\U000000E1",
-	"&acirc":                            "\U000000E2",
-	"&acute":                            "\U000000B4",
-	"&aelig":                            "\U000000E6",
-	"&agrave":                           "\U000000E0",
-	"&amp":                              "\U00000026",
-	"&aring":                            "\U000000E5",
-	"&atilde":                           "\U000000E3",
-	"&auml":                             "\U000000E4",
-	"&brvbar":                           "\U000000A6",
-	"&ccedil":                           "\U000000E7",
-	"&cedil":                            "\U000000B8",
-	"&cent":                             "\U000000A2",
-	"&copy":                             "\U000000A9",
-	"&curren":                           "\U000000A4",
-	"&deg":                              "\U000000B0",
-	"&divide":                           "\U000000F7",
-	"&eacute":                           "\U000000E9",
-	"&ecirc":                            "\U000000EA",
-	"&egrave":                           "\U000000E8",
-	"&eth":                              "\U000000F0",
-	"&euml":                             "\U000000EB",
-	"&frac12":                           "\U000000BD",
-	"&frac14":                           "\U000000BC",
-	"&frac34":                           "\U000000BE",
-	"&gt":                               "\U0000003E",
-	"&iacute":                           "\U000000ED",
-	"&icirc":                            "\U000000EE",
-	"&iexcl":                            "\U000000A1",
-	"&igrave":                           "\U000000EC",
-	"&iquest":                           "\U000000BF",
-	"&iuml":                             "\U000000EF",
-	"&laquo":                            "\U000000AB",
-	"&lt":                               "\U0000003C",
-	"&macr":                             "\U000000AF",
-	"&micro":                            "\U000000B5",
-	"&middot":                           "\U000000B7",
-	"&nbsp":                             "\U000000A0",
-	"&not":                              "\U000000AC",
-	"&ntilde":                           "\U000000F1",
-	"&oacute":                           "\U000000F3",
-	"&ocirc":                            "\U000000F4",
-	"&ograve":                           "\U000000F2",
-	"&ordf":                             "\U000000AA",
-	"&ordm":                             "\U000000BA",
-	"&oslash":                           "\U000000F8",
-	"&otilde":                           "\U000000F5",
-	"&ouml":                             "\U000000F6",
-	"&para":                             "\U000000B6",
-	"&plusmn":                           "\U000000B1",
-	"&pound":                            "\U000000A3",
-	"&quot":                             "\U00000022",
-	"&raquo":                            "\U000000BB",
-	"&reg":                              "\U000000AE",
-	"&sect":                             "\U000000A7",
-	"&shy":                              "\U000000AD",
-	"&sup1":                             "\U000000B9",
-	"&sup2":                             "\U000000B2",
-	"&sup3":                             "\U000000B3",
-	"&szlig":                            "\U000000DF",
-	"&thorn":                            "\U000000FE",
-	"&times":                            "\U000000D7",
-	"&uacute":                           "\U000000FA",
-	"&ucirc":                            "\U000000FB",
-	"&ugrave":                           "\U000000F9",
-	"&uml":                              "\U000000A8",
-	"&uuml":                             "\U000000FC",
-	"&yacute":                           "\U000000FD",
-	"&yen":                              "\U000000A5",
-	"&yuml":                             "\U000000FF",
-	"&nLt;":                             "\u226A\u20D2",
-	"&nGt;":                             "\u226B\u20D2",
-	"&NotEqualTilde;":                   "\u2242\u0338",
-	"&NotGreaterFullEqual;":             "\u2267\u0338",
-	"&NotGreaterGreater;":               "\u226B\u0338",
-	"&NotGreaterSlantEqual;":            "\u2A7E\u0338",
-	"&NotHumpDownHump;":                 "\u224E\u0338",
-	"&NotHumpEqual;":                    "\u224F\u0338",
-	"&NotLeftTriangleBar;":              "\u29CF\u0338",
-	"&NotLessLess;":                     "\u226A\u0338",
-	"&NotLessSlantEqual;":               "\u2A7D\u0338",
-	"&NotNestedGreaterGreater;":         "\u2AA2\u0338",
-	"&NotNestedLessLess;":               "\u2AA1\u0338",
-	"&NotPrecedesEqual;":                "\u2AAF\u0338",
-	"&NotRightTriangleBar;":             "\u29D0\u0338",
-	"&NotSquareSubset;":                 "\u228F\u0338",
-	"&NotSquareSuperset;":               "\u2290\u0338",
-	"&NotSubset;":                       "\u2282\u20D2",
-	"&NotSucceedsEqual;":                "\u2AB0\u0338",
-	"&NotSucceedsTilde;":                "\u227F\u0338",
-	"&NotSuperset;":                     "\u2283\u20D2",
-	"&ThickSpace;":                      "\u205F\u200A",
-	"&acE;":                             "\u223E\u0333",
-	"&bne;":                             "\u003D\u20E5",
-	"&bnequiv;":                         "\u2261\u20E5",
-	"&caps;":                            "\u2229\uFE00",
-	"&cups;":                            "\u222A\uFE00",
-	"&fjlig;":                           "\u0066\u006A",
-	"&gesl;":                            "\u22DB\uFE00",
-	"&gvertneqq;":                       "\u2269\uFE00",
-	"&gvnE;":                            "\u2269\uFE00",
-	"&lates;":                           "\u2AAD\uFE00",
-	"&lesg;":                            "\u22DA\uFE00",
-	"&lvertneqq;":                       "\u2268\uFE00",
-	"&lvnE;":                            "\u2268\uFE00",
-	"&nGg;":                             "\u22D9\u0338",
-	"&nGtv;":                            "\u226B\u0338",
-	"&nLl;":                             "\u22D8\u0338",
-	"&nLtv;":                            "\u226A\u0338",
-	"&nang;":                            "\u2220\u20D2",
-	"&napE;":                            "\u2A70\u0338",
-	"&napid;":                           "\u224B\u0338",
-	"&nbump;":                           "\u224E\u0338",
-	"&nbumpe;":                          "\u224F\u0338",
-	"&ncongdot;":                        "\u2A6D\u0338",
-	"&nedot;":                           "\u2250\u0338",
-	"&nesim;":                           "\u2242\u0338",
-	"&ngE;":                             "\u2267\u0338",
-	"&ngeqq;":                           "\u2267\u0338",
-	"&ngeqslant;":                       "\u2A7E\u0338",
-	"&nges;":                            "\u2A7E\u0338",
-	"&nlE;":                             "\u2266\u0338",
-	"&nleqq;":                           "\u2266\u0338",
-	"&nleqslant;":                       "\u2A7D\u0338",
-	"&nles;":                            "\u2A7D\u0338",
-	"&notinE;":                          "\u22F9\u0338",
-	"&notindot;":                        "\u22F5\u0338",
-	"&nparsl;":                          "\u2AFD\u20E5",
-	"&npart;":                           "\u2202\u0338",
-	"&npre;":                            "\u2AAF\u0338",
-	"&npreceq;":                         "\u2AAF\u0338",
-	"&nrarrc;":                          "\u2933\u0338",
-	"&nrarrw;":                          "\u219D\u0338",
-	"&nsce;":                            "\u2AB0\u0338",
-	"&nsubE;":                           "\u2AC5\u0338",
-	"&nsubset;":                         "\u2282\u20D2",
-	"&nsubseteqq;":                      "\u2AC5\u0338",
-	"&nsucceq;":                         "\u2AB0\u0338",
-	"&nsupE;":                           "\u2AC6\u0338",
-	"&nsupset;":                         "\u2283\u20D2",
-	"&nsupseteqq;":                      "\u2AC6\u0338",
-	"&nvap;":                            "\u224D\u20D2",
-	"&nvge;":                            "\u2265\u20D2",
-	"&nvgt;":                            "\u003E\u20D2",
-	"&nvle;":                            "\u2264\u20D2",
-	"&nvlt;":                            "\u003C\u20D2",
-	"&nvltrie;":                         "\u22B4\u20D2",
-	"&nvrtrie;":                         "\u22B5\u20D2",
-	"&nvsim;":                           "\u223C\u20D2",
-	"&race;":                            "\u223D\u0331",
-	"&smtes;":                           "\u2AAC\uFE00",
-	"&sqcaps;":                          "\u2293\uFE00",
-	"&sqcups;":                          "\u2294\uFE00",
-	"&varsubsetneq;":                    "\u228A\uFE00",
-	"&varsubsetneqq;":                   "\u2ACB\uFE00",
-	"&varsupsetneq;":                    "\u228B\uFE00",
-	"&varsupsetneqq;":                   "\u2ACC\uFE00",
-	"&vnsub;":                           "\u2282\u20D2",
-	"&vnsup;":                           "\u2283\u20D2",
-	"&vsubnE;":                          "\u2ACB\uFE00",
-	"&vsubne;":                          "\u228A\uFE00",
-	"&vsupnE;":                          "\u2ACC\uFE00",
-	"&vsupne;":                          "\u228B\uFE00",
+const entityData = "" +
+	"AElig\x00\u00c6\x00" +
+	"AElig;\x00\u00c6\x00" +
+	"AMP\x00&\x00" +
+	"AMP;\x00&\x00" +
+	"Aacute\x00\u00c1\x00" +
+	"Aacute;\x00\u00c1\x00" +
+	"Abreve;\x00\u0102\x00" +
+	"Acirc\x00\u00c2\x00" +
+	"Acirc;\x00\u00c2\x00" +
+	"Acy;\x00\u0410\x00" +
+	"Afr;\x00\U0001d504\x00" +
+	"Agrave\x00\u00c0\x00" +
+	"Agrave;\x00\u00c0\x00" +
+	"Alpha;\x00\u0391\x00" +
+	"Amacr;\x00\u0100\x00" +
+	"And;\x00\u2a53\x00" +
+	"Aogon;\x00\u0104\x00" +
+	"Aopf;\x00\U0001d538\x00" +
+	"ApplyFunction;\x00\u2061\x00" +
+	"Aring\x00\u00c5\x00" +
+	"Aring;\x00\u00c5\x00" +
+	"Ascr;\x00\U0001d49c\x00" +
+	"Assign;\x00\u2254\x00" +
+	"Atilde\x00\u00c3\x00" +
+	"Atilde;\x00\u00c3\x00" +
+	"Auml\x00\u00c4\x00" +
+	"Auml;\x00\u00c4\x00" +
+	"Backslash;\x00\u2216\x00" +
+	"Barv;\x00\u2ae7\x00" +
+	"Barwed;\x00\u2306\x00" +
+	"Bcy;\x00\u0411\x00" +
+	"Because;\x00\u2235\x00" +
+	"Bernoullis;\x00\u212c\x00" +
+	"Beta;\x00\u0392\x00" +
+	"Bfr;\x00\U0001d505\x00" +
+	"Bopf;\x00\U0001d539\x00" +
+	"Breve;\x00\u02d8\x00" +
+	"Bscr;\x00\u212c\x00" +
+	"Bumpeq;\x00\u224e\x00" +
+	"CHcy;\x00\u0427\x00" +
+	"COPY\x00\u00a9\x00" +
+	"COPY;\x00\u00a9\x00" +
+	"Cacute;\x00\u0106\x00" +
+	"Cap;\x00\u22d2\x00" +
+	"CapitalDifferentialD;\x00\u2145\x00" +
+	"Cayleys;\x00\u212d\x00" +
+	"Ccaron;\x00\u010c\x00" +
+	"Ccedil\x00\u00c7\x00" +
+	"Ccedil;\x00\u00c7\x00" +
+	"Ccirc;\x00\u0108\x00" +
+	"Cconint;\x00\u2230\x00" +
+	"Cdot;\x00\u010a\x00" +
+	"Cedilla;\x00\u00b8\x00" +
+	"CenterDot;\x00\u00b7\x00" +
+	"Cfr;\x00\u212d\x00" +
+	"Chi;\x00\u03a7\x00" +
+	"CircleDot;\x00\u2299\x00" +
+	"CircleMinus;\x00\u2296\x00" +
+	"CirclePlus;\x00\u2295\x00" +
+	"CircleTimes;\x00\u2297\x00" +
+	"ClockwiseContourIntegral;\x00\u2232\x00" +
+	"CloseCurlyDoubleQuote;\x00\u201d\x00" +
+	"CloseCurlyQuote;\x00\u2019\x00" +
+	"Colon;\x00\u2237\x00" +
+	"Colone;\x00\u2a74\x00" +
+	"Congruent;\x00\u2261\x00" +
+	"Conint;\x00\u222f\x00" +
+	"ContourIntegral;\x00\u222e\x00" +
+	"Copf;\x00\u2102\x00" +
+	"Coproduct;\x00\u2210\x00" +
+	"CounterClockwiseContourIntegral;\x00\u2233\x00" +
+	"Cross;\x00\u2a2f\x00" +
+	"Cscr;\x00\U0001d49e\x00" +
+	"Cup;\x00\u22d3\x00" +
+	"CupCap;\x00\u224d\x00" +
+	"DD;\x00\u2145\x00" +
+	"DDotrahd;\x00\u2911\x00" +
+	"DJcy;\x00\u0402\x00" +
+	"DScy;\x00\u0405\x00" +
+	"DZcy;\x00\u040f\x00" +
+	"Dagger;\x00\u2021\x00" +
+	"Darr;\x00\u21a1\x00" +
+	"Dashv;\x00\u2ae4\x00" +
+	"Dcaron;\x00\u010e\x00" +
+	"Dcy;\x00\u0414\x00" +
+	"Del;\x00\u2207\x00" +
+	"Delta;\x00\u0394\x00" +
+	"Dfr;\x00\U0001d507\x00" +
+	"DiacriticalAcute;\x00\u00b4\x00" +
+	"DiacriticalDot;\x00\u02d9\x00" +
+	"DiacriticalDoubleAcute;\x00\u02dd\x00" +
+	"DiacriticalGrave;\x00`\x00" +
+	"DiacriticalTilde;\x00\u02dc\x00" +
+	"Diamond;\x00\u22c4\x00" +
+	"DifferentialD;\x00\u2146\x00" +
+	"Dopf;\x00\U0001d53b\x00" +
+	"Dot;\x00\u00a8\x00" +
+	"DotDot;\x00\u20dc\x00" +
+	"DotEqual;\x00\u2250\x00" +
+	"DoubleContourIntegral;\x00\u222f\x00" +
+	"DoubleDot;\x00\u00a8\x00" +
+	"DoubleDownArrow;\x00\u21d3\x00" +
+	"DoubleLeftArrow;\x00\u21d0\x00" +
+	"DoubleLeftRightArrow;\x00\u21d4\x00" +
+	"DoubleLeftTee;\x00\u2ae4\x00" +
+	"DoubleLongLeftArrow;\x00\u27f8\x00" +
+	"DoubleLongLeftRightArrow;\x00\u27fa\x00" +
+	"DoubleLongRightArrow;\x00\u27f9\x00" +
+	"DoubleRightArrow;\x00\u21d2\x00" +
+	"DoubleRightTee;\x00\u22a8\x00" +
+	"DoubleUpArrow;\x00\u21d1\x00" +
+	"DoubleUpDownArrow;\x00\u21d5\x00" +
+	"DoubleVerticalBar;\x00\u2225\x00" +
+	"DownArrow;\x00\u2193\x00" +
+	"DownArrowBar;\x00\u2913\x00" +
+	"DownArrowUpArrow;\x00\u21f5\x00" +
+	"DownBreve;\x00\u0311\x00" +
+	"DownLeftRightVector;\x00\u2950\x00" +
+	"DownLeftTeeVector;\x00\u295e\x00" +
+	"DownLeftVector;\x00\u21bd\x00" +
+	"DownLeftVectorBar;\x00\u2956\x00" +
+	"DownRightTeeVector;\x00\u295f\x00" +
+	"DownRightVector;\x00\u21c1\x00" +
+	"DownRightVectorBar;\x00\u2957\x00" +
+	"DownTee;\x00\u22a4\x00" +
+	"DownTeeArrow;\x00\u21a7\x00" +
+	"Downarrow;\x00\u21d3\x00" +
+	"Dscr;\x00\U0001d49f\x00" +
+	"Dstrok;\x00\u0110\x00" +
+	"ENG;\x00\u014a\x00" +
+	"ETH\x00\u00d0\x00" +
+	"ETH;\x00\u00d0\x00" +
+	"Eacute\x00\u00c9\x00" +
+	"Eacute;\x00\u00c9\x00" +
+	"Ecaron;\x00\u011a\x00" +
+	"Ecirc\x00\u00ca\x00" +
+	"Ecirc;\x00\u00ca\x00" +
+	"Ecy;\x00\u042d\x00" +
+	"Edot;\x00\u0116\x00" +
+	"Efr;\x00\U0001d508\x00" +
+	"Egrave\x00\u00c8\x00" +
+	"Egrave;\x00\u00c8\x00" +
+	"Element;\x00\u2208\x00" +
+	"Emacr;\x00\u0112\x00" +
+	"EmptySmallSquare;\x00\u25fb\x00" +
+	"EmptyVerySmallSquare;\x00\u25ab\x00" +
+	"Eogon;\x00\u0118\x00" +
+	"Eopf;\x00\U0001d53c\x00" +
+	"Epsilon;\x00\u0395\x00" +
+	"Equal;\x00\u2a75\x00" +
+	"EqualTilde;\x00\u2242\x00" +
+	"Equilibrium;\x00\u21cc\x00" +
+	"Escr;\x00\u2130\x00" +
+	"Esim;\x00\u2a73\x00" +
+	"Eta;\x00\u0397\x00" +
+	"Euml\x00\u00cb\x00" +
+	"Euml;\x00\u00cb\x00" +
+	"Exists;\x00\u2203\x00" +
+	"ExponentialE;\x00\u2147\x00" +
+	"Fcy;\x00\u0424\x00" +
+	"Ffr;\x00\U0001d509\x00" +
+	"FilledSmallSquare;\x00\u25fc\x00" +
+	"FilledVerySmallSquare;\x00\u25aa\x00" +
+	"Fopf;\x00\U0001d53d\x00" +
+	"ForAll;\x00\u2200\x00" +
+	"Fouriertrf;\x00\u2131\x00" +
+	"Fscr;\x00\u2131\x00" +
+	"GJcy;\x00\u0403\x00" +
+	"GT\x00>\x00" +
+	"GT;\x00>\x00" +
+	"Gamma;\x00\u0393\x00" +
+	"Gammad;\x00\u03dc\x00" +
+	"Gbreve;\x00\u011e\x00" +
+	"Gcedil;\x00\u0122\x00" +
+	"Gcirc;\x00\u011c\x00" +
+	"Gcy;\x00\u0413\x00" +
+	"Gdot;\x00\u0120\x00" +
+	"Gfr;\x00\U0001d50a\x00" +
+	"Gg;\x00\u22d9\x00" +
+	"Gopf;\x00\U0001d53e\x00" +
+	"GreaterEqual;\x00\u2265\x00" +
+	"GreaterEqualLess;\x00\u22db\x00" +
+	"GreaterFullEqual;\x00\u2267\x00" +
+	"GreaterGreater;\x00\u2aa2\x00" +
+	"GreaterLess;\x00\u2277\x00" +
+	"GreaterSlantEqual;\x00\u2a7e\x00" +
+	"GreaterTilde;\x00\u2273\x00" +
+	"Gscr;\x00\U0001d4a2\x00" +
+	"Gt;\x00\u226b\x00" +
+	"HARDcy;\x00\u042a\x00" +
+	"Hacek;\x00\u02c7\x00" +
+	"Hat;\x00^\x00" +
+	"Hcirc;\x00\u0124\x00" +
+	"Hfr;\x00\u210c\x00" +
+	"HilbertSpace;\x00\u210b\x00" +
+	"Hopf;\x00\u210d\x00" +
+	"HorizontalLine;\x00\u2500\x00" +
+	"Hscr;\x00\u210b\x00" +
+	"Hstrok;\x00\u0126\x00" +
+	"HumpDownHump;\x00\u224e\x00" +
+	"HumpEqual;\x00\u224f\x00" +
+	"IEcy;\x00\u0415\x00" +
+	"IJlig;\x00\u0132\x00" +
+	"IOcy;\x00\u0401\x00" +
+	"Iacute\x00\u00cd\x00" +
+	"Iacute;\x00\u00cd\x00" +
+	"Icirc\x00\u00ce\x00" +
+	"Icirc;\x00\u00ce\x00" +
+	"Icy;\x00\u0418\x00" +
+	"Idot;\x00\u0130\x00" +
+	"Ifr;\x00\u2111\x00" +
+	"Igrave\x00\u00cc\x00" +
+	"Igrave;\x00\u00cc\x00" +
+	"Im;\x00\u2111\x00" +
+	"Imacr;\x00\u012a\x00" +
+	"ImaginaryI;\x00\u2148\x00" +
+	"Implies;\x00\u21d2\x00" +
+	"Int;\x00\u222c\x00" +
+	"Integral;\x00\u222b\x00" +
+	"Intersection;\x00\u22c2\x00" +
+	"InvisibleComma;\x00\u2063\x00" +
+	"InvisibleTimes;\x00\u2062\x00" +
+	"Iogon;\x00\u012e\x00" +
+	"Iopf;\x00\U0001d540\x00" +
+	"Iota;\x00\u0399\x00" +
+	"Iscr;\x00\u2110\x00" +
+	"Itilde;\x00\u0128\x00" +
+	"Iukcy;\x00\u0406\x00" +
+	"Iuml\x00\u00cf\x00" +
+	"Iuml;\x00\u00cf\x00" +
+	"Jcirc;\x00\u0134\x00" +
+	"Jcy;\x00\u0419\x00" +
+	"Jfr;\x00\U0001d50d\x00" +
+	"Jopf;\x00\U0001d541\x00" +
+	"Jscr;\x00\U0001d4a5\x00" +
+	"Jsercy;\x00\u0408\x00" +
+	"Jukcy;\x00\u0404\x00" +
+	"KHcy;\x00\u0425\x00" +
+	"KJcy;\x00\u040c\x00" +
+	"Kappa;\x00\u039a\x00" +
+	"Kcedil;\x00\u0136\x00" +
+	"Kcy;\x00\u041a\x00" +
+	"Kfr;\x00\U0001d50e\x00" +
+	"Kopf;\x00\U0001d542\x00" +
+	"Kscr;\x00\U0001d4a6\x00" +
+	"LJcy;\x00\u0409\x00" +
+	"LT\x00<\x00" +
+	"LT;\x00<\x00" +
+	"Lacute;\x00\u0139\x00" +
+	"Lambda;\x00\u039b\x00" +
+	"Lang;\x00\u27ea\x00" +
+	"Laplacetrf;\x00\u2112\x00" +
+	"Larr;\x00\u219e\x00" +
+	"Lcaron;\x00\u013d\x00" +
+	"Lcedil;\x00\u013b\x00" +
+	"Lcy;\x00\u041b\x00" +
+	"LeftAngleBracket;\x00\u27e8\x00" +
+	"LeftArrow;\x00\u2190\x00" +
+	"LeftArrowBar;\x00\u21e4\x00" +
+	"LeftArrowRightArrow;\x00\u21c6\x00" +
+	"LeftCeiling;\x00\u2308\x00" +
+	"LeftDoubleBracket;\x00\u27e6\x00" +
+	"LeftDownTeeVector;\x00\u2961\x00" +
+	"LeftDownVector;\x00\u21c3\x00" +
+	"LeftDownVectorBar;\x00\u2959\x00" +
+	"LeftFloor;\x00\u230a\x00" +
+	"LeftRightArrow;\x00\u2194\x00" +
+	"LeftRightVector;\x00\u294e\x00" +
+	"LeftTee;\x00\u22a3\x00" +
+	"LeftTeeArrow;\x00\u21a4\x00" +
+	"LeftTeeVector;\x00\u295a\x00" +
+	"LeftTriangle;\x00\u22b2\x00" +
+	"LeftTriangleBar;\x00\u29cf\x00" +
+	"LeftTriangleEqual;\x00\u22b4\x00" +
+	"LeftUpDownVector;\x00\u2951\x00" +
+	"LeftUpTeeVector;\x00\u2960\x00" +
+	"LeftUpVector;\x00\u21bf\x00" +
+	"LeftUpVectorBar;\x00\u2958\x00" +
+	"LeftVector;\x00\u21bc\x00" +
+	"LeftVectorBar;\x00\u2952\x00" +
+	"Leftarrow;\x00\u21d0\x00" +
+	"Leftrightarrow;\x00\u21d4\x00" +
+	"LessEqualGreater;\x00\u22da\x00" +
+	"LessFullEqual;\x00\u2266\x00" +
+	"LessGreater;\x00\u2276\x00" +
+	"LessLess;\x00\u2aa1\x00" +
+	"LessSlantEqual;\x00\u2a7d\x00" +
+	"LessTilde;\x00\u2272\x00" +
+	"Lfr;\x00\U0001d50f\x00" +
+	"Ll;\x00\u22d8\x00" +
+	"Lleftarrow;\x00\u21da\x00" +
+	"Lmidot;\x00\u013f\x00" +
+	"LongLeftArrow;\x00\u27f5\x00" +
+	"LongLeftRightArrow;\x00\u27f7\x00" +
+	"LongRightArrow;\x00\u27f6\x00" +
+	"Longleftarrow;\x00\u27f8\x00" +
+	"Longleftrightarrow;\x00\u27fa\x00" +
+	"Longrightarrow;\x00\u27f9\x00" +
+	"Lopf;\x00\U0001d543\x00" +
+	"LowerLeftArrow;\x00\u2199\x00" +
+	"LowerRightArrow;\x00\u2198\x00" +
+	"Lscr;\x00\u2112\x00" +
+	"Lsh;\x00\u21b0\x00" +
+	"Lstrok;\x00\u0141\x00" +
+	"Lt;\x00\u226a\x00" +
+	"Map;\x00\u2905\x00" +
+	"Mcy;\x00\u041c\x00" +
+	"MediumSpace;\x00\u205f\x00" +
+	"Mellintrf;\x00\u2133\x00" +
+	"Mfr;\x00\U0001d510\x00" +
+	"MinusPlus;\x00\u2213\x00" +
+	"Mopf;\x00\U0001d544\x00" +
+	"Mscr;\x00\u2133\x00" +
+	"Mu;\x00\u039c\x00" +
+	"NJcy;\x00\u040a\x00" +
+	"Nacute;\x00\u0143\x00" +
+	"Ncaron;\x00\u0147\x00" +
+	"Ncedil;\x00\u0145\x00" +
+	"Ncy;\x00\u041d\x00" +
+	"NegativeMediumSpace;\x00\u200b\x00" +
+	"NegativeThickSpace;\x00\u200b\x00" +
+	"NegativeThinSpace;\x00\u200b\x00" +
+	"NegativeVeryThinSpace;\x00\u200b\x00" +
+	"NestedGreaterGreater;\x00\u226b\x00" +
+	"NestedLessLess;\x00\u226a\x00" +
+	"NewLine;\x00\n\x00" +
+	"Nfr;\x00\U0001d511\x00" +
+	"NoBreak;\x00\u2060\x00" +
+	"NonBreakingSpace;\x00\u00a0\x00" +
+	"Nopf;\x00\u2115\x00" +
+	"Not;\x00\u2aec\x00" +
+	"NotCongruent;\x00\u2262\x00" +
+	"NotCupCap;\x00\u226d\x00" +
+	"NotDoubleVerticalBar;\x00\u2226\x00" +
+	"NotElement;\x00\u2209\x00" +
+	"NotEqual;\x00\u2260\x00" +
+	"NotEqualTilde;\x00\u2242\u0338\x00" +
+	"NotExists;\x00\u2204\x00" +
+	"NotGreater;\x00\u226f\x00" +
+	"NotGreaterEqual;\x00\u2271\x00" +
+	"NotGreaterFullEqual;\x00\u2267\u0338\x00" +
+	"NotGreaterGreater;\x00\u226b\u0338\x00" +
+	"NotGreaterLess;\x00\u2279\x00" +
+	"NotGreaterSlantEqual;\x00\u2a7e\u0338\x00" +
+	"NotGreaterTilde;\x00\u2275\x00" +
+	"NotHumpDownHump;\x00\u224e\u0338\x00" +
+	"NotHumpEqual;\x00\u224f\u0338\x00" +
+	"NotLeftTriangle;\x00\u22ea\x00" +
+	"NotLeftTriangleBar;\x00\u29cf\u0338\x00" +
+	"NotLeftTriangleEqual;\x00\u22ec\x00" +
+	"NotLess;\x00\u226e\x00" +
+	"NotLessEqual;\x00\u2270\x00" +
+	"NotLessGreater;\x00\u2278\x00" +
+	"NotLessLess;\x00\u226a\u0338\x00" +
+	"NotLessSlantEqual;\x00\u2a7d\u0338\x00" +
+	"NotLessTilde;\x00\u2274\x00" +
+	"NotNestedGreaterGreater;\x00\u2aa2\u0338\x00" +
+	"NotNestedLessLess;\x00\u2aa1\u0338\x00" +
+	"NotPrecedes;\x00\u2280\x00" +
+	"NotPrecedesEqual;\x00\u2aaf\u0338\x00" +
+	"NotPrecedesSlantEqual;\x00\u22e0\x00" +
+	"NotReverseElement;\x00\u220c\x00" +
+	"NotRightTriangle;\x00\u22eb\x00" +
+	"NotRightTriangleBar;\x00\u29d0\u0338\x00" +
+	"NotRightTriangleEqual;\x00\u22ed\x00" +
+	"NotSquareSubset;\x00\u228f\u0338\x00" +
+	"NotSquareSubsetEqual;\x00\u22e2\x00" +
+	"NotSquareSuperset;\x00\u2290\u0338\x00" +
+	"NotSquareSupersetEqual;\x00\u22e3\x00" +
+	"NotSubset;\x00\u2282\u20d2\x00" +
+	"NotSubsetEqual;\x00\u2288\x00" +
+	"NotSucceeds;\x00\u2281\x00" +
+	"NotSucceedsEqual;\x00\u2ab0\u0338\x00" +
+	"NotSucceedsSlantEqual;\x00\u22e1\x00" +
+	"NotSucceedsTilde;\x00\u227f\u0338\x00" +
+	"NotSuperset;\x00\u2283\u20d2\x00" +
+	"NotSupersetEqual;\x00\u2289\x00" +
+	"NotTilde;\x00\u2241\x00" +
+	"NotTildeEqual;\x00\u2244\x00" +
+	"NotTildeFullEqual;\x00\u2247\x00" +
+	"NotTildeTilde;\x00\u2249\x00" +
+	"NotVerticalBar;\x00\u2224\x00" +
+	"Nscr;\x00\U0001d4a9\x00" +
+	"Ntilde\x00\u00d1\x00" +
+	"Ntilde;\x00\u00d1\x00" +
+	"Nu;\x00\u039d\x00" +
+	"OElig;\x00\u0152\x00" +
+	"Oacute\x00\u00d3\x00" +
+	"Oacute;\x00\u00d3\x00" +
+	"Ocirc\x00\u00d4\x00" +
+	"Ocirc;\x00\u00d4\x00" +
+	"Ocy;\x00\u041e\x00" +
+	"Odblac;\x00\u0150\x00" +
+	"Ofr;\x00\U0001d512\x00" +
+	"Ograve\x00\u00d2\x00" +
+	"Ograve;\x00\u00d2\x00" +
+	"Omacr;\x00\u014c\x00" +
+	"Omega;\x00\u03a9\x00" +
+	"Omicron;\x00\u039f\x00" +
+	"Oopf;\x00\U0001d546\x00" +
+	"OpenCurlyDoubleQuote;\x00\u201c\x00" +
+	"OpenCurlyQuote;\x00\u2018\x00" +
+	"Or;\x00\u2a54\x00" +
+	"Oscr;\x00\U0001d4aa\x00" +
+	"Oslash\x00\u00d8\x00" +
+	"Oslash;\x00\u00d8\x00" +
+	"Otilde\x00\u00d5\x00" +
+	"Otilde;\x00\u00d5\x00" +
+	"Otimes;\x00\u2a37\x00" +
+	"Ouml\x00\u00d6\x00" +
+	"Ouml;\x00\u00d6\x00" +
+	"OverBar;\x00\u203e\x00" +
+	"OverBrace;\x00\u23de\x00" +
+	"OverBracket;\x00\u23b4\x00" +
+	"OverParenthesis;\x00\u23dc\x00" +
+	"PartialD;\x00\u2202\x00" +
+	"Pcy;\x00\u041f\x00" +
+	"Pfr;\x00\U0001d513\x00" +
+	"Phi;\x00\u03a6\x00" +
+	"Pi;\x00\u03a0\x00" +
+	"PlusMinus;\x00\u00b1\x00" +
+	"Poincareplane;\x00\u210c\x00" +
+	"Popf;\x00\u2119\x00" +
+	"Pr;\x00\u2abb\x00" +
+	"Precedes;\x00\u227a\x00" +
+	"PrecedesEqual;\x00\u2aaf\x00" +
+	"PrecedesSlantEqual;\x00\u227c\x00" +
+	"PrecedesTilde;\x00\u227e\x00" +
+	"Prime;\x00\u2033\x00" +
+	"Product;\x00\u220f\x00" +
+	"Proportion;\x00\u2237\x00" +
+	"Proportional;\x00\u221d\x00" +
+	"Pscr;\x00\U0001d4ab\x00" +
+	"Psi;\x00\u03a8\x00" +
+	"QUOT\x00\"\x00" +
+	"QUOT;\x00\"\x00" +
+	"Qfr;\x00\U0001d514\x00" +
+	"Qopf;\x00\u211a\x00" +
+	"Qscr;\x00\U0001d4ac\x00" +
+	"RBarr;\x00\u2910\x00" +
+	"REG\x00\u00ae\x00" +
+	"REG;\x00\u00ae\x00" +
+	"Racute;\x00\u0154\x00" +
+	"Rang;\x00\u27eb\x00" +
+	"Rarr;\x00\u21a0\x00" +
+	"Rarrtl;\x00\u2916\x00" +
+	"Rcaron;\x00\u0158\x00" +
+	"Rcedil;\x00\u0156\x00" +
+	"Rcy;\x00\u0420\x00" +
+	"Re;\x00\u211c\x00" +
+	"ReverseElement;\x00\u220b\x00" +
+	"ReverseEquilibrium;\x00\u21cb\x00" +
+	"ReverseUpEquilibrium;\x00\u296f\x00" +
+	"Rfr;\x00\u211c\x00" +
+	"Rho;\x00\u03a1\x00" +
+	"RightAngleBracket;\x00\u27e9\x00" +
+	"RightArrow;\x00\u2192\x00" +
+	"RightArrowBar;\x00\u21e5\x00" +
+	"RightArrowLeftArrow;\x00\u21c4\x00" +
+	"RightCeiling;\x00\u2309\x00" +
+	"RightDoubleBracket;\x00\u27e7\x00" +
+	"RightDownTeeVector;\x00\u295d\x00" +
+	"RightDownVector;\x00\u21c2\x00" +
+	"RightDownVectorBar;\x00\u2955\x00" +
+	"RightFloor;\x00\u230b\x00" +
+	"RightTee;\x00\u22a2\x00" +
+	"RightTeeArrow;\x00\u21a6\x00" +
+	"RightTeeVector;\x00\u295b\x00" +
+	"RightTriangle;\x00\u22b3\x00" +
+	"RightTriangleBar;\x00\u29d0\x00" +
+	"RightTriangleEqual;\x00\u22b5\x00" +
+	"RightUpDownVector;\x00\u294f\x00" +
+	"RightUpTeeVector;\x00\u295c\x00" +
+	"RightUpVector;\x00\u21be\x00" +
+	"RightUpVectorBar;\x00\u2954\x00" +
+	"RightVector;\x00\u21c0\x00" +
+	"RightVectorBar;\x00\u2953\x00" +
+	"Rightarrow;\x00\u21d2\x00" +
+	"Ropf;\x00\u211d\x00" +
+	"RoundImplies;\x00\u2970\x00" +
+	"Rrightarrow;\x00\u21db\x00" +
+	"Rscr;\x00\u211b\x00" +
+	"Rsh;\x00\u21b1\x00" +
+	"RuleDelayed;\x00\u29f4\x00" +
+	"SHCHcy;\x00\u0429\x00" +
+	"SHcy;\x00\u0428\x00" +
+	"SOFTcy;\x00\u042c\x00" +
+	"Sacute;\x00\u015a\x00" +
+	"Sc;\x00\u2abc\x00" +
+	"Scaron;\x00\u0160\x00" +
+	"Scedil;\x00\u015e\x00" +
+	"Scirc;\x00\u015c\x00" +
+	"Scy;\x00\u0421\x00" +
+	"Sfr;\x00\U0001d516\x00" +
+	"ShortDownArrow;\x00\u2193\x00" +
+	"ShortLeftArrow;\x00\u2190\x00" +
+	"ShortRightArrow;\x00\u2192\x00" +
+	"ShortUpArrow;\x00\u2191\x00" +
+	"Sigma;\x00\u03a3\x00" +
+	"SmallCircle;\x00\u2218\x00" +
+	"Sopf;\x00\U0001d54a\x00" +
+	"Sqrt;\x00\u221a\x00" +
+	"Square;\x00\u25a1\x00" +
+	"SquareIntersection;\x00\u2293\x00" +
+	"SquareSubset;\x00\u228f\x00" +
+	"SquareSubsetEqual;\x00\u2291\x00" +
+	"SquareSuperset;\x00\u2290\x00" +
+	"SquareSupersetEqual;\x00\u2292\x00" +
+	"SquareUnion;\x00\u2294\x00" +
+	"Sscr;\x00\U0001d4ae\x00" +
+	"Star;\x00\u22c6\x00" +
+	"Sub;\x00\u22d0\x00" +
+	"Subset;\x00\u22d0\x00" +
+	"SubsetEqual;\x00\u2286\x00" +
+	"Succeeds;\x00\u227b\x00" +
+	"SucceedsEqual;\x00\u2ab0\x00" +
+	"SucceedsSlantEqual;\x00\u227d\x00" +
+	"SucceedsTilde;\x00\u227f\x00" +
+	"SuchThat;\x00\u220b\x00" +
+	"Sum;\x00\u2211\x00" +
+	"Sup;\x00\u22d1\x00" +
+	"Superset;\x00\u2283\x00" +
+	"SupersetEqual;\x00\u2287\x00" +
+	"Supset;\x00\u22d1\x00" +
+	"THORN\x00\u00de\x00" +
+	"THORN;\x00\u00de\x00" +
+	"TRADE;\x00\u2122\x00" +
+	"TSHcy;\x00\u040b\x00" +
+	"TScy;\x00\u0426\x00" +
+	"Tab;\x00\t\x00" +
+	"Tau;\x00\u03a4\x00" +
+	"Tcaron;\x00\u0164\x00" +
+	"Tcedil;\x00\u0162\x00" +
+	"Tcy;\x00\u0422\x00" +
+	"Tfr;\x00\U0001d517\x00" +
+	"Therefore;\x00\u2234\x00" +
+	"Theta;\x00\u0398\x00" +
+	"ThickSpace;\x00\u205f\u200a\x00" +
+	"ThinSpace;\x00\u2009\x00" +
+	"Tilde;\x00\u223c\x00" +
+	"TildeEqual;\x00\u2243\x00" +
+	"TildeFullEqual;\x00\u2245\x00" +
+	"TildeTilde;\x00\u2248\x00" +
+	"Topf;\x00\U0001d54b\x00" +
+	"TripleDot;\x00\u20db\x00" +
+	"Tscr;\x00\U0001d4af\x00" +
+	"Tstrok;\x00\u0166\x00" +
+	"Uacute\x00\u00da\x00" +
+	"Uacute;\x00\u00da\x00" +
+	"Uarr;\x00\u219f\x00" +
+	"Uarrocir;\x00\u2949\x00" +
+	"Ubrcy;\x00\u040e\x00" +
+	"Ubreve;\x00\u016c\x00" +
+	"Ucirc\x00\u00db\x00" +
+	"Ucirc;\x00\u00db\x00" +
+	"Ucy;\x00\u0423\x00" +
+	"Udblac;\x00\u0170\x00" +
+	"Ufr;\x00\U0001d518\x00" +
+	"Ugrave\x00\u00d9\x00" +
+	"Ugrave;\x00\u00d9\x00" +
+	"Umacr;\x00\u016a\x00" +
+	"UnderBar;\x00_\x00" +
+	"UnderBrace;\x00\u23df\x00" +
+	"UnderBracket;\x00\u23b5\x00" +
+	"UnderParenthesis;\x00\u23dd\x00" +
+	"Union;\x00\u22c3\x00" +
+	"UnionPlus;\x00\u228e\x00" +
+	"Uogon;\x00\u0172\x00" +
+	"Uopf;\x00\U0001d54c\x00" +
+	"UpArrow;\x00\u2191\x00" +
+	"UpArrowBar;\x00\u2912\x00" +
+	"UpArrowDownArrow;\x00\u21c5\x00" +
+	"UpDownArrow;\x00\u2195\x00" +
+	"UpEquilibrium;\x00\u296e\x00" +
+	"UpTee;\x00\u22a5\x00" +
+	"UpTeeArrow;\x00\u21a5\x00" +
+	"Uparrow;\x00\u21d1\x00" +
+	"Updownarrow;\x00\u21d5\x00" +
+	"UpperLeftArrow;\x00\u2196\x00" +
+	"UpperRightArrow;\x00\u2197\x00" +
+	"Upsi;\x00\u03d2\x00" +
+	"Upsilon;\x00\u03a5\x00" +
+	"Uring;\x00\u016e\x00" +
+	"Uscr;\x00\U0001d4b0\x00" +
+	"Utilde;\x00\u0168\x00" +
+	"Uuml\x00\u00dc\x00" +
+	"Uuml;\x00\u00dc\x00" +
+	"VDash;\x00\u22ab\x00" +
+	"Vbar;\x00\u2aeb\x00" +
+	"Vcy;\x00\u0412\x00" +
+	"Vdash;\x00\u22a9\x00" +
+	"Vdashl;\x00\u2ae6\x00" +
+	"Vee;\x00\u22c1\x00" +
+	"Verbar;\x00\u2016\x00" +
+	"Vert;\x00\u2016\x00" +
+	"VerticalBar;\x00\u2223\x00" +
+	"VerticalLine;\x00|\x00" +
+	"VerticalSeparator;\x00\u2758\x00" +
+	"VerticalTilde;\x00\u2240\x00" +
+	"VeryThinSpace;\x00\u200a\x00" +
+	"Vfr;\x00\U0001d519\x00" +
+	"Vopf;\x00\U0001d54d\x00" +
+	"Vscr;\x00\U0001d4b1\x00" +
+	"Vvdash;\x00\u22aa\x00" +
+	"Wcirc;\x00\u0174\x00" +
+	"Wedge;\x00\u22c0\x00" +
+	"Wfr;\x00\U0001d51a\x00" +
+	"Wopf;\x00\U0001d54e\x00" +
+	"Wscr;\x00\U0001d4b2\x00" +
+	"Xfr;\x00\U0001d51b\x00" +
+	"Xi;\x00\u039e\x00" +
+	"Xopf;\x00\U0001d54f\x00" +
+	"Xscr;\x00\U0001d4b3\x00" +
+	"YAcy;\x00\u042f\x00" +
+	"YIcy;\x00\u0407\x00" +
+	"YUcy;\x00\u042e\x00" +
+	"Yacute\x00\u00dd\x00" +
+	"Yacute;\x00\u00dd\x00" +
+	"Ycirc;\x00\u0176\x00" +
+	"Ycy;\x00\u042b\x00" +
+	"Yfr;\x00\U0001d51c\x00" +
+	"Yopf;\x00\U0001d550\x00" +
+	"Yscr;\x00\U0001d4b4\x00" +
+	"Yuml;\x00\u0178\x00" +
+	"ZHcy;\x00\u0416\x00" +
+	"Zacute;\x00\u0179\x00" +
+	"Zcaron;\x00\u017d\x00" +
+	"Zcy;\x00\u0417\x00" +
+	"Zdot;\x00\u017b\x00" +
+	"ZeroWidthSpace;\x00\u200b\x00" +
+	"Zeta;\x00\u0396\x00" +
+	"Zfr;\x00\u2128\x00" +
+	"Zopf;\x00\u2124\x00" +
+	"Zscr;\x00\U0001d4b5\x00" +
+	"aacute\x00\u00e1\x00" +
+	"aacute;\x00\u00e1\x00" +
+	"abreve;\x00\u0103\x00" +
+	"ac;\x00\u223e\x00" +
+	"acE;\x00\u223e\u0333\x00" +
+	"acd;\x00\u223f\x00" +
+	"acirc\x00\u00e2\x00" +
+	"acirc;\x00\u00e2\x00" +
+	"acute\x00\u00b4\x00" +
+	"acute;\x00\u00b4\x00" +
+	"acy;\x00\u0430\x00" +
+	"aelig\x00\u00e6\x00" +
+	"aelig;\x00\u00e6\x00" +
+	"af;\x00\u2061\x00" +
+	"afr;\x00\U0001d51e\x00" +
+	"agrave\x00\u00e0\x00" +
+	"agrave;\x00\u00e0\x00" +
+	"alefsym;\x00\u2135\x00" +
+	"aleph;\x00\u2135\x00" +
+	"alpha;\x00\u03b1\x00" +
+	"amacr;\x00\u0101\x00" +
+	"amalg;\x00\u2a3f\x00" +
+	"amp\x00&\x00" +
+	"amp;\x00&\x00" +
+	"and;\x00\u2227\x00" +
+	"andand;\x00\u2a55\x00" +
+	"andd;\x00\u2a5c\x00" +
+	"andslope;\x00\u2a58\x00" +
+	"andv;\x00\u2a5a\x00" +
+	"ang;\x00\u2220\x00" +
+	"ange;\x00\u29a4\x00" +
+	"angle;\x00\u2220\x00" +
+	"angmsd;\x00\u2221\x00" +
+	"angmsdaa;\x00\u29a8\x00" +
+	"angmsdab;\x00\u29a9\x00" +
+	"angmsdac;\x00\u29aa\x00" +
+	"angmsdad;\x00\u29ab\x00" +
+	"angmsdae;\x00\u29ac\x00" +
+	"angmsdaf;\x00\u29ad\x00" +
+	"angmsdag;\x00\u29ae\x00" +
+	"angmsdah;\x00\u29af\x00" +
+	"angrt;\x00\u221f\x00" +
+	"angrtvb;\x00\u22be\x00" +
+	"angrtvbd;\x00\u299d\x00" +
+	"angsph;\x00\u2222\x00" +
+	"angst;\x00\u00c5\x00" +
+	"angzarr;\x00\u237c\x00" +
+	"aogon;\x00\u0105\x00" +
+	"aopf;\x00\U0001d552\x00" +
+	"ap;\x00\u2248\x00" +
+	"apE;\x00\u2a70\x00" +
+	"apacir;\x00\u2a6f\x00" +
+	"ape;\x00\u224a\x00" +
+	"apid;\x00\u224b\x00" +
+	"apos;\x00'\x00" +
+	"approx;\x00\u2248\x00" +
+	"approxeq;\x00\u224a\x00" +
+	"aring\x00\u00e5\x00" +
+	"aring;\x00\u00e5\x00" +
+	"ascr;\x00\U0001d4b6\x00" +
+	"ast;\x00*\x00" +
+	"asymp;\x00\u2248\x00" +
+	"asympeq;\x00\u224d\x00" +
+	"atilde\x00\u00e3\x00" +
+	"atilde;\x00\u00e3\x00" +
+	"auml\x00\u00e4\x00" +
+	"auml;\x00\u00e4\x00" +
+	"awconint;\x00\u2233\x00" +
+	"awint;\x00\u2a11\x00" +
+	"bNot;\x00\u2aed\x00" +
+	"backcong;\x00\u224c\x00" +
+	"backepsilon;\x00\u03f6\x00" +
+	"backprime;\x00\u2035\x00" +
+	"backsim;\x00\u223d\x00" +
+	"backsimeq;\x00\u22cd\x00" +
+	"barvee;\x00\u22bd\x00" +
+	"barwed;\x00\u2305\x00" +
+	"barwedge;\x00\u2305\x00" +
+	"bbrk;\x00\u23b5\x00" +
+	"bbrktbrk;\x00\u23b6\x00" +
+	"bcong;\x00\u224c\x00" +
+	"bcy;\x00\u0431\x00" +
+	"bdquo;\x00\u201e\x00" +
+	"becaus;\x00\u2235\x00" +
+	"because;\x00\u2235\x00" +
+	"bemptyv;\x00\u29b0\x00" +
+	"bepsi;\x00\u03f6\x00" +
+	"bernou;\x00\u212c\x00" +
+	"beta;\x00\u03b2\x00" +
+	"beth;\x00\u2136\x00" +
+	"between;\x00\u226c\x00" +
+	"bfr;\x00\U0001d51f\x00" +
+	"bigcap;\x00\u22c2\x00" +
+	"bigcirc;\x00\u25ef\x00" +
+	"bigcup;\x00\u22c3\x00" +
+	"bigodot;\x00\u2a00\x00" +
+	"bigoplus;\x00\u2a01\x00" +
+	"bigotimes;\x00\u2a02\x00" +
+	"bigsqcup;\x00\u2a06\x00" +
+	"bigstar;\x00\u2605\x00" +
+	"bigtriangledown;\x00\u25bd\x00" +
+	"bigtriangleup;\x00\u25b3\x00" +
+	"biguplus;\x00\u2a04\x00" +
+	"bigvee;\x00\u22c1\x00" +
+	"bigwedge;\x00\u22c0\x00" +
+	"bkarow;\x00\u290d\x00" +
+	"blacklozenge;\x00\u29eb\x00" +
+	"blacksquare;\x00\u25aa\x00" +
+	"blacktriangle;\x00\u25b4\x00" +
+	"blacktriangledown;\x00\u25be\x00" +
+	"blacktriangleleft;\x00\u25c2\x00" +
+	"blacktriangleright;\x00\u25b8\x00" +
+	"blank;\x00\u2423\x00" +
+	"blk12;\x00\u2592\x00" +
+	"blk14;\x00\u2591\x00" +
+	"blk34;\x00\u2593\x00" +
+	"block;\x00\u2588\x00" +
+	"bne;\x00=\u20e5\x00" +
+	"bnequiv;\x00\u2261\u20e5\x00" +
+	"bnot;\x00\u2310\x00" +
+	"bopf;\x00\U0001d553\x00" +
+	"bot;\x00\u22a5\x00" +
+	"bottom;\x00\u22a5\x00" +
+	"bowtie;\x00\u22c8\x00" +
+	"boxDL;\x00\u2557\x00" +
+	"boxDR;\x00\u2554\x00" +
+	"boxDl;\x00\u2556\x00" +
+	"boxDr;\x00\u2553\x00" +
+	"boxH;\x00\u2550\x00" +
+	"boxHD;\x00\u2566\x00" +
+	"boxHU;\x00\u2569\x00" +
+	"boxHd;\x00\u2564\x00" +
+	"boxHu;\x00\u2567\x00" +
+	"boxUL;\x00\u255d\x00" +
+	"boxUR;\x00\u255a\x00" +
+	"boxUl;\x00\u255c\x00" +
+	"boxUr;\x00\u2559\x00" +
+	"boxV;\x00\u2551\x00" +
+	"boxVH;\x00\u256c\x00" +
+	"boxVL;\x00\u2563\x00" +
+	"boxVR;\x00\u2560\x00" +
+	"boxVh;\x00\u256b\x00" +
+	"boxVl;\x00\u2562\x00" +
+	"boxVr;\x00\u255f\x00" +
+	"boxbox;\x00\u29c9\x00" +
+	"boxdL;\x00\u2555\x00" +
+	"boxdR;\x00\u2552\x00" +
+	"boxdl;\x00\u2510\x00" +
+	"boxdr;\x00\u250c\x00" +
+	"boxh;\x00\u2500\x00" +
+	"boxhD;\x00\u2565\x00" +
+	"boxhU;\x00\u2568\x00" +
+	"boxhd;\x00\u252c\x00" +
+	"boxhu;\x00\u2534\x00" +
+	"boxminus;\x00\u229f\x00" +
+	"boxplus;\x00\u229e\x00" +
+	"boxtimes;\x00\u22a0\x00" +
+	"boxuL;\x00\u255b\x00" +
+	"boxuR;\x00\u2558\x00" +
+	"boxul;\x00\u2518\x00" +
+	"boxur;\x00\u2514\x00" +
+	"boxv;\x00\u2502\x00" +
+	"boxvH;\x00\u256a\x00" +
+	"boxvL;\x00\u2561\x00" +
+	"boxvR;\x00\u255e\x00" +
+	"boxvh;\x00\u253c\x00" +
+	"boxvl;\x00\u2524\x00" +
+	"boxvr;\x00\u251c\x00" +
+	"bprime;\x00\u2035\x00" +
+	"breve;\x00\u02d8\x00" +
+	"brvbar\x00\u00a6\x00" +
+	"brvbar;\x00\u00a6\x00" +
+	"bscr;\x00\U0001d4b7\x00" +
+	"bsemi;\x00\u204f\x00" +
+	"bsim;\x00\u223d\x00" +
+	"bsime;\x00\u22cd\x00" +
+	"bsol;\x00\\\x00" +
+	"bsolb;\x00\u29c5\x00" +
+	"bsolhsub;\x00\u27c8\x00" +
+	"bull;\x00\u2022\x00" +
+	"bullet;\x00\u2022\x00" +
+	"bump;\x00\u224e\x00" +
+	"bumpE;\x00\u2aae\x00" +
+	"bumpe;\x00\u224f\x00" +
+	"bumpeq;\x00\u224f\x00" +
+	"cacute;\x00\u0107\x00" +
+	"cap;\x00\u2229\x00" +
+	"capand;\x00\u2a44\x00" +
+	"capbrcup;\x00\u2a49\x00" +
+	"capcap;\x00\u2a4b\x00" +
+	"capcup;\x00\u2a47\x00" +
+	"capdot;\x00\u2a40\x00" +
+	"caps;\x00\u2229\ufe00\x00" +
+	"caret;\x00\u2041\x00" +
+	"caron;\x00\u02c7\x00" +
+	"ccaps;\x00\u2a4d\x00" +
+	"ccaron;\x00\u010d\x00" +
+	"ccedil\x00\u00e7\x00" +
+	"ccedil;\x00\u00e7\x00" +
+	"ccirc;\x00\u0109\x00" +
+	"ccups;\x00\u2a4c\x00" +
+	"ccupssm;\x00\u2a50\x00" +
+	"cdot;\x00\u010b\x00" +
+	"cedil\x00\u00b8\x00" +
+	"cedil;\x00\u00b8\x00" +
+	"cemptyv;\x00\u29b2\x00" +
+	"cent\x00\u00a2\x00" +
+	"cent;\x00\u00a2\x00" +
+	"centerdot;\x00\u00b7\x00" +
+	"cfr;\x00\U0001d520\x00" +
+	"chcy;\x00\u0447\x00" +
+	"check;\x00\u2713\x00" +
+	"checkmark;\x00\u2713\x00" +
+	"chi;\x00\u03c7\x00" +
+	"cir;\x00\u25cb\x00" +
+	"cirE;\x00\u29c3\x00" +
+	"circ;\x00\u02c6\x00" +
+	"circeq;\x00\u2257\x00" +
+	"circlearrowleft;\x00\u21ba\x00" +
+	"circlearrowright;\x00\u21bb\x00" +
+	"circledR;\x00\u00ae\x00" +
+	"circledS;\x00\u24c8\x00" +
+	"circledast;\x00\u229b\x00" +
+	"circledcirc;\x00\u229a\x00" +
+	"circleddash;\x00\u229d\x00" +
+	"cire;\x00\u2257\x00" +
+	"cirfnint;\x00\u2a10\x00" +
+	"cirmid;\x00\u2aef\x00" +
+	"cirscir;\x00\u29c2\x00" +
+	"clubs;\x00\u2663\x00" +
+	"clubsuit;\x00\u2663\x00" +
+	"colon;\x00:\x00" +
+	"colone;\x00\u2254\x00" +
+	"coloneq;\x00\u2254\x00" +
+	"comma;\x00,\x00" +
+	"commat;\x00@\x00" +
+	"comp;\x00\u2201\x00" +
+	"compfn;\x00\u2218\x00" +
+	"complement;\x00\u2201\x00" +
+	"complexes;\x00\u2102\x00" +
+	"cong;\x00\u2245\x00" +
+	"congdot;\x00\u2a6d\x00" +
+	"conint;\x00\u222e\x00" +
+	"copf;\x00\U0001d554\x00" +
+	"coprod;\x00\u2210\x00" +
+	"copy\x00\u00a9\x00" +
+	"copy;\x00\u00a9\x00" +
+	"copysr;\x00\u2117\x00" +
+	"crarr;\x00\u21b5\x00" +
+	"cross;\x00\u2717\x00" +
+	"cscr;\x00\U0001d4b8\x00" +
+	"csub;\x00\u2acf\x00" +
+	"csube;\x00\u2ad1\x00" +
+	"csup;\x00\u2ad0\x00" +
+	"csupe;\x00\u2ad2\x00" +
+	"ctdot;\x00\u22ef\x00" +
+	"cudarrl;\x00\u2938\x00" +
+	"cudarrr;\x00\u2935\x00" +
+	"cuepr;\x00\u22de\x00" +
+	"cuesc;\x00\u22df\x00" +
+	"cularr;\x00\u21b6\x00" +
+	"cularrp;\x00\u293d\x00" +
+	"cup;\x00\u222a\x00" +
+	"cupbrcap;\x00\u2a48\x00" +
+	"cupcap;\x00\u2a46\x00" +
+	"cupcup;\x00\u2a4a\x00" +
+	"cupdot;\x00\u228d\x00" +
+	"cupor;\x00\u2a45\x00" +
+	"cups;\x00\u222a\ufe00\x00" +
+	"curarr;\x00\u21b7\x00" +
+	"curarrm;\x00\u293c\x00" +
+	"curlyeqprec;\x00\u22de\x00" +
+	"curlyeqsucc;\x00\u22df\x00" +
+	"curlyvee;\x00\u22ce\x00" +
+	"curlywedge;\x00\u22cf\x00" +
+	"curren\x00\u00a4\x00" +
+	"curren;\x00\u00a4\x00" +
+	"curvearrowleft;\x00\u21b6\x00" +
+	"curvearrowright;\x00\u21b7\x00" +
+	"cuvee;\x00\u22ce\x00" +
+	"cuwed;\x00\u22cf\x00" +
+	"cwconint;\x00\u2232\x00" +
+	"cwint;\x00\u2231\x00" +
+	"cylcty;\x00\u232d\x00" +
+	"dArr;\x00\u21d3\x00" +
+	"dHar;\x00\u2965\x00" +
+	"dagger;\x00\u2020\x00" +
+	"daleth;\x00\u2138\x00" +
+	"darr;\x00\u2193\x00" +
+	"dash;\x00\u2010\x00" +
+	"dashv;\x00\u22a3\x00" +
+	"dbkarow;\x00\u290f\x00" +
+	"dblac;\x00\u02dd\x00" +
+	"dcaron;\x00\u010f\x00" +
+	"dcy;\x00\u0434\x00" +
+	"dd;\x00\u2146\x00" +
+	"ddagger;\x00\u2021\x00" +
+	"ddarr;\x00\u21ca\x00" +
+	"ddotseq;\x00\u2a77\x00" +
+	"deg\x00\u00b0\x00" +
+	"deg;\x00\u00b0\x00" +
+	"delta;\x00\u03b4\x00" +
+	"demptyv;\x00\u29b1\x00" +
+	"dfisht;\x00\u297f\x00" +
+	"dfr;\x00\U0001d521\x00" +
+	"dharl;\x00\u21c3\x00" +
+	"dharr;\x00\u21c2\x00" +
+	"diam;\x00\u22c4\x00" +
+	"diamond;\x00\u22c4\x00" +
+	"diamondsuit;\x00\u2666\x00" +
+	"diams;\x00\u2666\x00" +
+	"die;\x00\u00a8\x00" +
+	"digamma;\x00\u03dd\x00" +
+	"disin;\x00\u22f2\x00" +
+	"div;\x00\u00f7\x00" +
+	"divide\x00\u00f7\x00" +
+	"divide;\x00\u00f7\x00" +
+	"divideontimes;\x00\u22c7\x00" +
+	"divonx;\x00\u22c7\x00" +
+	"djcy;\x00\u0452\x00" +
+	"dlcorn;\x00\u231e\x00" +
+	"dlcrop;\x00\u230d\x00" +
+	"dollar;\x00$\x00" +
+	"dopf;\x00\U0001d555\x00" +
+	"dot;\x00\u02d9\x00" +
+	"doteq;\x00\u2250\x00" +
+	"doteqdot;\x00\u2251\x00" +
+	"dotminus;\x00\u2238\x00" +
+	"dotplus;\x00\u2214\x00" +
+	"dotsquare;\x00\u22a1\x00" +
+	"doublebarwedge;\x00\u2306\x00" +
+	"downarrow;\x00\u2193\x00" +
+	"downdownarrows;\x00\u21ca\x00" +
+	"downharpoonleft;\x00\u21c3\x00" +
+	"downharpoonright;\x00\u21c2\x00" +
+	"drbkarow;\x00\u2910\x00" +
+	"drcorn;\x00\u231f\x00" +
+	"drcrop;\x00\u230c\x00" +
+	"dscr;\x00\U0001d4b9\x00" +
+	"dscy;\x00\u0455\x00" +
+	"dsol;\x00\u29f6\x00" +
+	"dstrok;\x00\u0111\x00" +
+	"dtdot;\x00\u22f1\x00" +
+	"dtri;\x00\u25bf\x00" +
+	"dtrif;\x00\u25be\x00" +
+	"duarr;\x00\u21f5\x00" +
+	"duhar;\x00\u296f\x00" +
+	"dwangle;\x00\u29a6\x00" +
+	"dzcy;\x00\u045f\x00" +
+	"dzigrarr;\x00\u27ff\x00" +
+	"eDDot;\x00\u2a77\x00" +
+	"eDot;\x00\u2251\x00" +
+	"eacute\x00\u00e9\x00" +
+	"eacute;\x00\u00e9\x00" +
+	"easter;\x00\u2a6e\x00" +
+	"ecaron;\x00\u011b\x00" +
+	"ecir;\x00\u2256\x00" +
+	"ecirc\x00\u00ea\x00" +
+	"ecirc;\x00\u00ea\x00" +
+	"ecolon;\x00\u2255\x00" +
+	"ecy;\x00\u044d\x00" +
+	"edot;\x00\u0117\x00" +
+	"ee;\x00\u2147\x00" +
+	"efDot;\x00\u2252\x00" +
+	"efr;\x00\U0001d522\x00" +
+	"eg;\x00\u2a9a\x00" +
+	"egrave\x00\u00e8\x00" +
+	"egrave;\x00\u00e8\x00" +
+	"egs;\x00\u2a96\x00" +
+	"egsdot;\x00\u2a98\x00" +
+	"el;\x00\u2a99\x00" +
+	"elinters;\x00\u23e7\x00" +
+	"ell;\x00\u2113\x00" +
+	"els;\x00\u2a95\x00" +
+	"elsdot;\x00\u2a97\x00" +
+	"emacr;\x00\u0113\x00" +
+	"empty;\x00\u2205\x00" +
+	"emptyset;\x00\u2205\x00" +
+	"emptyv;\x00\u2205\x00" +
+	"emsp13;\x00\u2004\x00" +
+	"emsp14;\x00\u2005\x00" +
+	"emsp;\x00\u2003\x00" +
+	"eng;\x00\u014b\x00" +
+	"ensp;\x00\u2002\x00" +
+	"eogon;\x00\u0119\x00" +
+	"eopf;\x00\U0001d556\x00" +
+	"epar;\x00\u22d5\x00" +
+	"eparsl;\x00\u29e3\x00" +
+	"eplus;\x00\u2a71\x00" +
+	"epsi;\x00\u03b5\x00" +
+	"epsilon;\x00\u03b5\x00" +
+	"epsiv;\x00\u03f5\x00" +
+	"eqcirc;\x00\u2256\x00" +
+	"eqcolon;\x00\u2255\x00" +
+	"eqsim;\x00\u2242\x00" +
+	"eqslantgtr;\x00\u2a96\x00" +
+	"eqslantless;\x00\u2a95\x00" +
+	"equals;\x00=\x00" +
+	"equest;\x00\u225f\x00" +
+	"equiv;\x00\u2261\x00" +
+	"equivDD;\x00\u2a78\x00" +
+	"eqvparsl;\x00\u29e5\x00" +
+	"erDot;\x00\u2253\x00" +
+	"erarr;\x00\u2971\x00" +
+	"escr;\x00\u212f\x00" +
+	"esdot;\x00\u2250\x00" +
+	"esim;\x00\u2242\x00" +
+	"eta;\x00\u03b7\x00" +
+	"eth\x00\u00f0\x00" +
+	"eth;\x00\u00f0\x00" +
+	"euml\x00\u00eb\x00" +
+	"euml;\x00\u00eb\x00" +
+	"euro;\x00\u20ac\x00" +
+	"excl;\x00!\x00" +
+	"exist;\x00\u2203\x00" +
+	"expectation;\x00\u2130\x00" +
+	"exponentiale;\x00\u2147\x00" +
+	"fallingdotseq;\x00\u2252\x00" +
+	"fcy;\x00\u0444\x00" +
+	"female;\x00\u2640\x00" +
+	"ffilig;\x00\ufb03\x00" +
+	"fflig;\x00\ufb00\x00" +
+	"ffllig;\x00\ufb04\x00" +
+	"ffr;\x00\U0001d523\x00" +
+	"filig;\x00\ufb01\x00" +
+	"fjlig;\x00fj\x00" +
+	"flat;\x00\u266d\x00" +
+	"fllig;\x00\ufb02\x00" +
+	"fltns;\x00\u25b1\x00" +
+	"fnof;\x00\u0192\x00" +
+	"fopf;\x00\U0001d557\x00" +
+	"forall;\x00\u2200\x00" +
+	"fork;\x00\u22d4\x00" +
+	"forkv;\x00\u2ad9\x00" +
+	"fpartint;\x00\u2a0d\x00" +
+	"frac12\x00\u00bd\x00" +
+	"frac12;\x00\u00bd\x00" +
+	"frac13;\x00\u2153\x00" +
+	"frac14\x00\u00bc\x00" +
+	"frac14;\x00\u00bc\x00" +
+	"frac15;\x00\u2155\x00" +
+	"frac16;\x00\u2159\x00" +
+	"frac18;\x00\u215b\x00" +
+	"frac23;\x00\u2154\x00" +
+	"frac25;\x00\u2156\x00" +
+	"frac34\x00\u00be\x00" +
+	"frac34;\x00\u00be\x00" +
+	"frac35;\x00\u2157\x00" +
+	"frac38;\x00\u215c\x00" +
+	"frac45;\x00\u2158\x00" +
+	"frac56;\x00\u215a\x00" +
+	"frac58;\x00\u215d\x00" +
+	"frac78;\x00\u215e\x00" +
+	"frasl;\x00\u2044\x00" +
+	"frown;\x00\u2322\x00" +
+	"fscr;\x00\U0001d4bb\x00" +
+	"gE;\x00\u2267\x00" +
+	"gEl;\x00\u2a8c\x00" +
+	"gacute;\x00\u01f5\x00" +
+	"gamma;\x00\u03b3\x00" +
+	"gammad;\x00\u03dd\x00" +
+	"gap;\x00\u2a86\x00" +
+	"gbreve;\x00\u011f\x00" +
+	"gcirc;\x00\u011d\x00" +
+	"gcy;\x00\u0433\x00" +
+	"gdot;\x00\u0121\x00" +
+	"ge;\x00\u2265\x00" +
+	"gel;\x00\u22db\x00" +
+	"geq;\x00\u2265\x00" +
+	"geqq;\x00\u2267\x00" +
+	"geqslant;\x00\u2a7e\x00" +
+	"ges;\x00\u2a7e\x00" +
+	"gescc;\x00\u2aa9\x00" +
+	"gesdot;\x00\u2a80\x00" +
+	"gesdoto;\x00\u2a82\x00" +
+	"gesdotol;\x00\u2a84\x00" +
+	"gesl;\x00\u22db\ufe00\x00" +
+	"gesles;\x00\u2a94\x00" +
+	"gfr;\x00\U0001d524\x00" +
+	"gg;\x00\u226b\x00" +
+	"ggg;\x00\u22d9\x00" +
+	"gimel;\x00\u2137\x00" +
+	"gjcy;\x00\u0453\x00" +
+	"gl;\x00\u2277\x00" +
+	"glE;\x00\u2a92\x00" +
+	"gla;\x00\u2aa5\x00" +
+	"glj;\x00\u2aa4\x00" +
+	"gnE;\x00\u2269\x00" +
+	"gnap;\x00\u2a8a\x00" +
+	"gnapprox;\x00\u2a8a\x00" +
+	"gne;\x00\u2a88\x00" +
+	"gneq;\x00\u2a88\x00" +
+	"gneqq;\x00\u2269\x00" +
+	"gnsim;\x00\u22e7\x00" +
+	"gopf;\x00\U0001d558\x00" +
+	"grave;\x00`\x00" +
+	"gscr;\x00\u210a\x00" +
+	"gsim;\x00\u2273\x00" +
+	"gsime;\x00\u2a8e\x00" +
+	"gsiml;\x00\u2a90\x00" +
+	"gt\x00>\x00" +
+	"gt;\x00>\x00" +
+	"gtcc;\x00\u2aa7\x00" +
+	"gtcir;\x00\u2a7a\x00" +
+	"gtdot;\x00\u22d7\x00" +
+	"gtlPar;\x00\u2995\x00" +
+	"gtquest;\x00\u2a7c\x00" +
+	"gtrapprox;\x00\u2a86\x00" +
+	"gtrarr;\x00\u2978\x00" +
+	"gtrdot;\x00\u22d7\x00" +
+	"gtreqless;\x00\u22db\x00" +
+	"gtreqqless;\x00\u2a8c\x00" +
+	"gtrless;\x00\u2277\x00" +
+	"gtrsim;\x00\u2273\x00" +
+	"gvertneqq;\x00\u2269\ufe00\x00" +
+	"gvnE;\x00\u2269\ufe00\x00" +
+	"hArr;\x00\u21d4\x00" +
+	"hairsp;\x00\u200a\x00" +
+	"half;\x00\u00bd\x00" +
+	"hamilt;\x00\u210b\x00" +
+	"hardcy;\x00\u044a\x00" +
+	"harr;\x00\u2194\x00" +
+	"harrcir;\x00\u2948\x00" +
+	"harrw;\x00\u21ad\x00" +
+	"hbar;\x00\u210f\x00" +
+	"hcirc;\x00\u0125\x00" +
+	"hearts;\x00\u2665\x00" +
+	"heartsuit;\x00\u2665\x00" +
+	"hellip;\x00\u2026\x00" +
+	"hercon;\x00\u22b9\x00" +
+	"hfr;\x00\U0001d525\x00" +
+	"hksearow;\x00\u2925\x00" +
+	"hkswarow;\x00\u2926\x00" +
+	"hoarr;\x00\u21ff\x00" +
+	"homtht;\x00\u223b\x00" +
+	"hookleftarrow;\x00\u21a9\x00" +
+	"hookrightarrow;\x00\u21aa\x00" +
+	"hopf;\x00\U0001d559\x00" +
+	"horbar;\x00\u2015\x00" +
+	"hscr;\x00\U0001d4bd\x00" +
+	"hslash;\x00\u210f\x00" +
+	"hstrok;\x00\u0127\x00" +
+	"hybull;\x00\u2043\x00" +
+	"hyphen;\x00\u2010\x00" +
+	"iacute\x00\u00ed\x00" +
+	"iacute;\x00\u00ed\x00" +
+	"ic;\x00\u2063\x00" +
+	"icirc\x00\u00ee\x00" +
+	"icirc;\x00\u00ee\x00" +
+	"icy;\x00\u0438\x00" +
+	"iecy;\x00\u0435\x00" +
+	"iexcl\x00\u00a1\x00" +
+	"iexcl;\x00\u00a1\x00" +
+	"iff;\x00\u21d4\x00" +
+	"ifr;\x00\U0001d526\x00" +
+	"igrave\x00\u00ec\x00" +
+	"igrave;\x00\u00ec\x00" +
+	"ii;\x00\u2148\x00" +
+	"iiiint;\x00\u2a0c\x00" +
+	"iiint;\x00\u222d\x00" +
+	"iinfin;\x00\u29dc\x00" +
+	"iiota;\x00\u2129\x00" +
+	"ijlig;\x00\u0133\x00" +
+	"imacr;\x00\u012b\x00" +
+	"image;\x00\u2111\x00" +
+	"imagline;\x00\u2110\x00" +
+	"imagpart;\x00\u2111\x00" +
+	"imath;\x00\u0131\x00" +
+	"imof;\x00\u22b7\x00" +
+	"imped;\x00\u01b5\x00" +
+	"in;\x00\u2208\x00" +
+	"incare;\x00\u2105\x00" +
+	"infin;\x00\u221e\x00" +
+	"infintie;\x00\u29dd\x00" +
+	"inodot;\x00\u0131\x00" +
+	"int;\x00\u222b\x00" +
+	"intcal;\x00\u22ba\x00" +
+	"integers;\x00\u2124\x00" +
+	"intercal;\x00\u22ba\x00" +
+	"intlarhk;\x00\u2a17\x00" +
+	"intprod;\x00\u2a3c\x00" +
+	"iocy;\x00\u0451\x00" +
+	"iogon;\x00\u012f\x00" +
+	"iopf;\x00\U0001d55a\x00" +
+	"iota;\x00\u03b9\x00" +
+	"iprod;\x00\u2a3c\x00" +
+	"iquest\x00\u00bf\x00" +
+	"iquest;\x00\u00bf\x00" +
+	"iscr;\x00\U0001d4be\x00" +
+	"isin;\x00\u2208\x00" +
+	"isinE;\x00\u22f9\x00" +
+	"isindot;\x00\u22f5\x00" +
+	"isins;\x00\u22f4\x00" +
+	"isinsv;\x00\u22f3\x00" +
+	"isinv;\x00\u2208\x00" +
+	"it;\x00\u2062\x00" +
+	"itilde;\x00\u0129\x00" +
+	"iukcy;\x00\u0456\x00" +
+	"iuml\x00\u00ef\x00" +
+	"iuml;\x00\u00ef\x00" +
+	"jcirc;\x00\u0135\x00" +
+	"jcy;\x00\u0439\x00" +
+	"jfr;\x00\U0001d527\x00" +
+	"jmath;\x00\u0237\x00" +
+	"jopf;\x00\U0001d55b\x00" +
+	"jscr;\x00\U0001d4bf\x00" +
+	"jsercy;\x00\u0458\x00" +
+	"jukcy;\x00\u0454\x00" +
+	"kappa;\x00\u03ba\x00" +
+	"kappav;\x00\u03f0\x00" +
+	"kcedil;\x00\u0137\x00" +
+	"kcy;\x00\u043a\x00" +
+	"kfr;\x00\U0001d528\x00" +
+	"kgreen;\x00\u0138\x00" +
+	"khcy;\x00\u0445\x00" +
+	"kjcy;\x00\u045c\x00" +
+	"kopf;\x00\U0001d55c\x00" +
+	"kscr;\x00\U0001d4c0\x00" +
+	"lAarr;\x00\u21da\x00" +
+	"lArr;\x00\u21d0\x00" +
+	"lAtail;\x00\u291b\x00" +
+	"lBarr;\x00\u290e\x00" +
+	"lE;\x00\u2266\x00" +
+	"lEg;\x00\u2a8b\x00" +
+	"lHar;\x00\u2962\x00" +
+	"lacute;\x00\u013a\x00" +
+	"laemptyv;\x00\u29b4\x00" +
+	"lagran;\x00\u2112\x00" +
+	"lambda;\x00\u03bb\x00" +
+	"lang;\x00\u27e8\x00" +
+	"langd;\x00\u2991\x00" +
+	"langle;\x00\u27e8\x00" +
+	"lap;\x00\u2a85\x00" +
+	"laquo\x00\u00ab\x00" +
+	"laquo;\x00\u00ab\x00" +
+	"larr;\x00\u2190\x00" +
+	"larrb;\x00\u21e4\x00" +
+	"larrbfs;\x00\u291f\x00" +
+	"larrfs;\x00\u291d\x00" +
+	"larrhk;\x00\u21a9\x00" +
+	"larrlp;\x00\u21ab\x00" +
+	"larrpl;\x00\u2939\x00" +
+	"larrsim;\x00\u2973\x00" +
+	"larrtl;\x00\u21a2\x00" +
+	"lat;\x00\u2aab\x00" +
+	"latail;\x00\u2919\x00" +
+	"late;\x00\u2aad\x00" +
+	"lates;\x00\u2aad\ufe00\x00" +
+	"lbarr;\x00\u290c\x00" +
+	"lbbrk;\x00\u2772\x00" +
+	"lbrace;\x00{\x00" +
+	"lbrack;\x00[\x00" +
+	"lbrke;\x00\u298b\x00" +
+	"lbrksld;\x00\u298f\x00" +
+	"lbrkslu;\x00\u298d\x00" +
+	"lcaron;\x00\u013e\x00" +
+	"lcedil;\x00\u013c\x00" +
+	"lceil;\x00\u2308\x00" +
+	"lcub;\x00{\x00" +
+	"lcy;\x00\u043b\x00" +
+	"ldca;\x00\u2936\x00" +
+	"ldquo;\x00\u201c\x00" +
+	"ldquor;\x00\u201e\x00" +
+	"ldrdhar;\x00\u2967\x00" +
+	"ldrushar;\x00\u294b\x00" +
+	"ldsh;\x00\u21b2\x00" +
+	"le;\x00\u2264\x00" +
+	"leftarrow;\x00\u2190\x00" +
+	"leftarrowtail;\x00\u21a2\x00" +
+	"leftharpoondown;\x00\u21bd\x00" +
+	"leftharpoonup;\x00\u21bc\x00" +
+	"leftleftarrows;\x00\u21c7\x00" +
+	"leftrightarrow;\x00\u2194\x00" +
+	"leftrightarrows;\x00\u21c6\x00" +
+	"leftrightharpoons;\x00\u21cb\x00" +
+	"leftrightsquigarrow;\x00\u21ad\x00" +
+	"leftthreetimes;\x00\u22cb\x00" +
+	"leg;\x00\u22da\x00" +
+	"leq;\x00\u2264\x00" +
+	"leqq;\x00\u2266\x00" +
+	"leqslant;\x00\u2a7d\x00" +
+	"les;\x00\u2a7d\x00" +
+	"lescc;\x00\u2aa8\x00" +
+	"lesdot;\x00\u2a7f\x00" +
+	"lesdoto;\x00\u2a81\x00" +
+	"lesdotor;\x00\u2a83\x00" +
+	"lesg;\x00\u22da\ufe00\x00" +
+	"lesges;\x00\u2a93\x00" +
+	"lessapprox;\x00\u2a85\x00" +
+	"lessdot;\x00\u22d6\x00" +
+	"lesseqgtr;\x00\u22da\x00" +
+	"lesseqqgtr;\x00\u2a8b\x00" +
+	"lessgtr;\x00\u2276\x00" +
+	"lesssim;\x00\u2272\x00" +
+	"lfisht;\x00\u297c\x00" +
+	"lfloor;\x00\u230a\x00" +
+	"lfr;\x00\U0001d529\x00" +
+	"lg;\x00\u2276\x00" +
+	"lgE;\x00\u2a91\x00" +
+	"lhard;\x00\u21bd\x00" +
+	"lharu;\x00\u21bc\x00" +
+	"lharul;\x00\u296a\x00" +
+	"lhblk;\x00\u2584\x00" +
+	"ljcy;\x00\u0459\x00" +
+	"ll;\x00\u226a\x00" +
+	"llarr;\x00\u21c7\x00" +
+	"llcorner;\x00\u231e\x00" +
+	"llhard;\x00\u296b\x00" +
+	"lltri;\x00\u25fa\x00" +
+	"lmidot;\x00\u0140\x00" +
+	"lmoust;\x00\u23b0\x00" +
+	"lmoustache;\x00\u23b0\x00" +
+	"lnE;\x00\u2268\x00" +
+	"lnap;\x00\u2a89\x00" +
+	"lnapprox;\x00\u2a89\x00" +
+	"lne;\x00\u2a87\x00" +
+	"lneq;\x00\u2a87\x00" +
+	"lneqq;\x00\u2268\x00" +
+	"lnsim;\x00\u22e6\x00" +
+	"loang;\x00\u27ec\x00" +
+	"loarr;\x00\u21fd\x00" +
+	"lobrk;\x00\u27e6\x00" +
+	"longleftarrow;\x00\u27f5\x00" +
+	"longleftrightarrow;\x00\u27f7\x00" +
+	"longmapsto;\x00\u27fc\x00" +
+	"longrightarrow;\x00\u27f6\x00" +
+	"looparrowleft;\x00\u21ab\x00" +
+	"looparrowright;\x00\u21ac\x00" +
+	"lopar;\x00\u2985\x00" +
+	"lopf;\x00\U0001d55d\x00" +
+	"loplus;\x00\u2a2d\x00" +
+	"lotimes;\x00\u2a34\x00" +
+	"lowast;\x00\u2217\x00" +
+	"lowbar;\x00_\x00" +
+	"loz;\x00\u25ca\x00" +
+	"lozenge;\x00\u25ca\x00" +
+	"lozf;\x00\u29eb\x00" +
+	"lpar;\x00(\x00" +
+	"lparlt;\x00\u2993\x00" +
+	"lrarr;\x00\u21c6\x00" +
+	"lrcorner;\x00\u231f\x00" +
+	"lrhar;\x00\u21cb\x00" +
+	"lrhard;\x00\u296d\x00" +
+	"lrm;\x00\u200e\x00" +
+	"lrtri;\x00\u22bf\x00" +
+	"lsaquo;\x00\u2039\x00" +
+	"lscr;\x00\U0001d4c1\x00" +
+	"lsh;\x00\u21b0\x00" +
+	"lsim;\x00\u2272\x00" +
+	"lsime;\x00\u2a8d\x00" +
+	"lsimg;\x00\u2a8f\x00" +
+	"lsqb;\x00[\x00" +
+	"lsquo;\x00\u2018\x00" +
+	"lsquor;\x00\u201a\x00" +
+	"lstrok;\x00\u0142\x00" +
+	"lt\x00<\x00" +
+	"lt;\x00<\x00" +
+	"ltcc;\x00\u2aa6\x00" +
+	"ltcir;\x00\u2a79\x00" +
+	"ltdot;\x00\u22d6\x00" +
+	"lthree;\x00\u22cb\x00" +
+	"ltimes;\x00\u22c9\x00" +
+	"ltlarr;\x00\u2976\x00" +
+	"ltquest;\x00\u2a7b\x00" +
+	"ltrPar;\x00\u2996\x00" +
+	"ltri;\x00\u25c3\x00" +
+	"ltrie;\x00\u22b4\x00" +
+	"ltrif;\x00\u25c2\x00" +
+	"lurdshar;\x00\u294a\x00" +
+	"luruhar;\x00\u2966\x00" +
+	"lvertneqq;\x00\u2268\ufe00\x00" +
+	"lvnE;\x00\u2268\ufe00\x00" +
+	"mDDot;\x00\u223a\x00" +
+	"macr\x00\u00af\x00" +
+	"macr;\x00\u00af\x00" +
+	"male;\x00\u2642\x00" +
+	"malt;\x00\u2720\x00" +
+	"maltese;\x00\u2720\x00" +
+	"map;\x00\u21a6\x00" +
+	"mapsto;\x00\u21a6\x00" +
+	"mapstodown;\x00\u21a7\x00" +
+	"mapstoleft;\x00\u21a4\x00" +
+	"mapstoup;\x00\u21a5\x00" +
+	"marker;\x00\u25ae\x00" +
+	"mcomma;\x00\u2a29\x00" +
+	"mcy;\x00\u043c\x00" +
+	"mdash;\x00\u2014\x00" +
+	"measuredangle;\x00\u2221\x00" +
+	"mfr;\x00\U0001d52a\x00" +
+	"mho;\x00\u2127\x00" +
+	"micro\x00\u00b5\x00" +
+	"micro;\x00\u00b5\x00" +
+	"mid;\x00\u2223\x00" +
+	"midast;\x00*\x00" +
+	"midcir;\x00\u2af0\x00" +
+	"middot\x00\u00b7\x00" +
+	"middot;\x00\u00b7\x00" +
+	"minus;\x00\u2212\x00" +
+	"minusb;\x00\u229f\x00" +
+	"minusd;\x00\u2238\x00" +
+	"minusdu;\x00\u2a2a\x00" +
+	"mlcp;\x00\u2adb\x00" +
+	"mldr;\x00\u2026\x00" +
+	"mnplus;\x00\u2213\x00" +
+	"models;\x00\u22a7\x00" +
+	"mopf;\x00\U0001d55e\x00" +
+	"mp;\x00\u2213\x00" +
+	"mscr;\x00\U0001d4c2\x00" +
+	"mstpos;\x00\u223e\x00" +
+	"mu;\x00\u03bc\x00" +
+	"multimap;\x00\u22b8\x00" +
+	"mumap;\x00\u22b8\x00" +
+	"nGg;\x00\u22d9\u0338\x00" +
+	"nGt;\x00\u226b\u20d2\x00" +
+	"nGtv;\x00\u226b\u0338\x00" +
+	"nLeftarrow;\x00\u21cd\x00" +
+	"nLeftrightarrow;\x00\u21ce\x00" +
+	"nLl;\x00\u22d8\u0338\x00" +
+	"nLt;\x00\u226a\u20d2\x00" +
+	"nLtv;\x00\u226a\u0338\x00" +
+	"nRightarrow;\x00\u21cf\x00" +
+	"nVDash;\x00\u22af\x00" +
+	"nVdash;\x00\u22ae\x00" +
+	"nabla;\x00\u2207\x00" +
+	"nacute;\x00\u0144\x00" +
+	"nang;\x00\u2220\u20d2\x00" +
+	"nap;\x00\u2249\x00" +
+	"napE;\x00\u2a70\u0338\x00" +
+	"napid;\x00\u224b\u0338\x00" +
+	"napos;\x00\u0149\x00" +
+	"napprox;\x00\u2249\x00" +
+	"natur;\x00\u266e\x00" +
+	"natural;\x00\u266e\x00" +
+	"naturals;\x00\u2115\x00" +
+	"nbsp\x00\u00a0\x00" +
+	"nbsp;\x00\u00a0\x00" +
+	"nbump;\x00\u224e\u0338\x00" +
+	"nbumpe;\x00\u224f\u0338\x00" +
+	"ncap;\x00\u2a43\x00" +
+	"ncaron;\x00\u0148\x00" +
+	"ncedil;\x00\u0146\x00" +
+	"ncong;\x00\u2247\x00" +
+	"ncongdot;\x00\u2a6d\u0338\x00" +
+	"ncup;\x00\u2a42\x00" +
+	"ncy;\x00\u043d\x00" +
+	"ndash;\x00\u2013\x00" +
+	"ne;\x00\u2260\x00" +
+	"neArr;\x00\u21d7\x00" +
+	"nearhk;\x00\u2924\x00" +
+	"nearr;\x00\u2197\x00" +
+	"nearrow;\x00\u2197\x00" +
+	"nedot;\x00\u2250\u0338\x00" +
+	"nequiv;\x00\u2262\x00" +
+	"nesear;\x00\u2928\x00" +
+	"nesim;\x00\u2242\u0338\x00" +
+	"nexist;\x00\u2204\x00" +
+	"nexists;\x00\u2204\x00" +
+	"nfr;\x00\U0001d52b\x00" +
+	"ngE;\x00\u2267\u0338\x00" +
+	"nge;\x00\u2271\x00" +
+	"ngeq;\x00\u2271\x00" +
+	"ngeqq;\x00\u2267\u0338\x00" +
+	"ngeqslant;\x00\u2a7e\u0338\x00" +
+	"nges;\x00\u2a7e\u0338\x00" +
+	"ngsim;\x00\u2275\x00" +
+	"ngt;\x00\u226f\x00" +
+	"ngtr;\x00\u226f\x00" +
+	"nhArr;\x00\u21ce\x00" +
+	"nharr;\x00\u21ae\x00" +
+	"nhpar;\x00\u2af2\x00" +
+	"ni;\x00\u220b\x00" +
+	"nis;\x00\u22fc\x00" +
+	"nisd;\x00\u22fa\x00" +
+	"niv;\x00\u220b\x00" +
+	"njcy;\x00\u045a\x00" +
+	"nlArr;\x00\u21cd\x00" +
+	"nlE;\x00\u2266\u0338\x00" +
+	"nlarr;\x00\u219a\x00" +
+	"nldr;\x00\u2025\x00" +
+	"nle;\x00\u2270\x00" +
+	"nleftarrow;\x00\u219a\x00" +
+	"nleftrightarrow;\x00\u21ae\x00" +
+	"nleq;\x00\u2270\x00" +
+	"nleqq;\x00\u2266\u0338\x00" +
+	"nleqslant;\x00\u2a7d\u0338\x00" +
+	"nles;\x00\u2a7d\u0338\x00" +
+	"nless;\x00\u226e\x00" +
+	"nlsim;\x00\u2274\x00" +
+	"nlt;\x00\u226e\x00" +
+	"nltri;\x00\u22ea\x00" +
+	"nltrie;\x00\u22ec\x00" +
+	"nmid;\x00\u2224\x00" +
+	"nopf;\x00\U0001d55f\x00" +
+	"not\x00\u00ac\x00" +
+	"not;\x00\u00ac\x00" +
+	"notin;\x00\u2209\x00" +
+	"notinE;\x00\u22f9\u0338\x00" +
+	"notindot;\x00\u22f5\u0338\x00" +
+	"notinva;\x00\u2209\x00" +
+	"notinvb;\x00\u22f7\x00" +
+	"notinvc;\x00\u22f6\x00" +
+	"notni;\x00\u220c\x00" +
+	"notniva;\x00\u220c\x00" +
+	"notnivb;\x00\u22fe\x00" +
+	"notnivc;\x00\u22fd\x00" +
+	"npar;\x00\u2226\x00" +
+	"nparallel;\x00\u2226\x00" +
+	"nparsl;\x00\u2afd\u20e5\x00" +
+	"npart;\x00\u2202\u0338\x00" +
+	"npolint;\x00\u2a14\x00" +
+	"npr;\x00\u2280\x00" +
+	"nprcue;\x00\u22e0\x00" +
+	"npre;\x00\u2aaf\u0338\x00" +
+	"nprec;\x00\u2280\x00" +
+	"npreceq;\x00\u2aaf\u0338\x00" +
+	"nrArr;\x00\u21cf\x00" +
+	"nrarr;\x00\u219b\x00" +
+	"nrarrc;\x00\u2933\u0338\x00" +
+	"nrarrw;\x00\u219d\u0338\x00" +
+	"nrightarrow;\x00\u219b\x00" +
+	"nrtri;\x00\u22eb\x00" +
+	"nrtrie;\x00\u22ed\x00" +
+	"nsc;\x00\u2281\x00" +
+	"nsccue;\x00\u22e1\x00" +
+	"nsce;\x00\u2ab0\u0338\x00" +
+	"nscr;\x00\U0001d4c3\x00" +
+	"nshortmid;\x00\u2224\x00" +
+	"nshortparallel;\x00\u2226\x00" +
+	"nsim;\x00\u2241\x00" +
+	"nsime;\x00\u2244\x00" +
+	"nsimeq;\x00\u2244\x00" +
+	"nsmid;\x00\u2224\x00" +
+	"nspar;\x00\u2226\x00" +
+	"nsqsube;\x00\u22e2\x00" +
+	"nsqsupe;\x00\u22e3\x00" +
+	"nsub;\x00\u2284\x00" +
+	"nsubE;\x00\u2ac5\u0338\x00" +
+	"nsube;\x00\u2288\x00" +
+	"nsubset;\x00\u2282\u20d2\x00" +
+	"nsubseteq;\x00\u2288\x00" +
+	"nsubseteqq;\x00\u2ac5\u0338\x00" +
+	"nsucc;\x00\u2281\x00" +
+	"nsucceq;\x00\u2ab0\u0338\x00" +
+	"nsup;\x00\u2285\x00" +
+	"nsupE;\x00\u2ac6\u0338\x00" +
+	"nsupe;\x00\u2289\x00" +
+	"nsupset;\x00\u2283\u20d2\x00" +
+	"nsupseteq;\x00\u2289\x00" +
+	"nsupseteqq;\x00\u2ac6\u0338\x00" +
+	"ntgl;\x00\u2279\x00" +
+	"ntilde\x00\u00f1\x00" +
+	"ntilde;\x00\u00f1\x00" +
+	"ntlg;\x00\u2278\x00" +
+	"ntriangleleft;\x00\u22ea\x00" +
+	"ntrianglelefteq;\x00\u22ec\x00" +
+	"ntriangleright;\x00\u22eb\x00" +
+	"ntrianglerighteq;\x00\u22ed\x00" +
+	"nu;\x00\u03bd\x00" +
+	"num;\x00#\x00" +
+	"numero;\x00\u2116\x00" +
+	"numsp;\x00\u2007\x00" +
+	"nvDash;\x00\u22ad\x00" +
+	"nvHarr;\x00\u2904\x00" +
+	"nvap;\x00\u224d\u20d2\x00" +
+	"nvdash;\x00\u22ac\x00" +
+	"nvge;\x00\u2265\u20d2\x00" +
+	"nvgt;\x00>\u20d2\x00" +
+	"nvinfin;\x00\u29de\x00" +
+	"nvlArr;\x00\u2902\x00" +
+	"nvle;\x00\u2264\u20d2\x00" +
+	"nvlt;\x00<\u20d2\x00" +
+	"nvltrie;\x00\u22b4\u20d2\x00" +
+	"nvrArr;\x00\u2903\x00" +
+	"nvrtrie;\x00\u22b5\u20d2\x00" +
+	"nvsim;\x00\u223c\u20d2\x00" +
+	"nwArr;\x00\u21d6\x00" +
+	"nwarhk;\x00\u2923\x00" +
+	"nwarr;\x00\u2196\x00" +
+	"nwarrow;\x00\u2196\x00" +
+	"nwnear;\x00\u2927\x00" +
+	"oS;\x00\u24c8\x00" +
+	"oacute\x00\u00f3\x00" +
+	"oacute;\x00\u00f3\x00" +
+	"oast;\x00\u229b\x00" +
+	"ocir;\x00\u229a\x00" +
+	"ocirc\x00\u00f4\x00" +
+	"ocirc;\x00\u00f4\x00" +
+	"ocy;\x00\u043e\x00" +
+	"odash;\x00\u229d\x00" +
+	"odblac;\x00\u0151\x00" +
+	"odiv;\x00\u2a38\x00" +
+	"odot;\x00\u2299\x00" +
+	"odsold;\x00\u29bc\x00" +
+	"oelig;\x00\u0153\x00" +
+	"ofcir;\x00\u29bf\x00" +
+	"ofr;\x00\U0001d52c\x00" +
+	"ogon;\x00\u02db\x00" +
+	"ograve\x00\u00f2\x00" +
+	"ograve;\x00\u00f2\x00" +
+	"ogt;\x00\u29c1\x00" +
+	"ohbar;\x00\u29b5\x00" +
+	"ohm;\x00\u03a9\x00" +
+	"oint;\x00\u222e\x00" +
+	"olarr;\x00\u21ba\x00" +
+	"olcir;\x00\u29be\x00" +
+	"olcross;\x00\u29bb\x00" +
+	"oline;\x00\u203e\x00" +
+	"olt;\x00\u29c0\x00" +
+	"omacr;\x00\u014d\x00" +
+	"omega;\x00\u03c9\x00" +
+	"omicron;\x00\u03bf\x00" +
+	"omid;\x00\u29b6\x00" +
+	"ominus;\x00\u2296\x00" +
+	"oopf;\x00\U0001d560\x00" +
+	"opar;\x00\u29b7\x00" +
+	"operp;\x00\u29b9\x00" +
+	"oplus;\x00\u2295\x00" +
+	"or;\x00\u2228\x00" +
+	"orarr;\x00\u21bb\x00" +
+	"ord;\x00\u2a5d\x00" +
+	"order;\x00\u2134\x00" +
+	"orderof;\x00\u2134\x00" +
+	"ordf\x00\u00aa\x00" +
+	"ordf;\x00\u00aa\x00" +
+	"ordm\x00\u00ba\x00" +
+	"ordm;\x00\u00ba\x00" +
+	"origof;\x00\u22b6\x00" +
+	"oror;\x00\u2a56\x00" +
+	"orslope;\x00\u2a57\x00" +
+	"orv;\x00\u2a5b\x00" +
+	"oscr;\x00\u2134\x00" +
+	"oslash\x00\u00f8\x00" +
+	"oslash;\x00\u00f8\x00" +
+	"osol;\x00\u2298\x00" +
+	"otilde\x00\u00f5\x00" +
+	"otilde;\x00\u00f5\x00" +
+	"otimes;\x00\u2297\x00" +
+	"otimesas;\x00\u2a36\x00" +
+	"ouml\x00\u00f6\x00" +
+	"ouml;\x00\u00f6\x00" +
+	"ovbar;\x00\u233d\x00" +
+	"par;\x00\u2225\x00" +
+	"para\x00\u00b6\x00" +
+	"para;\x00\u00b6\x00" +
+	"parallel;\x00\u2225\x00" +
+	"parsim;\x00\u2af3\x00" +
+	"parsl;\x00\u2afd\x00" +
+	"part;\x00\u2202\x00" +
+	"pcy;\x00\u043f\x00" +
+	"percnt;\x00%\x00" +
+	"period;\x00.\x00" +
+	"permil;\x00\u2030\x00" +
+	"perp;\x00\u22a5\x00" +
+	"pertenk;\x00\u2031\x00" +
+	"pfr;\x00\U0001d52d\x00" +
+	"phi;\x00\u03c6\x00" +
+	"phiv;\x00\u03d5\x00" +
+	"phmmat;\x00\u2133\x00" +
+	"phone;\x00\u260e\x00" +
+	"pi;\x00\u03c0\x00" +
+	"pitchfork;\x00\u22d4\x00" +
+	"piv;\x00\u03d6\x00" +
+	"planck;\x00\u210f\x00" +
+	"planckh;\x00\u210e\x00" +
+	"plankv;\x00\u210f\x00" +
+	"plus;\x00+\x00" +
+	"plusacir;\x00\u2a23\x00" +
+	"plusb;\x00\u229e\x00" +
+	"pluscir;\x00\u2a22\x00" +
+	"plusdo;\x00\u2214\x00" +
+	"plusdu;\x00\u2a25\x00" +
+	"pluse;\x00\u2a72\x00" +
+	"plusmn\x00\u00b1\x00" +
+	"plusmn;\x00\u00b1\x00" +
+	"plussim;\x00\u2a26\x00" +
+	"plustwo;\x00\u2a27\x00" +
+	"pm;\x00\u00b1\x00" +
+	"pointint;\x00\u2a15\x00" +
+	"popf;\x00\U0001d561\x00" +
+	"pound\x00\u00a3\x00" +
+	"pound;\x00\u00a3\x00" +
+	"pr;\x00\u227a\x00" +
+	"prE;\x00\u2ab3\x00" +
+	"prap;\x00\u2ab7\x00" +
+	"prcue;\x00\u227c\x00" +
+	"pre;\x00\u2aaf\x00" +
+	"prec;\x00\u227a\x00" +
+	"precapprox;\x00\u2ab7\x00" +
+	"preccurlyeq;\x00\u227c\x00" +
+	"preceq;\x00\u2aaf\x00" +
+	"precnapprox;\x00\u2ab9\x00" +
+	"precneqq;\x00\u2ab5\x00" +
+	"precnsim;\x00\u22e8\x00" +
+	"precsim;\x00\u227e\x00" +
+	"prime;\x00\u2032\x00" +
+	"primes;\x00\u2119\x00" +
+	"prnE;\x00\u2ab5\x00" +
+	"prnap;\x00\u2ab9\x00" +
+	"prnsim;\x00\u22e8\x00" +
+	"prod;\x00\u220f\x00" +
+	"profalar;\x00\u232e\x00" +
+	"profline;\x00\u2312\x00" +
+	"profsurf;\x00\u2313\x00" +
+	"prop;\x00\u221d\x00" +
+	"propto;\x00\u221d\x00" +
+	"prsim;\x00\u227e\x00" +
+	"prurel;\x00\u22b0\x00" +
+	"pscr;\x00\U0001d4c5\x00" +
+	"psi;\x00\u03c8\x00" +
+	"puncsp;\x00\u2008\x00" +
+	"qfr;\x00\U0001d52e\x00" +
+	"qint;\x00\u2a0c\x00" +
+	"qopf;\x00\U0001d562\x00" +
+	"qprime;\x00\u2057\x00" +
+	"qscr;\x00\U0001d4c6\x00" +
+	"quaternions;\x00\u210d\x00" +
+	"quatint;\x00\u2a16\x00" +
+	"quest;\x00?\x00" +
+	"questeq;\x00\u225f\x00" +
+	"quot\x00\"\x00" +
+	"quot;\x00\"\x00" +
+	"rAarr;\x00\u21db\x00" +
+	"rArr;\x00\u21d2\x00" +
+	"rAtail;\x00\u291c\x00" +
+	"rBarr;\x00\u290f\x00" +
+	"rHar;\x00\u2964\x00" +
+	"race;\x00\u223d\u0331\x00" +
+	"racute;\x00\u0155\x00" +
+	"radic;\x00\u221a\x00" +
+	"raemptyv;\x00\u29b3\x00" +
+	"rang;\x00\u27e9\x00" +
+	"rangd;\x00\u2992\x00" +
+	"range;\x00\u29a5\x00" +
+	"rangle;\x00\u27e9\x00" +
+	"raquo\x00\u00bb\x00" +
+	"raquo;\x00\u00bb\x00" +
+	"rarr;\x00\u2192\x00" +
+	"rarrap;\x00\u2975\x00" +
+	"rarrb;\x00\u21e5\x00" +
+	"rarrbfs;\x00\u2920\x00" +
+	"rarrc;\x00\u2933\x00" +
+	"rarrfs;\x00\u291e\x00" +
+	"rarrhk;\x00\u21aa\x00" +
+	"rarrlp;\x00\u21ac\x00" +
+	"rarrpl;\x00\u2945\x00" +
+	"rarrsim;\x00\u2974\x00" +
+	"rarrtl;\x00\u21a3\x00" +
+	"rarrw;\x00\u219d\x00" +
+	"ratail;\x00\u291a\x00" +
+	"ratio;\x00\u2236\x00" +
+	"rationals;\x00\u211a\x00" +
+	"rbarr;\x00\u290d\x00" +
+	"rbbrk;\x00\u2773\x00" +
+	"rbrace;\x00}\x00" +
+	"rbrack;\x00]\x00" +
+	"rbrke;\x00\u298c\x00" +
+	"rbrksld;\x00\u298e\x00" +
+	"rbrkslu;\x00\u2990\x00" +
+	"rcaron;\x00\u0159\x00" +
+	"rcedil;\x00\u0157\x00" +
+	"rceil;\x00\u2309\x00" +
+	"rcub;\x00}\x00" +
+	"rcy;\x00\u0440\x00" +
+	"rdca;\x00\u2937\x00" +
+	"rdldhar;\x00\u2969\x00" +
+	"rdquo;\x00\u201d\x00" +
+	"rdquor;\x00\u201d\x00" +
+	"rdsh;\x00\u21b3\x00" +
+	"real;\x00\u211c\x00" +
+	"realine;\x00\u211b\x00" +
+	"realpart;\x00\u211c\x00" +
+	"reals;\x00\u211d\x00" +
+	"rect;\x00\u25ad\x00" +
+	"reg\x00\u00ae\x00" +
+	"reg;\x00\u00ae\x00" +
+	"rfisht;\x00\u297d\x00" +
+	"rfloor;\x00\u230b\x00" +
+	"rfr;\x00\U0001d52f\x00" +
+	"rhard;\x00\u21c1\x00" +
+	"rharu;\x00\u21c0\x00" +
+	"rharul;\x00\u296c\x00" +
+	"rho;\x00\u03c1\x00" +
+	"rhov;\x00\u03f1\x00" +
+	"rightarrow;\x00\u2192\x00" +
+	"rightarrowtail;\x00\u21a3\x00" +
+	"rightharpoondown;\x00\u21c1\x00" +
+	"rightharpoonup;\x00\u21c0\x00" +
+	"rightleftarrows;\x00\u21c4\x00" +
+	"rightleftharpoons;\x00\u21cc\x00" +
+	"rightrightarrows;\x00\u21c9\x00" +
+	"rightsquigarrow;\x00\u219d\x00" +
+	"rightthreetimes;\x00\u22cc\x00" +
+	"ring;\x00\u02da\x00" +
+	"risingdotseq;\x00\u2253\x00" +
+	"rlarr;\x00\u21c4\x00" +
+	"rlhar;\x00\u21cc\x00" +
+	"rlm;\x00\u200f\x00" +
+	"rmoust;\x00\u23b1\x00" +
+	"rmoustache;\x00\u23b1\x00" +
+	"rnmid;\x00\u2aee\x00" +
+	"roang;\x00\u27ed\x00" +
+	"roarr;\x00\u21fe\x00" +
+	"robrk;\x00\u27e7\x00" +
+	"ropar;\x00\u2986\x00" +
+	"ropf;\x00\U0001d563\x00" +
+	"roplus;\x00\u2a2e\x00" +
+	"rotimes;\x00\u2a35\x00" +
+	"rpar;\x00)\x00" +
+	"rpargt;\x00\u2994\x00" +
+	"rppolint;\x00\u2a12\x00" +
+	"rrarr;\x00\u21c9\x00" +
+	"rsaquo;\x00\u203a\x00" +
+	"rscr;\x00\U0001d4c7\x00" +
+	"rsh;\x00\u21b1\x00" +
+	"rsqb;\x00]\x00" +
+	"rsquo;\x00\u2019\x00" +
+	"rsquor;\x00\u2019\x00" +
+	"rthree;\x00\u22cc\x00" +
+	"rtimes;\x00\u22ca\x00" +
+	"rtri;\x00\u25b9\x00" +
+	"rtrie;\x00\u22b5\x00" +
+	"rtrif;\x00\u25b8\x00" +
+	"rtriltri;\x00\u29ce\x00" +
+	"ruluhar;\x00\u2968\x00" +
+	"rx;\x00\u211e\x00" +
+	"sacute;\x00\u015b\x00" +
+	"sbquo;\x00\u201a\x00" +
+	"sc;\x00\u227b\x00" +
+	"scE;\x00\u2ab4\x00" +
+	"scap;\x00\u2ab8\x00" +
+	"scaron;\x00\u0161\x00" +
+	"sccue;\x00\u227d\x00" +
+	"sce;\x00\u2ab0\x00" +
+	"scedil;\x00\u015f\x00" +
+	"scirc;\x00\u015d\x00" +
+	"scnE;\x00\u2ab6\x00" +
+	"scnap;\x00\u2aba\x00" +
+	"scnsim;\x00\u22e9\x00" +
+	"scpolint;\x00\u2a13\x00" +
+	"scsim;\x00\u227f\x00" +
+	"scy;\x00\u0441\x00" +
+	"sdot;\x00\u22c5\x00" +
+	"sdotb;\x00\u22a1\x00" +
+	"sdote;\x00\u2a66\x00" +
+	"seArr;\x00\u21d8\x00" +
+	"searhk;\x00\u2925\x00" +
+	"searr;\x00\u2198\x00" +
+	"searrow;\x00\u2198\x00" +
+	"sect\x00\u00a7\x00" +
+	"sect;\x00\u00a7\x00" +
+	"semi;\x00;\x00" +
+	"seswar;\x00\u2929\x00" +
+	"setminus;\x00\u2216\x00" +
+	"setmn;\x00\u2216\x00" +
+	"sext;\x00\u2736\x00" +
+	"sfr;\x00\U0001d530\x00" +
+	"sfrown;\x00\u2322\x00" +
+	"sharp;\x00\u266f\x00" +
+	"shchcy;\x00\u0449\x00" +
+	"shcy;\x00\u0448\x00" +
+	"shortmid;\x00\u2223\x00" +
+	"shortparallel;\x00\u2225\x00" +
+	"shy\x00\u00ad\x00" +
+	"shy;\x00\u00ad\x00" +
+	"sigma;\x00\u03c3\x00" +
+	"sigmaf;\x00\u03c2\x00" +
+	"sigmav;\x00\u03c2\x00" +
+	"sim;\x00\u223c\x00" +
+	"simdot;\x00\u2a6a\x00" +
+	"sime;\x00\u2243\x00" +
+	"simeq;\x00\u2243\x00" +
+	"simg;\x00\u2a9e\x00" +
+	"simgE;\x00\u2aa0\x00" +
+	"siml;\x00\u2a9d\x00" +
+	"simlE;\x00\u2a9f\x00" +
+	"simne;\x00\u2246\x00" +
+	"simplus;\x00\u2a24\x00" +
+	"simrarr;\x00\u2972\x00" +
+	"slarr;\x00\u2190\x00" +
+	"smallsetminus;\x00\u2216\x00" +
+	"smashp;\x00\u2a33\x00" +
+	"smeparsl;\x00\u29e4\x00" +
+	"smid;\x00\u2223\x00" +
+	"smile;\x00\u2323\x00" +
+	"smt;\x00\u2aaa\x00" +
+	"smte;\x00\u2aac\x00" +
+	"smtes;\x00\u2aac\ufe00\x00" +
+	"softcy;\x00\u044c\x00" +
+	"sol;\x00/\x00" +
+	"solb;\x00\u29c4\x00" +
+	"solbar;\x00\u233f\x00" +
+	"sopf;\x00\U0001d564\x00" +
+	"spades;\x00\u2660\x00" +
+	"spadesuit;\x00\u2660\x00" +
+	"spar;\x00\u2225\x00" +
+	"sqcap;\x00\u2293\x00" +
+	"sqcaps;\x00\u2293\ufe00\x00" +
+	"sqcup;\x00\u2294\x00" +
+	"sqcups;\x00\u2294\ufe00\x00" +
+	"sqsub;\x00\u228f\x00" +
+	"sqsube;\x00\u2291\x00" +
+	"sqsubset;\x00\u228f\x00" +
+	"sqsubseteq;\x00\u2291\x00" +
+	"sqsup;\x00\u2290\x00" +
+	"sqsupe;\x00\u2292\x00" +
+	"sqsupset;\x00\u2290\x00" +
+	"sqsupseteq;\x00\u2292\x00" +
+	"squ;\x00\u25a1\x00" +
+	"square;\x00\u25a1\x00" +
+	"squarf;\x00\u25aa\x00" +
+	"squf;\x00\u25aa\x00" +
+	"srarr;\x00\u2192\x00" +
+	"sscr;\x00\U0001d4c8\x00" +
+	"ssetmn;\x00\u2216\x00" +
+	"ssmile;\x00\u2323\x00" +
+	"sstarf;\x00\u22c6\x00" +
+	"star;\x00\u2606\x00" +
+	"starf;\x00\u2605\x00" +
+	"straightepsilon;\x00\u03f5\x00" +
+	"straightphi;\x00\u03d5\x00" +
+	"strns;\x00\u00af\x00" +
+	"sub;\x00\u2282\x00" +
+	"subE;\x00\u2ac5\x00" +
+	"subdot;\x00\u2abd\x00" +
+	"sube;\x00\u2286\x00" +
+	"subedot;\x00\u2ac3\x00" +
+	"submult;\x00\u2ac1\x00" +
+	"subnE;\x00\u2acb\x00" +
+	"subne;\x00\u228a\x00" +
+	"subplus;\x00\u2abf\x00" +
+	"subrarr;\x00\u2979\x00" +
+	"subset;\x00\u2282\x00" +
+	"subseteq;\x00\u2286\x00" +
+	"subseteqq;\x00\u2ac5\x00" +
+	"subsetneq;\x00\u228a\x00" +
+	"subsetneqq;\x00\u2acb\x00" +
+	"subsim;\x00\u2ac7\x00" +
+	"subsub;\x00\u2ad5\x00" +
+	"subsup;\x00\u2ad3\x00" +
+	"succ;\x00\u227b\x00" +
+	"succapprox;\x00\u2ab8\x00" +
+	"succcurlyeq;\x00\u227d\x00" +
+	"succeq;\x00\u2ab0\x00" +
+	"succnapprox;\x00\u2aba\x00" +
+	"succneqq;\x00\u2ab6\x00" +
+	"succnsim;\x00\u22e9\x00" +
+	"succsim;\x00\u227f\x00" +
+	"sum;\x00\u2211\x00" +
+	"sung;\x00\u266a\x00" +
+	"sup1\x00\u00b9\x00" +
+	"sup1;\x00\u00b9\x00" +
+	"sup2\x00\u00b2\x00" +
+	"sup2;\x00\u00b2\x00" +
+	"sup3\x00\u00b3\x00" +
+	"sup3;\x00\u00b3\x00" +
+	"sup;\x00\u2283\x00" +
+	"supE;\x00\u2ac6\x00" +
+	"supdot;\x00\u2abe\x00" +
+	"supdsub;\x00\u2ad8\x00" +
+	"supe;\x00\u2287\x00" +
+	"supedot;\x00\u2ac4\x00" +
+	"suphsol;\x00\u27c9\x00" +
+	"suphsub;\x00\u2ad7\x00" +
+	"suplarr;\x00\u297b\x00" +
+	"supmult;\x00\u2ac2\x00" +
+	"supnE;\x00\u2acc\x00" +
+	"supne;\x00\u228b\x00" +
+	"supplus;\x00\u2ac0\x00" +
+	"supset;\x00\u2283\x00" +
+	"supseteq;\x00\u2287\x00" +
+	"supseteqq;\x00\u2ac6\x00" +
+	"supsetneq;\x00\u228b\x00" +
+	"supsetneqq;\x00\u2acc\x00" +
+	"supsim;\x00\u2ac8\x00" +
+	"supsub;\x00\u2ad4\x00" +
+	"supsup;\x00\u2ad6\x00" +
+	"swArr;\x00\u21d9\x00" +
+	"swarhk;\x00\u2926\x00" +
+	"swarr;\x00\u2199\x00" +
+	"swarrow;\x00\u2199\x00" +
+	"swnwar;\x00\u292a\x00" +
+	"szlig\x00\u00df\x00" +
+	"szlig;\x00\u00df\x00" +
+	"target;\x00\u2316\x00" +
+	"tau;\x00\u03c4\x00" +
+	"tbrk;\x00\u23b4\x00" +
+	"tcaron;\x00\u0165\x00" +
+	"tcedil;\x00\u0163\x00" +
+	"tcy;\x00\u0442\x00" +
+	"tdot;\x00\u20db\x00" +
+	"telrec;\x00\u2315\x00" +
+	"tfr;\x00\U0001d531\x00" +
+	"there4;\x00\u2234\x00" +
+	"therefore;\x00\u2234\x00" +
+	"theta;\x00\u03b8\x00" +
+	"thetasym;\x00\u03d1\x00" +
+	"thetav;\x00\u03d1\x00" +
+	"thickapprox;\x00\u2248\x00" +
+	"thicksim;\x00\u223c\x00" +
+	"thinsp;\x00\u2009\x00" +
+	"thkap;\x00\u2248\x00" +
+	"thksim;\x00\u223c\x00" +
+	"thorn\x00\u00fe\x00" +
+	"thorn;\x00\u00fe\x00" +
+	"tilde;\x00\u02dc\x00" +
+	"times\x00\u00d7\x00" +
+	"times;\x00\u00d7\x00" +
+	"timesb;\x00\u22a0\x00" +
+	"timesbar;\x00\u2a31\x00" +
+	"timesd;\x00\u2a30\x00" +
+	"tint;\x00\u222d\x00" +
+	"toea;\x00\u2928\x00" +
+	"top;\x00\u22a4\x00" +
+	"topbot;\x00\u2336\x00" +
+	"topcir;\x00\u2af1\x00" +
+	"topf;\x00\U0001d565\x00" +
+	"topfork;\x00\u2ada\x00" +
+	"tosa;\x00\u2929\x00" +
+	"tprime;\x00\u2034\x00" +
+	"trade;\x00\u2122\x00" +
+	"triangle;\x00\u25b5\x00" +
+	"triangledown;\x00\u25bf\x00" +
+	"triangleleft;\x00\u25c3\x00" +
+	"trianglelefteq;\x00\u22b4\x00" +
+	"triangleq;\x00\u225c\x00" +
+	"triangleright;\x00\u25b9\x00" +
+	"trianglerighteq;\x00\u22b5\x00" +
+	"tridot;\x00\u25ec\x00" +
+	"trie;\x00\u225c\x00" +
+	"triminus;\x00\u2a3a\x00" +
+	"triplus;\x00\u2a39\x00" +
+	"trisb;\x00\u29cd\x00" +
+	"tritime;\x00\u2a3b\x00" +
+	"trpezium;\x00\u23e2\x00" +
+	"tscr;\x00\U0001d4c9\x00" +
+	"tscy;\x00\u0446\x00" +
+	"tshcy;\x00\u045b\x00" +
+	"tstrok;\x00\u0167\x00" +
+	"twixt;\x00\u226c\x00" +
+	"twoheadleftarrow;\x00\u219e\x00" +
+	"twoheadrightarrow;\x00\u21a0\x00" +
+	"uArr;\x00\u21d1\x00" +
+	"uHar;\x00\u2963\x00" +
+	"uacute\x00\u00fa\x00" +
+	"uacute;\x00\u00fa\x00" +
+	"uarr;\x00\u2191\x00" +
+	"ubrcy;\x00\u045e\x00" +
+	"ubreve;\x00\u016d\x00" +
+	"ucirc\x00\u00fb\x00" +
+	"ucirc;\x00\u00fb\x00" +
+	"ucy;\x00\u0443\x00" +
+	"udarr;\x00\u21c5\x00" +
+	"udblac;\x00\u0171\x00" +
+	"udhar;\x00\u296e\x00" +
+	"ufisht;\x00\u297e\x00" +
+	"ufr;\x00\U0001d532\x00" +
+	"ugrave\x00\u00f9\x00" +
+	"ugrave;\x00\u00f9\x00" +
+	"uharl;\x00\u21bf\x00" +
+	"uharr;\x00\u21be\x00" +
+	"uhblk;\x00\u2580\x00" +
+	"ulcorn;\x00\u231c\x00" +
+	"ulcorner;\x00\u231c\x00" +
+	"ulcrop;\x00\u230f\x00" +
+	"ultri;\x00\u25f8\x00" +
+	"umacr;\x00\u016b\x00" +
+	"uml\x00\u00a8\x00" +
+	"uml;\x00\u00a8\x00" +
+	"uogon;\x00\u0173\x00" +
+	"uopf;\x00\U0001d566\x00" +
+	"uparrow;\x00\u2191\x00" +
+	"updownarrow;\x00\u2195\x00" +
+	"upharpoonleft;\x00\u21bf\x00" +
+	"upharpoonright;\x00\u21be\x00" +
+	"uplus;\x00\u228e\x00" +
+	"upsi;\x00\u03c5\x00" +
+	"upsih;\x00\u03d2\x00" +
+	"upsilon;\x00\u03c5\x00" +
+	"upuparrows;\x00\u21c8\x00" +
+	"urcorn;\x00\u231d\x00" +
+	"urcorner;\x00\u231d\x00" +
+	"urcrop;\x00\u230e\x00" +
+	"uring;\x00\u016f\x00" +
+	"urtri;\x00\u25f9\x00" +
+	"uscr;\x00\U0001d4ca\x00" +
+	"utdot;\x00\u22f0\x00" +
+	"utilde;\x00\u0169\x00" +
+	"utri;\x00\u25b5\x00" +
+	"utrif;\x00\u25b4\x00" +
+	"uuarr;\x00\u21c8\x00" +
+	"uuml\x00\u00fc\x00" +
+	"uuml;\x00\u00fc\x00" +
+	"uwangle;\x00\u29a7\x00" +
+	"vArr;\x00\u21d5\x00" +
+	"vBar;\x00\u2ae8\x00" +
+	"vBarv;\x00\u2ae9\x00" +
+	"vDash;\x00\u22a8\x00" +
+	"vangrt;\x00\u299c\x00" +
+	"varepsilon;\x00\u03f5\x00" +
+	"varkappa;\x00\u03f0\x00" +
+	"varnothing;\x00\u2205\x00" +
+	"varphi;\x00\u03d5\x00" +
+	"varpi;\x00\u03d6\x00" +
+	"varpropto;\x00\u221d\x00" +
+	"varr;\x00\u2195\x00" +
+	"varrho;\x00\u03f1\x00" +
+	"varsigma;\x00\u03c2\x00" +
+	"varsubsetneq;\x00\u228a\ufe00\x00" +
+	"varsubsetneqq;\x00\u2acb\ufe00\x00" +
+	"varsupsetneq;\x00\u228b\ufe00\x00" +
+	"varsupsetneqq;\x00\u2acc\ufe00\x00" +
+	"vartheta;\x00\u03d1\x00" +
+	"vartriangleleft;\x00\u22b2\x00" +
+	"vartriangleright;\x00\u22b3\x00" +
+	"vcy;\x00\u0432\x00" +
+	"vdash;\x00\u22a2\x00" +
+	"vee;\x00\u2228\x00" +
+	"veebar;\x00\u22bb\x00" +
+	"veeeq;\x00\u225a\x00" +
+	"vellip;\x00\u22ee\x00" +
+	"verbar;\x00|\x00" +
+	"vert;\x00|\x00" +
+	"vfr;\x00\U0001d533\x00" +
+	"vltri;\x00\u22b2\x00" +
+	"vnsub;\x00\u2282\u20d2\x00" +
+	"vnsup;\x00\u2283\u20d2\x00" +
+	"vopf;\x00\U0001d567\x00" +
+	"vprop;\x00\u221d\x00" +
+	"vrtri;\x00\u22b3\x00" +
+	"vscr;\x00\U0001d4cb\x00" +
+	"vsubnE;\x00\u2acb\ufe00\x00" +
+	"vsubne;\x00\u228a\ufe00\x00" +
+	"vsupnE;\x00\u2acc\ufe00\x00" +
+	"vsupne;\x00\u228b\ufe00\x00" +
+	"vzigzag;\x00\u299a\x00" +
+	"wcirc;\x00\u0175\x00" +
+	"wedbar;\x00\u2a5f\x00" +
+	"wedge;\x00\u2227\x00" +
+	"wedgeq;\x00\u2259\x00" +
+	"weierp;\x00\u2118\x00" +
+	"wfr;\x00\U0001d534\x00" +
+	"wopf;\x00\U0001d568\x00" +
+	"wp;\x00\u2118\x00" +
+	"wr;\x00\u2240\x00" +
+	"wreath;\x00\u2240\x00" +
+	"wscr;\x00\U0001d4cc\x00" +
+	"xcap;\x00\u22c2\x00" +
+	"xcirc;\x00\u25ef\x00" +
+	"xcup;\x00\u22c3\x00" +
+	"xdtri;\x00\u25bd\x00" +
+	"xfr;\x00\U0001d535\x00" +
+	"xhArr;\x00\u27fa\x00" +
+	"xharr;\x00\u27f7\x00" +
+	"xi;\x00\u03be\x00" +
+	"xlArr;\x00\u27f8\x00" +
+	"xlarr;\x00\u27f5\x00" +
+	"xmap;\x00\u27fc\x00" +
+	"xnis;\x00\u22fb\x00" +
+	"xodot;\x00\u2a00\x00" +
+	"xopf;\x00\U0001d569\x00" +
+	"xoplus;\x00\u2a01\x00" +
+	"xotime;\x00\u2a02\x00" +
+	"xrArr;\x00\u27f9\x00" +
+	"xrarr;\x00\u27f6\x00" +
+	"xscr;\x00\U0001d4cd\x00" +
+	"xsqcup;\x00\u2a06\x00" +
+	"xuplus;\x00\u2a04\x00" +
+	"xutri;\x00\u25b3\x00" +
+	"xvee;\x00\u22c1\x00" +
+	"xwedge;\x00\u22c0\x00" +
+	"yacute\x00\u00fd\x00" +
+	"yacute;\x00\u00fd\x00" +
+	"yacy;\x00\u044f\x00" +
+	"ycirc;\x00\u0177\x00" +
+	"ycy;\x00\u044b\x00" +
+	"yen\x00\u00a5\x00" +
+	"yen;\x00\u00a5\x00" +
+	"yfr;\x00\U0001d536\x00" +
+	"yicy;\x00\u0457\x00" +
+	"yopf;\x00\U0001d56a\x00" +
+	"yscr;\x00\U0001d4ce\x00" +
+	"yucy;\x00\u044e\x00" +
+	"yuml\x00\u00ff\x00" +
+	"yuml;\x00\u00ff\x00" +
+	"zacute;\x00\u017a\x00" +
+	"zcaron;\x00\u017e\x00" +
+	"zcy;\x00\u0437\x00" +
+	"zdot;\x00\u017c\x00" +
+	"zeetrf;\x00\u2128\x00" +
+	"zeta;\x00\u03b6\x00" +
+	"zfr;\x00\U0001d537\x00" +
+	"zhcy;\x00\u0436\x00" +
+	"zigrarr;\x00\u21dd\x00" +
+	"zopf;\x00\U0001d56b\x00" +
+	"zscr;\x00\U0001d4cf\x00" +
+	"zwj;\x00\u200d\x00" +
+	"zwnj;\x00\u200c\x00"
+
+var entityTable = decodeEntityData(entityData)
+
+// Decode entityData into a table of entities sorted by name.
+func decodeEntityData(data string) []namedEntity {
+	entities := make([]namedEntity, 0, 2231)
+	for len(data) > 0 {
+		name, rest, _ := strings.Cut(data, "\x00")
+		exp, rest, _ := strings.Cut(rest, "\x00")
+		entities = append(entities, namedEntity{Name: name, Exp: exp})
+		data = rest
+	}
+	return entities
 }
@@ -0,0 +1,113 @@
+package gohtml
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+	"testing"
+)
+
+func TestEntityTableMatchesSpec(t *testing.T) {
+	data, err := os.ReadFile("entities.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec map[string]struct {
+		Characters string `json:"characters"`
+	}
+	if err := json.Unmarshal(data, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entityTable) != len(spec) {
+		t.Errorf("len(entityTable) = %d, want %d", len(entityTable), len(spec))
+	}
+	if !sort.SliceIsSorted(entityTable, func(i, j int) bool {
+		return entityTable[i].Name < entityTable[j].Name
+	}) {
+		t.Error("entityTable is not sorted by name")
+	}
+	for _, entity := range entityTable {
+		want, ok := spec["&"+entity.Name]
+		if !ok {
+			t.Errorf("entity %q is not in entities.json", entity.Name)
+		} else if entity.Exp != want.Characters {
+			t.Errorf("entity %q = %q, want %q", entity.Name, entity.Exp, want.Characters)
+		}
+	}
+}
+
+func TestLookupEntity(t *testing.T) {
+	tests := []struct {
+		in   string
+		exp  string
+		n    int
+		isOk bool
+	}{
+		{"AElig;", "Æ", 6, true},
+		{"AElig", "Æ", 5, true},
+		{"amp;x", "&", 4, true},
+		{"ampx", "&", 3, true},
+		{"notin;", "∉", 6, true},
+		{"notit;", "¬", 3, true},
+		{"NotEqualTilde;", "≂̸", 14, true},
+		{"zwnj;", "‌", 5, true},
+		{"bogus;", "", 0, false},
+		{"Amp;", "", 0, false},
+	}
+
+	for _, test := range tests {
+		exp, n, ok := lookupEntity([]byte(test.in))
+		if exp != test.exp || n != test.n || ok != test.isOk {
+			t.Errorf("lookupEntity(%q) = %q, %d, %v, want %q, %d, %v",
+				test.in, exp, n, ok, test.exp, test.n, test.isOk)
+		}
+	}
+}
+
+func TestParseEntities(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"&AElig;&amp;&lt", "Æ&<"},
+		{"&NotEqualTilde;", "≂̸"},
+		{"&notit;", "¬it;"},
+		{"&bogus;", "&bogus;"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := node.Text(); got != test.want {
+			t.Errorf("Parse(%q) text = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestLookupEveryEntity(t *testing.T) {
+	// every entity is found as itself, even followed by more name characters
+	for _, ent := range entityTable {
+		for _, data := range []string{ent.Name, ent.Name + "zz"} {
+			exp, n, ok := lookupEntity([]byte(data))
+			if !ok || n < len(ent.Name) || n == len(ent.Name) && exp != ent.Exp {
+				t.Errorf("lookupEntity(%q) = %q, %d, %v, want %q, %d", data, exp, n, ok, ent.Exp, len(ent.Name))
+			}
+		}
+	}
+}
+
+func TestLookupEntityAllocs(t *testing.T) {
+	data := [][]byte{[]byte("amp;"), []byte("notit;"), []byte("NotEqualTilde;"), []byte("copyx"), []byte("bogus;")}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, d := range data {
+			lookupEntity(d)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("lookupEntity: %v allocations, want 0", allocs)
+	}
+}
@@ -2,17 +2,58 @@ package gohtml
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Errors.  These may serve as warnings, as well.
 var (
-	EmptyInputErr    = errors.New("empty input")
-	EofErr           = errors.New("unexpected EOF")
-	EntityErr        = errors.New("invalid entity")
-	TokenErr         = errors.New("unexpected token")
-	UnclosedTagErr   = errors.New("unclosed tag")
-	EmptyContentErr  = errors.New("empty token content")
-	EmptyTagStackErr = errors.New("empty tag stack")
-	TagMismatchErr   = errors.New("mismatched tags")
-	AttrKeyErr       = errors.New("invalid attribute key")
+	EmptyInputErr      = errors.New("empty input")
+	EofErr             = errors.New("unexpected EOF")
+	EntityErr          = errors.New("invalid entity")
+	TokenErr           = errors.New("unexpected token")
+	UnclosedTagErr     = errors.New("unclosed tag")
+	EmptyContentErr    = errors.New("empty token content")
+	EmptyTagStackErr   = errors.New("empty tag stack")
+	TagMismatchErr     = errors.New("mismatched tags")
+	AttrKeyErr         = errors.New("invalid attribute key")
+	TagNameErr         = errors.New("invalid tag name")
+	ContentErr         = errors.New("invalid content")
+	EditErr            = errors.New("invalid edit")
+	MissingAttrErr     = errors.New("missing attribute")
+	UnquotedAttrErr    = errors.New("unquoted attribute value")
+	FosterParentErr    = errors.New("misplaced table content")
+	TxDoneErr          = errors.New("transaction already finished")
+	IndexErr           = errors.New("index out of range")
+	SelectorErr        = errors.New("invalid selector")
+	XPathErr           = errors.New("invalid XPath expression")
+	BogusCommentErr    = errors.New("bogus comment")
+	CloseTagContentErr = errors.New("extraneous closing tag content")
+	SelfCloseErr       = errors.New("self-closing non-void element")
+	CharsetErr         = errors.New("unsupported charset")
+	UTF8Err            = errors.New("invalid UTF-8")
+	NULErr             = errors.New("unexpected NUL character")
+	SizeLimitErr       = errors.New("size limit exceeded")
+	AttrLimitErr       = errors.New("too many attributes")
+	NodeLimitErr       = errors.New("node limit exceeded")
 )
+
+// Error at a location in a document, as returned by parsing, both as a
+// warning and as a fatal error.  Err is one of the errors above, possibly
+// wrapped with details; use errors.Is to check which.
+type ParseError struct {
+	Loc Location // Where the problem is
+	Err error    // What the problem is
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("%s: %s", err.Loc, err.Err)
+}
+
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Make a ParseError at loc, with Err formatted as by fmt.Errorf.
+func errorAt(loc Location, format string, args ...any) error {
+	return &ParseError{Loc: loc, Err: fmt.Errorf(format, args...)}
+}
@@ -0,0 +1,78 @@
+package gohtml
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseErrorWarnings(t *testing.T) {
+	src := "<p>\n  <div a=1 a=2>&bogus;</span>\n<table>x</table>"
+	_, err, warns := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(warns) == 0 {
+		t.Fatal("no warnings")
+	}
+
+	for _, warn := range warns {
+		var parseErr *ParseError
+		if !errors.As(warn, &parseErr) {
+			t.Errorf("warning %q is not a *ParseError", warn)
+			continue
+		}
+		if parseErr.Loc.Snippet([]byte(src)) == "" {
+			t.Errorf("warning %q has no snippet", warn)
+		}
+	}
+}
+
+func TestParseErrorUnwrap(t *testing.T) {
+	_, _, warns := Parse([]byte("<b></i>x"))
+	var parseErr *ParseError
+	for _, warn := range warns {
+		if errors.Is(warn, TagMismatchErr) && errors.As(warn, &parseErr) {
+			break
+		}
+		parseErr = nil
+	}
+	if parseErr == nil {
+		t.Fatalf("no TagMismatchErr *ParseError in %q", warns)
+	}
+
+	if got, want := parseErr.Loc, (Location{Line: 1, Col: 4, Pos: 3}); got != want {
+		t.Errorf("Loc = %+v, want %+v", got, want)
+	}
+	if got, want := parseErr.Error(), "1:4: "+parseErr.Err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestLocationSnippet(t *testing.T) {
+	tests := []struct {
+		data string
+		pos  int
+		want string
+	}{
+		{"<p>fish &chips;</p>", 8, "<p>fish &chips;</p>\n        ^\n"},
+		{"<p>\n<b>\n</p>", 0, "<p>\n^\n"},
+		{"<p>\n<b>\n</p>", 9, "</p>\n ^\n"},
+		{"<p>\n<b>\n</p>", 5, "<b>\n ^\n"},
+		{"\t<p>\tx", 5, "\t<p>\tx\n\t   \t^\n"},
+		{"é&x;", 2, "é&x;\n ^\n"},
+		{"日本&x;", 6, "日本&x;\n  ^\n"},
+		{"<p>\r\na&b\r\n", 6, "a&b\n ^\n"},
+		{"<p>\r\n", 3, "<p>\n   ^\n"},
+		{"<p>", 3, "<p>\n   ^\n"},
+		{"<p>\n", 4, "\n^\n"},
+		{"", 0, "\n^\n"},
+		{"<p>", 4, ""},
+		{"<p>", -1, ""},
+	}
+
+	for _, test := range tests {
+		loc := Location{Pos: test.pos}
+		if got := loc.Snippet([]byte(test.data)); got != test.want {
+			t.Errorf("Location{Pos: %d}.Snippet(%q) = %q, want %q", test.pos, test.data, got, test.want)
+		}
+	}
+}
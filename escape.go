@@ -0,0 +1,138 @@
+package gohtml
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Escape s for use as text: '&', '<', and '>' are replaced with character
+// references.
+func EscapeString(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// Escape s for use as a double-quoted attribute value: '&' and '"' are
+// replaced with character references.
+func EscapeAttrString(s string) string {
+	return attrEscaper.Replace(s)
+}
+
+// Expand the character references in s as in text, e.g. "&lt;" to "<".
+// Invalid references are left as is, except for legacy references without a
+// terminating semicolon (e.g. "&amp"), which are expanded as in browsers.
+func UnescapeString(s string) string {
+	s, _ = expandEntitysString(s, Location{}, false)
+	return s
+}
+
+// Expand the character references in s as in an attribute value.  Unlike in
+// text, legacy references without a terminating semicolon are left as is if
+// followed by a letter, digit, or '=', so that e.g. "?a=1&copy=2" is kept.
+func UnescapeAttrString(s string) string {
+	s, _ = expandEntitysString(s, Location{}, true)
+	return s
+}
+
+// Policy for escaping text and attribute values when rendering.
+type Escaping int
+
+const (
+	// Escape '&', '<', and '>' in text, and '&' and '"' in attribute values.
+	DefaultEscaping Escaping = iota
+	// Escape only '&' and '<' in text, and '&' and '"' in attribute values.
+	MinimalEscaping
+	// Escape as with DefaultEscaping, and also non-ASCII characters that have
+	// named character references, e.g. "&copy;" for '©'.
+	NamedEscaping
+	// Escape as with DefaultEscaping, and also all non-ASCII characters as
+	// numeric character references, e.g. "&#xa9;" for '©'.
+	NumericEscaping
+)
+
+// Error message-friendly string representation.
+func (esc Escaping) String() string {
+	switch esc {
+	case MinimalEscaping:
+		return "MinimalEscaping"
+	case NamedEscaping:
+		return "NamedEscaping"
+	case NumericEscaping:
+		return "NumericEscaping"
+	default:
+		return "DefaultEscaping"
+	}
+}
+
+var minimalTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;")
+
+// Escape s for use as text according to the policy.
+func (esc Escaping) escapeText(s string) string {
+	if esc == MinimalEscaping {
+		return minimalTextEscaper.Replace(s)
+	}
+	return esc.escapeNonASCII(textEscaper.Replace(s))
+}
+
+// Escape s for use as a double-quoted attribute value according to the
+// policy.
+func (esc Escaping) escapeAttr(s string) string {
+	return esc.escapeNonASCII(attrEscaper.Replace(s))
+}
+
+// Escape the non-ASCII characters in s according to the policy.
+func (esc Escaping) escapeNonASCII(s string) string {
+	if esc != NamedEscaping && esc != NumericEscaping {
+		return s
+	}
+
+	i := strings.IndexFunc(s, func(r rune) bool { return r >= utf8.RuneSelf })
+	if i < 0 {
+		return s
+	}
+
+	buf := strings.Builder{}
+	buf.Grow(len(s) + len(s)/2)
+	buf.WriteString(s[:i])
+	for _, r := range s[i:] {
+		if r < utf8.RuneSelf {
+			buf.WriteRune(r)
+		} else if name, ok := namedRefs()[r]; ok && esc == NamedEscaping {
+			buf.WriteString(name)
+		} else if esc == NumericEscaping {
+			fmt.Fprintf(&buf, "&#x%x;", r)
+		} else {
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// Preferred named character reference for each character that has one: the
+// shortest, favoring lowercase names (e.g. "&copy;" over "&COPY;").
+var namedRefs = sync.OnceValue(func() map[rune]string {
+	refs := make(map[rune]string)
+	for _, entity := range entityTable {
+		name, exp := "&"+entity.Name, entity.Exp
+		r, size := utf8.DecodeRuneInString(exp)
+		if size != len(exp) || !strings.HasSuffix(name, ";") {
+			continue
+		}
+
+		prev, ok := refs[r]
+		switch {
+		case !ok || len(name) < len(prev):
+		case len(name) > len(prev):
+			continue
+		case (name == strings.ToLower(name)) != (prev == strings.ToLower(prev)):
+			if name != strings.ToLower(name) {
+				continue
+			}
+		case name > prev:
+			continue
+		}
+		refs[r] = name
+	}
+	return refs
+})
@@ -0,0 +1,97 @@
+package gohtml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEscapeString(t *testing.T) {
+	tests := []struct {
+		in   string
+		text string
+		attr string
+	}{
+		{`a < b & c > "d" 'e'`, `a &lt; b &amp; c &gt; "d" 'e'`, `a < b &amp; c > &quot;d&quot; 'e'`},
+		{"plain ©", "plain ©", "plain ©"},
+		{"&amp;", "&amp;amp;", "&amp;amp;"},
+	}
+
+	for _, test := range tests {
+		if got := EscapeString(test.in); got != test.text {
+			t.Errorf("EscapeString(%q) = %q, want %q", test.in, got, test.text)
+		}
+		if got := EscapeAttrString(test.in); got != test.attr {
+			t.Errorf("EscapeAttrString(%q) = %q, want %q", test.in, got, test.attr)
+		}
+		if got := UnescapeString(EscapeString(test.in)); got != test.in {
+			t.Errorf("UnescapeString(EscapeString(%q)) = %q", test.in, got)
+		}
+		if got := UnescapeAttrString(EscapeAttrString(test.in)); got != test.in {
+			t.Errorf("UnescapeAttrString(EscapeAttrString(%q)) = %q", test.in, got)
+		}
+	}
+}
+
+func TestUnescapeString(t *testing.T) {
+	tests := []struct {
+		in   string
+		text string
+		attr string
+	}{
+		{"&lt;&gt;&amp;&quot;&#39;", `<>&"'`, `<>&"'`},
+		{"&#x41;&#66;&#X43;", "ABC", "ABC"},
+		{"&copy 2024", "© 2024", "© 2024"},
+		{"?a=1&copy=2", "?a=1©=2", "?a=1&copy=2"},
+		{"&ampx", "&x", "&ampx"},
+		{"&bogus; & &#;", "&bogus; & &#;", "&bogus; & &#;"},
+		{"&#0;&#x110000;", "��", "��"},
+		{"&#128;", "€", "€"},
+		{"&NotEqualTilde;", "≂̸", "≂̸"},
+	}
+
+	for _, test := range tests {
+		if got := UnescapeString(test.in); got != test.text {
+			t.Errorf("UnescapeString(%q) = %q, want %q", test.in, got, test.text)
+		}
+		if got := UnescapeAttrString(test.in); got != test.attr {
+			t.Errorf("UnescapeAttrString(%q) = %q, want %q", test.in, got, test.attr)
+		}
+	}
+}
+
+func TestEscapingPolicies(t *testing.T) {
+	node := &Node{Kind: ElementNode, Content: "p", Attrs: map[string]string{"title": `"© & é"`}, Children: []*Node{
+		{Kind: TextNode, Content: "a < b > c & © é 😀"},
+	}}
+	tests := []struct {
+		esc  Escaping
+		want string
+	}{
+		{DefaultEscaping, `<p title="&quot;© &amp; é&quot;">a &lt; b &gt; c &amp; © é 😀</p>`},
+		{MinimalEscaping, `<p title="&quot;© &amp; é&quot;">a &lt; b > c &amp; © é 😀</p>`},
+		{NamedEscaping, `<p title="&quot;&copy; &amp; &eacute;&quot;">a &lt; b &gt; c &amp; &copy; &eacute; 😀</p>`},
+		{NumericEscaping, `<p title="&quot;&#xa9; &amp; &#xe9;&quot;">a &lt; b &gt; c &amp; &#xa9; &#xe9; &#x1f600;</p>`},
+	}
+
+	for _, test := range tests {
+		buf := strings.Builder{}
+		r := Renderer{TextEscaping: test.esc, AttrEscaping: test.esc}
+		if err := r.Render(&buf, node); err != nil {
+			t.Errorf("Render with %v: %v", test.esc, err)
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("Render with %v = %q, want %q", test.esc, got, test.want)
+		}
+		reparsed, _, _ := Parse([]byte(buf.String()))
+		if got, want := reparsed.Text(), node.Text(); got != want {
+			t.Errorf("Render with %v reparses with text %q, want %q", test.esc, got, want)
+		}
+	}
+
+	// text and attribute policies are independent
+	buf := strings.Builder{}
+	(&Renderer{TextEscaping: NumericEscaping}).Render(&buf, node)
+	if want := `<p title="&quot;© &amp; é&quot;">a &lt; b &gt; c &amp; &#xa9; &#xe9; &#x1f600;</p>`; buf.String() != want {
+		t.Errorf("Render with numeric text escaping = %q, want %q", buf.String(), want)
+	}
+}
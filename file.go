@@ -0,0 +1,61 @@
+package gohtml
+
+import (
+	"fmt"
+)
+
+// Document parsed from a file by ParseFile.  The file is memory-mapped rather
+// than read, and the mapping is kept until Close.  Node contents are copied
+// from the mapping unless ParseOptions.FileViews is set.
+type File struct {
+	// Root of the document.
+	Node *Node
+
+	// contents of the file, mapped by mapFile
+	data []byte
+}
+
+// Parse the HTML file at path with options applied; see
+// ParseOptions.ParseFile.
+func ParseFile(path string, opts ...Option) (f *File, err error, warns []error) {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.ParseFile(path)
+}
+
+// Parse the HTML file at path according to the options.  Returns the same
+// values as Parse, with the document in a File, which must be closed once
+// done with it.  Locations name the file path unless opts.Filename is set.
+// The nodes of the document are allocated as with
+// ParseOptions.Arena, and no node may be used after Close; see
+// ParseOptions.FileViews for strings taken from them.
+func (opts ParseOptions) ParseFile(path string) (f *File, err error, warns []error) {
+	data, err := mapFile(path)
+	if err != nil {
+		err = fmt.Errorf("error reading document: %w", err)
+		return &File{Node: EmptyNode()}, err, warns
+	}
+
+	if opts.Filename == "" {
+		opts.Filename = path
+	}
+	opts.Arena = false
+	opts.arena = &arena{views: opts.FileViews}
+	node, err, warns := opts.Parse(data)
+	return &File{Node: node, data: data}, err, warns
+}
+
+// Free the document's nodes and unmap the file.  No node of the document may
+// be used afterwards; see ParseOptions.ParseFile.
+func (f *File) Close() error {
+	f.Node.Release()
+	f.Node = EmptyNode()
+	data := f.data
+	f.data = nil
+	if data == nil {
+		return nil
+	}
+	return unmapFile(data)
+}
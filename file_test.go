@@ -0,0 +1,54 @@
+package gohtml
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseFileCopies(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "doc.html")
+	if err := os.WriteFile(path, []byte("<p>hello</p><!--note-->"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err, _ := ParseFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := f.Node.Children[0].Children[0].Content
+	comment := f.Node.Children[1].Content
+
+	// neither modifying nor unmapping the file affects strings taken from
+	// the document
+	if err := os.WriteFile(path, []byte("<p>HELLO</p><!--NOTE-->"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if text != "hello" || comment != "note" {
+		t.Errorf("got %q and %q after Close, want %q and %q", text, comment, "hello", "note")
+	}
+	if len(f.Node.Children) != 0 {
+		t.Errorf("document not emptied by Close: %d children", len(f.Node.Children))
+	}
+}
+
+func TestParseFileViews(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "doc.html")
+	if err := os.WriteFile(path, []byte("<p>hello</p>"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err, _ := ParseFile(path, WithFileViews())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if got := string(f.Node.RenderBytes()); got != "<p>hello</p>" {
+		t.Errorf("got %q", got)
+	}
+}
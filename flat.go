@@ -0,0 +1,247 @@
+package gohtml
+
+// Compact, read-only representation of a tree, for workloads that only read
+// documents.  Rather than a graph of *Node, nodes are numbered in document
+// order from 0 (the root) and their fields are kept in parallel slices, with
+// parent, first child, and next sibling links as node numbers.  This takes
+// far less memory per node than a *Node tree and keeps nodes that are close
+// in the document close in memory.
+//
+// Only the fields needed to read a document are kept: raw attribute values,
+// attribute locations, and whether conditional comments are revealed are not.
+// Text with deferred entities (see ParseOptions.DeferEntities) is expanded.
+// Node numbers and byte offsets are limited to 32 bits.
+type FlatTree struct {
+	kinds       []NodeKind
+	contents    []string
+	namespaces  []Namespace
+	parents     []int32 // -1 for the root
+	firstChild  []int32 // -1 for nodes without children
+	nextSibling []int32 // -1 for last children
+	locs        []flatLoc
+	endLocs     []flatLoc
+
+	// attributes of node i are attrs[attrStart[i]:attrStart[i+1]], in order
+	attrs     []flatAttr
+	attrStart []int32
+
+	file       string
+	quirksMode QuirksMode
+	charset    string
+}
+
+type flatLoc struct {
+	Line, Col, Pos int32
+}
+
+type flatAttr struct {
+	Key, Val string
+}
+
+// Convert a tree rooted at node to a FlatTree.  The tree is left as is.
+func Flatten(node *Node) *FlatTree {
+	tree := &FlatTree{
+		file:       node.Loc.File,
+		quirksMode: node.QuirksMode,
+		charset:    node.Charset,
+		attrStart:  []int32{0},
+	}
+
+	type entry struct {
+		node   *Node
+		parent int32
+	}
+	// last child numbered so far of each node
+	var lastChild []int32
+
+	stk := make(stack[entry], 0, 16)
+	stk.Push(entry{node, -1})
+
+	for e, ok := stk.Pop(); ok; e, ok = stk.Pop() {
+		node, i := e.node, int32(len(tree.kinds))
+
+		content := node.Content
+		if node.Unexpanded {
+			content = UnescapeString(content)
+		}
+		tree.kinds = append(tree.kinds, node.Kind)
+		tree.contents = append(tree.contents, content)
+		tree.namespaces = append(tree.namespaces, node.Namespace)
+		tree.parents = append(tree.parents, e.parent)
+		tree.firstChild = append(tree.firstChild, -1)
+		tree.nextSibling = append(tree.nextSibling, -1)
+		tree.locs = append(tree.locs, newFlatLoc(node.Loc))
+		tree.endLocs = append(tree.endLocs, newFlatLoc(node.EndLoc))
+		for _, key := range node.AttrKeys() {
+			tree.attrs = append(tree.attrs, flatAttr{key, node.Attrs[key]})
+		}
+		tree.attrStart = append(tree.attrStart, int32(len(tree.attrs)))
+		lastChild = append(lastChild, -1)
+
+		if p := e.parent; p >= 0 {
+			if lastChild[p] < 0 {
+				tree.firstChild[p] = i
+			} else {
+				tree.nextSibling[lastChild[p]] = i
+			}
+			lastChild[p] = i
+		}
+
+		// reverse iteration so that first child is pushed last
+		for j := len(node.Children) - 1; j >= 0; j-- {
+			stk.Push(entry{node.Children[j], i})
+		}
+	}
+
+	return tree
+}
+
+func newFlatLoc(loc Location) flatLoc {
+	return flatLoc{Line: int32(loc.Line), Col: int32(loc.Col), Pos: int32(loc.Pos)}
+}
+
+// Parse HTML with options applied into a FlatTree.  Returns the same values
+// as Parse, with the tree flattened; see ParseOptions.ParseFlat.
+func ParseFlat(data []byte, opts ...Option) (tree *FlatTree, err error, warns []error) {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.ParseFlat(data)
+}
+
+// Parse HTML according to the options into a FlatTree.  The intermediate
+// *Node tree is allocated from an arena and released once flattened, so its
+// memory is reused by later parses.
+func (opts ParseOptions) ParseFlat(data []byte) (tree *FlatTree, err error, warns []error) {
+	opts.Arena = true
+	node, err, warns := opts.Parse(data)
+	tree = Flatten(node)
+	node.Release()
+	return tree, err, warns
+}
+
+// Number of nodes in the tree.
+func (tree *FlatTree) Len() int {
+	return len(tree.kinds)
+}
+
+// Kind of node i.
+func (tree *FlatTree) Kind(i int) NodeKind {
+	return tree.kinds[i]
+}
+
+// Content of node i; see Node.Content.
+func (tree *FlatTree) Content(i int) string {
+	return tree.contents[i]
+}
+
+// Namespace of node i; see Node.Namespace.
+func (tree *FlatTree) Namespace(i int) Namespace {
+	return tree.namespaces[i]
+}
+
+// Parent of node i, or -1 for the root.
+func (tree *FlatTree) Parent(i int) int {
+	return int(tree.parents[i])
+}
+
+// First child of node i, or -1 if it has no children.
+func (tree *FlatTree) FirstChild(i int) int {
+	return int(tree.firstChild[i])
+}
+
+// Next sibling of node i, or -1 if it is the last child of its parent.
+func (tree *FlatTree) NextSibling(i int) int {
+	return int(tree.nextSibling[i])
+}
+
+// Return the value of the attribute key of node i and whether it has it; see
+// Node.Attr.
+func (tree *FlatTree) Attr(i int, key string) (string, bool) {
+	key = attrKey(tree.namespaces[i], key)
+	for _, attr := range tree.attrs[tree.attrStart[i]:tree.attrStart[i+1]] {
+		if attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+// Return the keys of the attributes of node i in order; see Node.AttrKeys.
+func (tree *FlatTree) AttrKeys(i int) []string {
+	attrs := tree.attrs[tree.attrStart[i]:tree.attrStart[i+1]]
+	keys := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		keys = append(keys, attr.Key)
+	}
+	return keys
+}
+
+// Location in the original document where node i began; see Node.Loc.
+func (tree *FlatTree) Loc(i int) Location {
+	return tree.location(tree.locs[i])
+}
+
+// Location in the original document just past the end of node i; see
+// Node.EndLoc.
+func (tree *FlatTree) EndLoc(i int) Location {
+	return tree.location(tree.endLocs[i])
+}
+
+func (tree *FlatTree) location(loc flatLoc) Location {
+	return Location{Line: int(loc.Line), Col: int(loc.Col), Pos: int(loc.Pos), File: tree.file}
+}
+
+// Compatibility mode of the document; see Node.QuirksMode.
+func (tree *FlatTree) QuirksMode() QuirksMode {
+	return tree.quirksMode
+}
+
+// Character encoding label of the document; see Node.Charset.
+func (tree *FlatTree) Charset() string {
+	return tree.charset
+}
+
+// Convert the subtree rooted at node i back to a tree of *Node, e.g. to
+// render or modify it.  The root's Parent is nil.
+func (tree *FlatTree) Node(i int) *Node {
+	root := tree.node(i)
+
+	stk := make(stack[*Node], 0, 16)
+	index := make(stack[int], 0, 16)
+	stk.Push(root)
+	index.Push(i)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		i, _ := index.Pop()
+		for c := tree.FirstChild(i); c >= 0; c = tree.NextSibling(c) {
+			child := tree.node(c)
+			child.Parent = node
+			node.Children = append(node.Children, child)
+			stk.Push(child)
+			index.Push(c)
+		}
+	}
+
+	return root
+}
+
+// Convert node i to a *Node without children.
+func (tree *FlatTree) node(i int) *Node {
+	node := &Node{
+		Kind:      tree.kinds[i],
+		Content:   tree.contents[i],
+		Namespace: tree.namespaces[i],
+		Loc:       tree.Loc(i),
+		EndLoc:    tree.EndLoc(i),
+	}
+	if tree.parents[i] < 0 {
+		node.QuirksMode = tree.quirksMode
+		node.Charset = tree.charset
+	}
+	for _, attr := range tree.attrs[tree.attrStart[i]:tree.attrStart[i+1]] {
+		node.SetAttr(attr.Key, attr.Val)
+	}
+	return node
+}
@@ -0,0 +1,96 @@
+package gohtml
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	src := "<!DOCTYPE html><html><head><title>a &amp; b</title></head>" +
+		`<body class="x" id="main"><!--c--><p>one<b>two</b></p><svg viewBox="0 0 1 1"><circle r="1"/></svg></body></html>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q) error: %v", src, err)
+	}
+	tree := Flatten(doc)
+
+	// nodes are numbered in document order, so i follows the preorder walk
+	i := 0
+	var check func(node *Node, parent int)
+	check = func(node *Node, parent int) {
+		n := i
+		i++
+		if tree.Kind(n) != node.Kind || tree.Content(n) != node.Content || tree.Namespace(n) != node.Namespace {
+			t.Errorf("node %d = %v %q, want %v %q", n, tree.Kind(n), tree.Content(n), node.Kind, node.Content)
+		}
+		if tree.Parent(n) != parent {
+			t.Errorf("node %d parent = %d, want %d", n, tree.Parent(n), parent)
+		}
+		if got := tree.Loc(n); got.Pos != node.Loc.Pos || got.Line != node.Loc.Line || got.Col != node.Loc.Col {
+			t.Errorf("node %d location = %v, want %v", n, got, node.Loc)
+		}
+		if got := tree.EndLoc(n); got.Pos != node.EndLoc.Pos {
+			t.Errorf("node %d end location = %v, want %v", n, got, node.EndLoc)
+		}
+		if got, want := tree.AttrKeys(n), node.AttrKeys(); !slices.Equal(got, want) {
+			t.Errorf("node %d attributes = %q, want %q", n, got, want)
+		}
+		for _, key := range node.AttrKeys() {
+			if val, ok := tree.Attr(n, key); !ok || val != node.Attrs[key] {
+				t.Errorf("node %d attribute %q = %q, %v, want %q", n, key, val, ok, node.Attrs[key])
+			}
+		}
+
+		child := tree.FirstChild(n)
+		for _, c := range node.Children {
+			if child != i {
+				t.Fatalf("node %d child = %d, want %d", n, child, i)
+			}
+			check(c, n)
+			child = tree.NextSibling(child)
+		}
+		if child != -1 {
+			t.Errorf("node %d has extra child %d", n, child)
+		}
+	}
+	check(doc, -1)
+	if tree.Len() != i {
+		t.Errorf("Len() = %d, want %d", tree.Len(), i)
+	}
+
+	if _, ok := tree.Attr(0, "id"); ok {
+		t.Error("document has attribute id")
+	}
+	if tree.QuirksMode() != doc.QuirksMode || tree.Charset() != doc.Charset {
+		t.Errorf("QuirksMode(), Charset() = %v, %q, want %v, %q", tree.QuirksMode(), tree.Charset(), doc.QuirksMode, doc.Charset)
+	}
+	if got, want := string(tree.Node(0).RenderBytes()), string(doc.RenderBytes()); got != want {
+		t.Errorf("Node(0) renders %q, want %q", got, want)
+	}
+	checkParents(t, tree.Node(0))
+}
+
+func TestParseFlat(t *testing.T) {
+	src := `<div id="a"><p>x &lt; y</p></div>`
+	doc, _, _ := Parse([]byte(src))
+	want := string(doc.RenderBytes())
+
+	for _, opts := range [][]Option{nil, {WithDeferredEntities()}} {
+		tree, err, _ := ParseFlat([]byte(src), opts...)
+		if err != nil {
+			t.Fatalf("ParseFlat(%q) error: %v", src, err)
+		}
+		if got := string(tree.Node(0).RenderBytes()); got != want {
+			t.Errorf("ParseFlat(%q) renders %q, want %q", src, got, want)
+		}
+		// document, div, p, text
+		if got := tree.Content(3); got != "x < y" {
+			t.Errorf("ParseFlat(%q) text = %q, want %q", src, got, "x < y")
+		}
+	}
+
+	tree, _, _ := ParseFlat([]byte(src))
+	if got := tree.Node(1); got.Parent != nil || got.Content != "div" || len(got.Children) != 1 {
+		t.Errorf("ParseFlat(%q).Node(1) = %q with %d children, want div with 1", src, got.Content, len(got.Children))
+	}
+}
@@ -0,0 +1,254 @@
+package gohtml
+
+import (
+	"strings"
+)
+
+// Namespace of an element.  Elements within <svg> and <math> are foreign
+// elements, in the SVG and MathML namespaces respectively.
+type Namespace int
+
+const (
+	HTMLNamespace   Namespace = iota // HTML elements
+	SVGNamespace                     // Elements within <svg>
+	MathMLNamespace                  // Elements within <math>
+)
+
+// Error message-friendly string representation.
+func (ns Namespace) String() string {
+	switch ns {
+	case SVGNamespace:
+		return "SVGNamespace"
+	case MathMLNamespace:
+		return "MathMLNamespace"
+	default:
+		return "HTMLNamespace"
+	}
+}
+
+// Namespace URI, per the Infra standard.
+func (ns Namespace) URI() string {
+	switch ns {
+	case SVGNamespace:
+		return "http://www.w3.org/2000/svg"
+	case MathMLNamespace:
+		return "http://www.w3.org/1998/Math/MathML"
+	default:
+		return "http://www.w3.org/1999/xhtml"
+	}
+}
+
+// Foreign elements whose children are HTML elements, keyed by lowercase tag
+// name.
+var integrationPoints = map[Namespace]map[string]bool{
+	SVGNamespace: {
+		"foreignobject": true,
+		"desc":          true,
+		"title":         true,
+	},
+	MathMLNamespace: {
+		"mi":             true,
+		"mo":             true,
+		"mn":             true,
+		"ms":             true,
+		"mtext":          true,
+		"annotation-xml": true,
+	},
+}
+
+// Namespace of an element named tagName (lowercase) opened within an element
+// named parentName in the namespace parentNS.
+func childNamespace(parentNS Namespace, parentName string, tagName string) Namespace {
+	if parentNS != HTMLNamespace && !integrationPoints[parentNS][strings.ToLower(parentName)] {
+		return parentNS
+	}
+
+	switch tagName {
+	case "svg":
+		return SVGNamespace
+	case "math":
+		return MathMLNamespace
+	default:
+		return HTMLNamespace
+	}
+}
+
+// Case adjustments for SVG tag names, which are case sensitive.
+var svgTagNames = map[string]string{
+	"altglyph":            "altGlyph",
+	"altglyphdef":         "altGlyphDef",
+	"altglyphitem":        "altGlyphItem",
+	"animatecolor":        "animateColor",
+	"animatemotion":       "animateMotion",
+	"animatetransform":    "animateTransform",
+	"clippath":            "clipPath",
+	"feblend":             "feBlend",
+	"fecolormatrix":       "feColorMatrix",
+	"fecomponenttransfer": "feComponentTransfer",
+	"fecomposite":         "feComposite",
+	"feconvolvematrix":    "feConvolveMatrix",
+	"fediffuselighting":   "feDiffuseLighting",
+	"fedisplacementmap":   "feDisplacementMap",
+	"fedistantlight":      "feDistantLight",
+	"fedropshadow":        "feDropShadow",
+	"feflood":             "feFlood",
+	"fefunca":             "feFuncA",
+	"fefuncb":             "feFuncB",
+	"fefuncg":             "feFuncG",
+	"fefuncr":             "feFuncR",
+	"fegaussianblur":      "feGaussianBlur",
+	"feimage":             "feImage",
+	"femerge":             "feMerge",
+	"femergenode":         "feMergeNode",
+	"femorphology":        "feMorphology",
+	"feoffset":            "feOffset",
+	"fepointlight":        "fePointLight",
+	"fespecularlighting":  "feSpecularLighting",
+	"fespotlight":         "feSpotLight",
+	"fetile":              "feTile",
+	"feturbulence":        "feTurbulence",
+	"foreignobject":       "foreignObject",
+	"glyphref":            "glyphRef",
+	"lineargradient":      "linearGradient",
+	"radialgradient":      "radialGradient",
+	"textpath":            "textPath",
+}
+
+// Case adjustments for foreign attribute names, which are case sensitive.
+var foreignAttrNames = map[Namespace]map[string]string{
+	SVGNamespace: {
+		"attributename":       "attributeName",
+		"attributetype":       "attributeType",
+		"basefrequency":       "baseFrequency",
+		"baseprofile":         "baseProfile",
+		"calcmode":            "calcMode",
+		"clippathunits":       "clipPathUnits",
+		"diffuseconstant":     "diffuseConstant",
+		"edgemode":            "edgeMode",
+		"filterunits":         "filterUnits",
+		"glyphref":            "glyphRef",
+		"gradienttransform":   "gradientTransform",
+		"gradientunits":       "gradientUnits",
+		"kernelmatrix":        "kernelMatrix",
+		"kernelunitlength":    "kernelUnitLength",
+		"keypoints":           "keyPoints",
+		"keysplines":          "keySplines",
+		"keytimes":            "keyTimes",
+		"lengthadjust":        "lengthAdjust",
+		"limitingconeangle":   "limitingConeAngle",
+		"markerheight":        "markerHeight",
+		"markerunits":         "markerUnits",
+		"markerwidth":         "markerWidth",
+		"maskcontentunits":    "maskContentUnits",
+		"maskunits":           "maskUnits",
+		"numoctaves":          "numOctaves",
+		"pathlength":          "pathLength",
+		"patterncontentunits": "patternContentUnits",
+		"patterntransform":    "patternTransform",
+		"patternunits":        "patternUnits",
+		"pointsatx":           "pointsAtX",
+		"pointsaty":           "pointsAtY",
+		"pointsatz":           "pointsAtZ",
+		"preservealpha":       "preserveAlpha",
+		"preserveaspectratio": "preserveAspectRatio",
+		"primitiveunits":      "primitiveUnits",
+		"refx":                "refX",
+		"refy":                "refY",
+		"repeatcount":         "repeatCount",
+		"repeatdur":           "repeatDur",
+		"requiredextensions":  "requiredExtensions",
+		"requiredfeatures":    "requiredFeatures",
+		"specularconstant":    "specularConstant",
+		"specularexponent":    "specularExponent",
+		"spreadmethod":        "spreadMethod",
+		"startoffset":         "startOffset",
+		"stddeviation":        "stdDeviation",
+		"stitchtiles":         "stitchTiles",
+		"surfacescale":        "surfaceScale",
+		"systemlanguage":      "systemLanguage",
+		"tablevalues":         "tableValues",
+		"targetx":             "targetX",
+		"targety":             "targetY",
+		"textlength":          "textLength",
+		"viewbox":             "viewBox",
+		"viewtarget":          "viewTarget",
+		"xchannelselector":    "xChannelSelector",
+		"ychannelselector":    "yChannelSelector",
+		"zoomandpan":          "zoomAndPan",
+	},
+	MathMLNamespace: {
+		"definitionurl": "definitionURL",
+	},
+}
+
+// Set the namespace of a newly parsed element opened within parent, and
+// adjust the case of its tag and attribute names if it is foreign.
+func setNamespace(node *Node, parent *Node) {
+	for parent.Kind == ConditionalCommentNode && parent.Parent != nil {
+		parent = parent.Parent
+	}
+	node.Namespace = childNamespace(parent.Namespace, parent.Content, node.Content)
+	adjustForeignNames(node)
+}
+
+// Adjust the case of a foreign element's tag and attribute names.
+func adjustForeignNames(node *Node) {
+	if node.Namespace == HTMLNamespace {
+		return
+	}
+
+	if node.Namespace == SVGNamespace {
+		if name, ok := svgTagNames[node.Content]; ok {
+			node.Content = name
+		}
+	}
+
+	for i, key := range node.AttrOrder {
+		newKey := node.attrKey(key)
+		if newKey == key {
+			continue
+		} else if _, exists := node.Attrs[newKey]; exists {
+			continue
+		}
+
+		node.Attrs[newKey] = node.Attrs[key]
+		delete(node.Attrs, key)
+		if raw, ok := node.RawAttrs[key]; ok {
+			node.RawAttrs[newKey] = raw
+			delete(node.RawAttrs, key)
+		}
+		if loc, ok := node.AttrLocs[key]; ok {
+			node.AttrLocs[newKey] = loc
+			delete(node.AttrLocs, key)
+		}
+		node.AttrOrder[i] = newKey
+	}
+}
+
+// Key of the attribute key as stored in node.Attrs: for foreign elements,
+// adjusted to its proper case (e.g. "viewBox" for "viewbox").
+func (node *Node) attrKey(key string) string {
+	return attrKey(node.Namespace, key)
+}
+
+// Key of the attribute key of an element in the namespace ns; see
+// Node.attrKey.
+func attrKey(ns Namespace, key string) string {
+	if ns == HTMLNamespace {
+		return key
+	} else if name, ok := foreignAttrNames[ns][strings.ToLower(key)]; ok {
+		return name
+	}
+	return key
+}
+
+// Tag name of an element as written: for SVG elements, adjusted to its proper
+// case (e.g. "clipPath" for "clippath").
+func tagName(node *Node) string {
+	if node.Namespace == SVGNamespace {
+		if name, ok := svgTagNames[strings.ToLower(node.Content)]; ok {
+			return name
+		}
+	}
+	return node.Content
+}
@@ -0,0 +1,127 @@
+package gohtml
+
+import (
+	"testing"
+)
+
+func TestForeignContent(t *testing.T) {
+	src := `<svg viewbox="0 0 1 1"><lineargradient/><circle r=1 /><foreignobject><p>x<br/></p></foreignobject></svg>` +
+		`<math definitionurl=u><mi>y</mi><mrow/></math><p>z</p>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+
+	tests := []struct {
+		tag  string
+		ns   Namespace
+		name string
+	}{
+		{"svg", SVGNamespace, "svg"},
+		{"lineargradient", SVGNamespace, "linearGradient"},
+		{"circle", SVGNamespace, "circle"},
+		{"foreignobject", SVGNamespace, "foreignObject"},
+		{"br", HTMLNamespace, "br"},
+		{"math", MathMLNamespace, "math"},
+		{"mi", MathMLNamespace, "mi"},
+		{"mrow", MathMLNamespace, "mrow"},
+		{"p", HTMLNamespace, "p"},
+	}
+	for _, test := range tests {
+		node := doc.FindFunc(func(node *Node) bool {
+			return node.Kind == ElementNode && tagName(node) == test.name
+		})
+		if node.Kind != ElementNode || node.Namespace != test.ns {
+			t.Errorf("<%s> has namespace %v, want %v", test.name, node.Namespace, test.ns)
+		}
+	}
+
+	svg := doc.Find("svg")
+	if val, ok := svg.Attrs["viewBox"]; !ok || val != "0 0 1 1" {
+		t.Errorf("<svg> attributes = %v, want viewBox", svg.Attrs)
+	}
+	if _, ok := doc.Find("math").Attrs["definitionURL"]; !ok {
+		t.Errorf("<math> attributes = %v, want definitionURL", doc.Find("math").Attrs)
+	}
+	if n := len(svg.Children); n != 3 {
+		t.Errorf("<svg> has %d children, want 3 since self-closing foreign elements are closed", n)
+	}
+
+	want := `<svg viewBox="0 0 1 1"><linearGradient/><circle r=1/><foreignObject><p>x<br></p></foreignObject></svg>` +
+		`<math definitionURL=u><mi>y</mi><mrow/></math><p>z</p>`
+	if got := string(doc.RenderBytes()); got != want {
+		t.Errorf("RenderBytes = %q, want %q", got, want)
+	}
+}
+
+func TestForeignVerbatim(t *testing.T) {
+	tests := []struct {
+		in    string
+		bolds int
+	}{
+		// NOTE: foreign <title> and <style> elements aren't RCDATA or raw text
+		{"<svg><title>a<b>c</b></title></svg>", 1},
+		{"<svg><style>a<b>c</b></style></svg>", 1},
+		{"<svg><desc/><title>a<b>c</b></title></svg>", 1},
+		{"<math><mtext><textarea>a<b>c</b></textarea></mtext></math>", 0},
+		{"<svg><foreignObject><script>a<b>c</b></script></foreignObject></svg>", 0},
+		{"<svg><g><SVG></G></svg><title>a<b>c</b></title>", 0},
+	}
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.in, err)
+		}
+		bolds := 0
+		for node := range doc.Descendants() {
+			if node.Kind == ElementNode && node.Content == "b" {
+				bolds++
+			}
+		}
+		if bolds != test.bolds {
+			t.Errorf("Parse(%q) has %d <b> elements, want %d", test.in, bolds, test.bolds)
+		}
+	}
+}
+
+func TestHTMLSelfClosing(t *testing.T) {
+	src := "<div/>x<span/>y"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	if got, want := string(doc.RenderBytes()), "<div>x<span>y</span></div>"; got != want {
+		t.Errorf("Parse(%q) = %q, want %q", src, got, want)
+	}
+}
+
+func TestForeignAttrCase(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`<svg VIEWBOX="0 0 1 1" preserveaspectratio=none><g/></svg>`, `<svg viewBox="0 0 1 1" preserveAspectRatio=none><g/></svg>`},
+		{`<svg><path pathlength=1 stroke=red /></svg>`, `<svg><path pathLength=1 stroke=red/></svg>`},
+		{`<svg><lineargradient gradientunits=a gradienttransform=b spreadmethod=c /></svg>`, `<svg><linearGradient gradientUnits=a gradientTransform=b spreadMethod=c/></svg>`},
+		{`<math definitionurl=u><mi>x</mi></math>`, `<math definitionURL=u><mi>x</mi></math>`},
+		{`<div viewbox=1></div>`, `<div viewbox=1></div>`},
+		{`<svg><foreignObject><div viewbox=1></div></foreignObject></svg>`, `<svg><foreignObject><div viewbox=1></div></foreignObject></svg>`},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+
+	svg := &Node{Kind: ElementNode, Content: "svg", Namespace: SVGNamespace}
+	svg.SetAttr("viewbox", "0 0 2 2")
+	if val, ok := svg.Attr("VIEWBOX"); !ok || val != "0 0 2 2" || svg.AttrKeys()[0] != "viewBox" {
+		t.Errorf("SetAttr(%q) set %v, want viewBox", "viewbox", svg.Attrs)
+	}
+}
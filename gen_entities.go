@@ -0,0 +1,122 @@
+//go:build ignore
+
+// Generate entityMap.go from the WHATWG entities.json, available at
+// <https://html.spec.whatwg.org/entities.json>.
+//
+// Usage:
+//
+//	go run gen_entities.go [-in entities.json] [-url URL] [-out entityMap.go]
+//
+// If -url is given, the table is downloaded from URL instead of read from the
+// -in file.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type entity struct {
+	Codepoints []rune `json:"codepoints"`
+	Characters string `json:"characters"`
+}
+
+func readEntities(in, url string) ([]byte, error) {
+	if url == "" {
+		return os.ReadFile(in)
+	}
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func main() {
+	in := flag.String("in", "entities.json", "entities.json file to read")
+	url := flag.String("url", "", "URL to download entities.json from instead of reading -in")
+	out := flag.String("out", "entityMap.go", "Go file to write")
+	flag.Parse()
+
+	data, err := readEntities(*in, *url)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	entities := make(map[string]entity)
+	if err := json.Unmarshal(data, &entities); err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(entities))
+	for name, ent := range entities {
+		if !strings.HasPrefix(name, "&") || len(name) < 2 {
+			log.Fatalf("invalid entity name %q", name)
+		} else if string(ent.Codepoints) != ent.Characters {
+			log.Fatalf("entity %q: codepoints do not match characters", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bytes.Buffer{}
+	fmt.Fprintln(&buf, "// Code generated by gen_entities.go; DO NOT EDIT.")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "package gohtml")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "import \"strings\"")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Named entities, including the legacy ones without a terminating semicolon,")
+	fmt.Fprintln(&buf, "// encoded as consecutive records of the form \"name\\x00expansion\\x00\" with the")
+	fmt.Fprintln(&buf, "// leading '&' of each name omitted.")
+	fmt.Fprintln(&buf, "// for a complete list of valid HTML named entities, see:")
+	fmt.Fprintln(&buf, "// <https://html.spec.whatwg.org/multipage/named-characters.html#named-character-references>")
+	fmt.Fprintln(&buf, "const entityData = \"\" +")
+	for i, name := range names {
+		record := name[1:] + "\x00" + entities[name].Characters + "\x00"
+		sep := " +"
+		if i == len(names)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(&buf, "\t%s%s\n", strconv.QuoteToASCII(record), sep)
+	}
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "var entityTable = decodeEntityData(entityData)")
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "// Decode entityData into a table of entities sorted by name.")
+	fmt.Fprintln(&buf, "func decodeEntityData(data string) []namedEntity {")
+	fmt.Fprintf(&buf, "\tentities := make([]namedEntity, 0, %d)\n", len(names))
+	fmt.Fprintln(&buf, "\tfor len(data) > 0 {")
+	fmt.Fprintln(&buf, "\t\tname, rest, _ := strings.Cut(data, \"\\x00\")")
+	fmt.Fprintln(&buf, "\t\texp, rest, _ := strings.Cut(rest, \"\\x00\")")
+	fmt.Fprintln(&buf, "\t\tentities = append(entities, namedEntity{Name: name, Exp: exp})")
+	fmt.Fprintln(&buf, "\t\tdata = rest")
+	fmt.Fprintln(&buf, "\t}")
+	fmt.Fprintln(&buf, "\treturn entities")
+	fmt.Fprintln(&buf, "}")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
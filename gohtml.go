@@ -1,20 +1,706 @@
 // Package gohtml parses HTML data into a tree of nodes.
 package gohtml
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+//go:generate go run gen_entities.go
+
 // Parse HTML.  Returns the node representing the entire document, a fatal
 // parse error (if encountered), and a slice of warnings.  The returned node is
 // never nil, regardless of the value of err.
 func Parse(data []byte) (node *Node, err error, warns []error) {
-	tokens, err, warns := lex(data)
+	return ParseOptions{}.Parse(data)
+}
+
+// Parse HTML with options applied.  Returns the same values as Parse.
+func ParseWithOptions(data []byte, opts ...Option) (node *Node, err error, warns []error) {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.Parse(data)
+}
+
+// Parse HTML with options applied, stopping early with a fatal error wrapping
+// ctx.Err() if ctx is canceled or its deadline passes first, e.g. to bound
+// the time spent parsing untrusted input.  Returns the same values as Parse.
+func ParseContext(ctx context.Context, data []byte, opts ...Option) (node *Node, err error, warns []error) {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.ParseContext(ctx, data)
+}
+
+// Option for ParseWithOptions, which sets fields of ParseOptions.
+type Option func(*ParseOptions)
+
+// Drop nodes for which filter returns false; see ParseOptions.NodeFilter.
+func WithNodeFilter(filter func(kind NodeKind, tagName string) bool) Option {
+	return func(opts *ParseOptions) {
+		opts.NodeFilter = filter
+	}
+}
+
+// Implicitly close elements according to rules; see
+// ParseOptions.ImpliedEndTags.
+func WithImpliedEndTags(rules map[string][]string) Option {
+	return func(opts *ParseOptions) {
+		opts.ImpliedEndTags = rules
+	}
+}
+
+// Insert missing <html>, <head>, and <body> elements; see
+// ParseOptions.ImplyDocument.
+func WithImpliedDocument() Option {
+	return func(opts *ParseOptions) {
+		opts.ImplyDocument = true
+	}
+}
+
+// Leave character references in text unexpanded; see
+// ParseOptions.DeferEntities.
+func WithDeferredEntities() Option {
+	return func(opts *ParseOptions) {
+		opts.DeferEntities = true
+	}
+}
+
+// Normalize newlines in text and attribute values; see
+// ParseOptions.NormalizeNewlines.
+func WithNormalizedNewlines() Option {
+	return func(opts *ParseOptions) {
+		opts.NormalizeNewlines = true
+	}
+}
+
+// Treat the elements in tags as void elements; see ParseOptions.VoidTags.
+func WithVoidTags(tags map[string]bool) Option {
+	return func(opts *ParseOptions) {
+		opts.VoidTags = tags
+	}
+}
+
+// Treat the elements in tags as raw text elements; see
+// ParseOptions.RawTextTags.
+func WithRawTextTags(tags map[string]bool) Option {
+	return func(opts *ParseOptions) {
+		opts.RawTextTags = tags
+	}
+}
+
+// Handle self-closing tags on non-void elements according to mode; see
+// ParseOptions.SelfClosing.
+func WithSelfClosing(mode SelfClosingMode) Option {
+	return func(opts *ParseOptions) {
+		opts.SelfClosing = mode
+	}
+}
+
+// Decode input in the character encoding labeled charset; see
+// ParseOptions.Encoding.
+func WithEncoding(charset string) Option {
+	return func(opts *ParseOptions) {
+		opts.Encoding = charset
+	}
+}
+
+// Advance columns past tabs to the next multiple of n; see
+// ParseOptions.TabWidth.
+func WithTabWidth(n int) Option {
+	return func(opts *ParseOptions) {
+		opts.TabWidth = n
+	}
+}
+
+// Compute the lines and columns of locations in the tree on demand; see
+// ParseOptions.LazyLocations.
+func WithLazyLocations() Option {
+	return func(opts *ParseOptions) {
+		opts.LazyLocations = true
+	}
+}
+
+// Name locations after the file name; see ParseOptions.Filename.
+func WithFilename(name string) Option {
+	return func(opts *ParseOptions) {
+		opts.Filename = name
+	}
+}
+
+// Fail on the first problem found; see ParseOptions.Strict.
+func WithStrict() Option {
+	return func(opts *ParseOptions) {
+		opts.Strict = true
+	}
+}
+
+// Handle each class of problems according to policies; see
+// ParseOptions.ErrorPolicies.
+func WithErrorPolicies(policies map[error]ErrorPolicy) Option {
+	return func(opts *ParseOptions) {
+		opts.ErrorPolicies = policies
+	}
+}
+
+// Fail on input longer than n bytes; see ParseOptions.MaxInputSize.
+func WithMaxInputSize(n int) Option {
+	return func(opts *ParseOptions) {
+		opts.MaxInputSize = n
+	}
+}
+
+// Fail on tokens longer than n bytes; see ParseOptions.MaxTokenSize.
+func WithMaxTokenSize(n int) Option {
+	return func(opts *ParseOptions) {
+		opts.MaxTokenSize = n
+	}
+}
+
+// Keep at most n attributes per element; see ParseOptions.MaxAttrs.
+func WithMaxAttrs(n int) Option {
+	return func(opts *ParseOptions) {
+		opts.MaxAttrs = n
+	}
+}
+
+// Stop parsing once the tree has n nodes; see ParseOptions.MaxNodes.
+func WithMaxNodes(n int) Option {
+	return func(opts *ParseOptions) {
+		opts.MaxNodes = n
+	}
+}
+
+// Allocate nodes from slabs freed all at once; see ParseOptions.Arena.
+func WithArena() Option {
+	return func(opts *ParseOptions) {
+		opts.Arena = true
+	}
+}
+
+// Reference files parsed by ParseFile rather than copy them; see
+// ParseOptions.FileViews.
+func WithFileViews() Option {
+	return func(opts *ParseOptions) {
+		opts.FileViews = true
+	}
+}
+
+// Parse large documents with n goroutines; see ParseOptions.Workers.
+func WithWorkers(n int) Option {
+	return func(opts *ParseOptions) {
+		opts.Workers = n
+	}
+}
+
+// Options for customizing parsing behavior.  The zero value parses the same
+// way as Parse.
+type ParseOptions struct {
+	// Filter for nodes to keep in the tree.  If non-nil, it is called with
+	// the kind of each node (and tag name, for ElementNode) before the node is
+	// created; if it returns false, the node is dropped along with all of its
+	// descendants, which are never allocated.
+	NodeFilter func(kind NodeKind, tagName string) bool
+
+	// Rules for implicitly closing elements, mapping the name of an opening
+	// tag to the names of the elements it closes; e.g. with "li": {"li"},
+	// "<li>a<li>b" parses into two sibling <li> elements rather than nested
+	// ones.  Whenever the current open element is one that the opening tag
+	// closes, that element is closed before the new one is opened.  Elements
+	// that some rule closes are also closed by the closing tag of an element
+	// containing them, e.g. "<ul><li>a</ul>".  If nil, DefaultImpliedEndTags
+	// is used; set to an empty map to disable implicit closing.
+	ImpliedEndTags map[string][]string
+
+	// Whether to insert any missing <html>, <head>, and <body> elements, as
+	// browsers do, so that the tree always has the standard document
+	// structure; e.g. "<p>hi" parses into <html><head></head><body><p>hi.
+	// Head content such as <title> found before any other content is moved
+	// into <head>, and everything else into <body>.  Inserted elements have
+	// no location in the document; i.e. their Loc.Pos is -1.
+	ImplyDocument bool
+
+	// Whether to leave character references (e.g. &amp;) in text unexpanded,
+	// skipping the cost of expanding them.  Text nodes then hold their text
+	// as written, with Node.Unexpanded set, until expanded by
+	// Node.ExpandEntities.  Attribute values are expanded regardless.
+	DeferEntities bool
+
+	// Whether to normalize newlines ("\r\n" and lone "\r") to "\n" in text,
+	// comments, and attribute values, as browsers do before parsing.
+	// Carriage returns written as character references (e.g. &#13;) are
+	// kept.  Attribute values in Node.RawAttrs are left as written.
+	NormalizeNewlines bool
+
+	// Void elements, keyed by lowercase tag name, which have no closing tag
+	// or children (e.g. <br>), so that their opening tag is all there is to
+	// them.  If nil, DefaultVoidTags is used; to add or remove elements, copy
+	// it first (e.g. with maps.Clone).  Renderers follow Renderer.VoidTags.
+	VoidTags map[string]bool
+
+	// Raw text elements, keyed by lowercase tag name, whose contents are kept
+	// as text up to their closing tag without parsing any markup or
+	// character references within, e.g. <script> or a custom <x-code>.  If
+	// nil, DefaultRawTextTags is used; to add or remove elements, copy it
+	// first (e.g. with maps.Clone).  Renderers follow Renderer.RawTextTags.
+	RawTextTags map[string]bool
+
+	// How to handle self-closing syntax on non-void HTML elements, e.g.
+	// <div/>.  Self-closing foreign elements (e.g. <circle/>) and void
+	// elements (e.g. <br/>) are always closed.
+	SelfClosing SelfClosingMode
+
+	// Label of the character encoding of the input (e.g. "windows-1252"), per
+	// the WHATWG Encoding standard.  If empty, the encoding detected by
+	// DetectCharset is used, defaulting to UTF-8.  Input in any other
+	// encoding is transcoded to UTF-8 before parsing; node locations then
+	// still hold byte offsets into the original input, but their columns
+	// count bytes of the transcoded text.  Input in an unknown encoding is
+	// parsed as UTF-8, with a warning.  Invalid UTF-8 bytes in UTF-8 input are
+	// replaced with U+FFFD, with a warning, and locations are kept the same
+	// way.
+	Encoding string
+
+	// Tab width for the columns of locations in nodes and warnings (e.g. 4 or
+	// 8), so that they line up with an editor's: a tab advances the column to
+	// the next tab stop.  If less than 2, a tab counts as one column, like
+	// any other byte.
+	TabWidth int
+
+	// Whether to leave the lines and columns of the locations in the tree to
+	// be computed on demand, with Node.Locate, rather than computing them all
+	// once parsing is done; locations in the tree then only have their byte
+	// offsets (Pos) set, and a Line and Col of 0.  Warnings always have their
+	// lines and columns set.  Ignored for input transcoded from another
+	// encoding.
+	LazyLocations bool
+
+	// Name of the file the input was read from, if any (e.g. "index.html").
+	// It is set as the File of every location, so that errors and warnings
+	// are formatted like "index.html:12:7: ...".
+	Filename string
+
+	// Whether to treat any warning as a fatal error, so that parsing stops at
+	// the first problem found, e.g. for validating generated HTML rather than
+	// scraping pages as browsers would.  Problems found while lexing are
+	// found before those found while building the tree.
+	Strict bool
+
+	// How to handle each class of problems, keyed by the error the problems
+	// wrap (e.g. UnclosedTagErr, TagMismatchErr, EntityErr, or EofErr):
+	// whether to stop parsing, warn, or silently recover.  Problems of other
+	// classes are fatal if Strict is set, and warnings otherwise.
+	ErrorPolicies map[error]ErrorPolicy
+
+	// Maximum size in bytes of the input, as given; larger input fails with
+	// SizeLimitErr before any parsing.  If 0, there is no limit.
+	MaxInputSize int
+
+	// Maximum size in bytes of any single token, i.e. a tag (including its
+	// attributes), comment, run of text, etc.; a larger one fails with
+	// SizeLimitErr as soon as it is lexed.  If 0, there is no limit.
+	MaxTokenSize int
+
+	// Maximum number of attributes kept per element; any more are dropped,
+	// with an AttrLimitErr warning (which ErrorPolicies may make fatal).  If
+	// 0, there is no limit.
+	MaxAttrs int
+
+	// Maximum number of nodes in the tree, not counting the DocumentNode, to
+	// bound memory use.  Parsing stops at the first node over the limit,
+	// failing with NodeLimitErr but returning the tree parsed so far.  If 0,
+	// there is no limit.
+	MaxNodes int
+
+	// Whether to allocate the nodes of the document from large slabs rather
+	// than one by one, cutting allocations and garbage collection overhead
+	// for services parsing many documents.  The slabs are freed for reuse by
+	// calling Node.Release on the document once done with it, after which
+	// none of its nodes may be used.  Documents that aren't released are
+	// freed by the garbage collector as usual, once none of their nodes are
+	// referenced.
+	Arena bool
+
+	// Whether the contents of the text, comment, and similar nodes of
+	// documents parsed by ParseFile reference the memory-mapped file rather
+	// than copies of it, so that very large documents aren't held in memory
+	// twice.  No string taken from such a node may then be used after
+	// File.Close unless copied (e.g. with strings.Clone), nor while the file
+	// is modified by others.
+	FileViews bool
+
+	// Number of goroutines to parse large documents with.  If greater than 1,
+	// the direct children of <body> are split into chunks that are parsed
+	// concurrently and then stitched back into the tree.  Lexing remains
+	// sequential, and warnings may be reported out of document order.
+	// Ignored if NodeFilter, Strict, ErrorPolicies, or MaxNodes is set.
+	Workers int
+
+	// Context for canceling parsing; see ParseContext.
+	ctx context.Context
+
+	// Buffers to reuse, if parsing with a Parser.
+	scratch *scratch
+
+	// Allocator of nodes, if Arena is set.
+	arena *arena
+
+	// Elements that may be closed implicitly, computed from ImpliedEndTags
+	// when parsing starts, since they're looked up on every closing tag.
+	optionalEnds map[string]bool
+}
+
+// Handling of self-closing syntax on non-void HTML elements.
+type SelfClosingMode int
+
+const (
+	// Ignore the slash, as browsers do, so that <div/> opens an element like
+	// <div> does, with a warning.
+	HTML5SelfClosing SelfClosingMode = iota
+	// Close the element immediately, as in XML, so that <div/> is an empty
+	// element like <div></div>.
+	XMLSelfClosing
+)
+
+// Error message-friendly string representation.
+func (mode SelfClosingMode) String() string {
+	switch mode {
+	case XMLSelfClosing:
+		return "XMLSelfClosing"
+	default:
+		return "HTML5SelfClosing"
+	}
+}
+
+// Handling of a class of problems found while parsing.
+type ErrorPolicy int
+
+const (
+	// Recover from the problem, with a warning.
+	WarnPolicy ErrorPolicy = iota
+	// Recover from the problem without a warning.
+	IgnorePolicy
+	// Stop parsing, with the problem as a fatal error.
+	AbortPolicy
+)
+
+// Error message-friendly string representation.
+func (policy ErrorPolicy) String() string {
+	switch policy {
+	case IgnorePolicy:
+		return "IgnorePolicy"
+	case AbortPolicy:
+		return "AbortPolicy"
+	default:
+		return "WarnPolicy"
+	}
+}
+
+// Policy for handling a problem.
+func (opts *ParseOptions) errorPolicy(warn error) ErrorPolicy {
+	for class, policy := range opts.ErrorPolicies {
+		if errors.Is(warn, class) {
+			return policy
+		}
+	}
+	if opts.Strict {
+		return AbortPolicy
+	}
+	return WarnPolicy
+}
+
+// Apply error policies to warns[from:], dropping those to ignore.  Returns the
+// warnings kept, along with the first problem to abort on, if any, in which
+// case the warnings after it are dropped as well.
+func (opts *ParseOptions) applyErrorPolicies(warns []error, from int) (kept []error, err error) {
+	if opts.ErrorPolicies == nil && !opts.Strict {
+		return warns, nil
+	}
+
+	kept = warns[:from]
+	for _, warn := range warns[from:] {
+		switch opts.errorPolicy(warn) {
+		case AbortPolicy:
+			return kept, warn
+		case WarnPolicy:
+			kept = append(kept, warn)
+		}
+	}
+	return kept, nil
+}
+
+// Location of the start of the input.
+func (opts *ParseOptions) startLoc() Location {
+	return Location{Line: 1, Col: 1, Pos: 0, File: opts.Filename, tabWidth: opts.TabWidth}
+}
+
+// Whether a self-closing tag for node closes it immediately.
+func (opts *ParseOptions) selfCloses(node *Node) bool {
+	return node.Namespace != HTMLNamespace || opts.SelfClosing == XMLSelfClosing
+}
+
+// Parse HTML according to the options, stopping early if ctx is done; see
+// ParseContext.
+func (opts ParseOptions) ParseContext(ctx context.Context, data []byte) (node *Node, err error, warns []error) {
+	opts.ctx = ctx
+	return opts.Parse(data)
+}
+
+// Number of tokens lexed or parsed between checks for cancellation.
+const cancelCheckInterval = 1024
+
+// Error if parsing has been canceled, as of the location loc.
+func (opts *ParseOptions) canceled(loc Location) error {
+	if opts.ctx == nil {
+		return nil
+	} else if err := opts.ctx.Err(); err != nil {
+		return errorAt(loc, "error parsing document: %w", err)
+	}
+	return nil
+}
+
+// Parse HTML according to the options.  Returns the same values as Parse.
+func (opts ParseOptions) Parse(data []byte) (node *Node, err error, warns []error) {
+	if opts.MaxInputSize > 0 && len(data) > opts.MaxInputSize {
+		err = fmt.Errorf("error parsing document: %w: %d bytes of input, limit %d", SizeLimitErr, len(data), opts.MaxInputSize)
+		return EmptyNode(), err, warns
+	}
+	opts.optionalEnds = opts.optionalEndTags()
+
+	charset := opts.Encoding
+	if charset == "" {
+		charset = DetectCharset(data)
+	}
+
+	var offsets []int
+	if !isUTF8Charset(charset) {
+		decoded, decodedOffsets, decodeErr := decode(data, charset)
+		if decodeErr != nil {
+			// NOTE: parsed as UTF-8 instead
+			warn := errorAt(opts.startLoc(), "error decoding input: %w: %q", CharsetErr, charset)
+			warns = append(warns, warn)
+		} else {
+			data, offsets = decoded, decodedOffsets
+		}
+	}
+	if offsets == nil {
+		var utf8Warns []error
+		data, offsets, utf8Warns = replaceInvalidUTF8(data, opts.startLoc())
+		warns = append(warns, utf8Warns...)
+	}
+
+	if warns, err = opts.applyErrorPolicies(warns, 0); err != nil {
+		return EmptyNode(), err, warns
+	}
+
+	if opts.Arena {
+		opts.arena = &arena{}
+	}
+
+	node, err, parseWarns := opts.parse(data)
+	warns = append(warns, parseWarns...)
+	node.Charset = charset
+	node.arena = opts.arena
+	node.rawTextTags = opts.RawTextTags
+	if offsets != nil {
+		// NOTE: the line index is of the transcoded input, so lines and
+		// columns can't be computed on demand from remapped offsets
+		if node.lines != nil {
+			resolveLocations(node, node.lines)
+			node.lines = nil
+		}
+		remapLocations(node, offsets)
+	}
+	return node, err, warns
+}
+
+// Parse UTF-8 encoded HTML according to the options.
+func (opts *ParseOptions) parse(data []byte) (node *Node, err error, warns []error) {
+	// NOTE: locations are lexed as byte offsets only; see lineIndex
+	lines := newLineIndex(data, opts.contentStart(data))
+	defer func() {
+		lines.locateErrors(warns)
+		lines.locateErrors([]error{err})
+	}()
+
+	tokens, err, warns := lex(data, opts)
+	defer opts.releaseTokens(tokens)
 	if err != nil {
-		return node, err, warns
+		return EmptyNode(), err, warns
 	}
 
-	node, err, parseWarns := parse(tokens)
+	var parseWarns []error
+	if opts.Workers > 1 && opts.NodeFilter == nil && !opts.Strict && opts.ErrorPolicies == nil && opts.MaxNodes == 0 && len(tokens) >= minParallelTokens {
+		node, err, parseWarns = parseParallel(tokens, opts)
+	} else {
+		node, err, parseWarns = parse(tokens, opts)
+	}
 	warns = append(warns, parseWarns...)
+	if opts.LazyLocations {
+		node.lines = lines
+	} else {
+		resolveLocations(node, lines)
+	}
 	if err != nil {
 		return node, err, warns
 	}
 
+	if opts.ImplyDocument {
+		implyDocument(node)
+	}
+
+	node.QuirksMode = quirksMode(tokens)
 	return node, nil, warns
 }
+
+// Rules for implicitly closing elements whose end tags are optional per the
+// WHATWG HTML standard (e.g. <p>, <li>, and <td>), for
+// ParseOptions.ImpliedEndTags.
+var DefaultImpliedEndTags = map[string][]string{
+	"address":    {"p"},
+	"article":    {"p"},
+	"aside":      {"p"},
+	"blockquote": {"p"},
+	"details":    {"p"},
+	"dialog":     {"p"},
+	"div":        {"p"},
+	"dl":         {"p"},
+	"fieldset":   {"p"},
+	"figcaption": {"p"},
+	"figure":     {"p"},
+	"footer":     {"p"},
+	"form":       {"p"},
+	"h1":         {"p", "h1", "h2", "h3", "h4", "h5", "h6"},
+	"h2":         {"p", "h1", "h2", "h3", "h4", "h5", "h6"},
+	"h3":         {"p", "h1", "h2", "h3", "h4", "h5", "h6"},
+	"h4":         {"p", "h1", "h2", "h3", "h4", "h5", "h6"},
+	"h5":         {"p", "h1", "h2", "h3", "h4", "h5", "h6"},
+	"h6":         {"p", "h1", "h2", "h3", "h4", "h5", "h6"},
+	"header":     {"p"},
+	"hgroup":     {"p"},
+	"hr":         {"p"},
+	"main":       {"p"},
+	"menu":       {"p"},
+	"nav":        {"p"},
+	"ol":         {"p"},
+	"p":          {"p"},
+	"pre":        {"p"},
+	"search":     {"p"},
+	"section":    {"p"},
+	"table":      {"p"},
+	"ul":         {"p"},
+	"li":         {"p", "li"},
+	"dt":         {"p", "dt", "dd"},
+	"dd":         {"p", "dt", "dd"},
+	"option":     {"option"},
+	"optgroup":   {"option", "optgroup"},
+	"rb":         {"rb", "rt", "rp", "rtc"},
+	"rtc":        {"rb", "rt", "rp", "rtc"},
+	"rt":         {"rb", "rt", "rp"},
+	"rp":         {"rb", "rt", "rp"},
+	"caption":    {"caption"},
+	"thead":      {"caption", "thead", "tbody", "tfoot", "tr", "td", "th"},
+	"tbody":      {"caption", "thead", "tbody", "tfoot", "tr", "td", "th"},
+	"tfoot":      {"caption", "thead", "tbody", "tfoot", "tr", "td", "th"},
+	"tr":         {"caption", "tr", "td", "th"},
+	"td":         {"td", "th"},
+	"th":         {"td", "th"},
+	"body":       {"head"},
+}
+
+// Implied end tag rules in effect.
+func (opts *ParseOptions) impliedEndTags() map[string][]string {
+	if opts.ImpliedEndTags == nil {
+		return DefaultImpliedEndTags
+	}
+	return opts.ImpliedEndTags
+}
+
+// Void elements in effect.
+func (opts *ParseOptions) voidTags() map[string]bool {
+	if opts.VoidTags == nil {
+		return DefaultVoidTags
+	}
+	return opts.VoidTags
+}
+
+// Raw text elements in effect.
+func (opts *ParseOptions) rawTextTags() map[string]bool {
+	if opts.RawTextTags == nil {
+		return DefaultRawTextTags
+	}
+	return opts.RawTextTags
+}
+
+// Whether an element is a void element.  Foreign elements are never void.
+func (opts *ParseOptions) isVoid(node *Node) bool {
+	return node.Namespace == HTMLNamespace && opts.voidTags()[node.Content]
+}
+
+// Whether opening a tag named tagName implicitly closes the open element
+// named openName.
+func (opts *ParseOptions) impliedEnd(tagName string, openName string) bool {
+	for _, name := range opts.impliedEndTags()[tagName] {
+		if name == openName {
+			return true
+		}
+	}
+	return false
+}
+
+// Elements that may be closed implicitly under DefaultImpliedEndTags.
+var defaultOptionalEnds = optionalEnds(DefaultImpliedEndTags)
+
+// Set of the elements that may be closed implicitly under the implied end tag
+// rules, i.e. those implicitly closed by any tag.
+func optionalEnds(rules map[string][]string) map[string]bool {
+	ends := make(map[string]bool, len(rules))
+	for _, names := range rules {
+		for _, name := range names {
+			ends[name] = true
+		}
+	}
+	return ends
+}
+
+// Elements that may be closed implicitly under the implied end tag rules in
+// effect.  Computed once per parse; see ParseOptions.optionalEnds.
+func (opts *ParseOptions) optionalEndTags() map[string]bool {
+	if opts.optionalEnds != nil {
+		return opts.optionalEnds
+	} else if opts.ImpliedEndTags == nil {
+		return defaultOptionalEnds
+	}
+	return optionalEnds(opts.ImpliedEndTags)
+}
+
+// Whether an element named openName may be closed implicitly.
+func (opts *ParseOptions) optionalEnd(openName string) bool {
+	return opts.optionalEndTags()[openName]
+}
+
+// Number of the n topmost open elements that a closing tag named tagName
+// closes: the matching element, along with any elements open within it that
+// may be closed implicitly.  nameAt(i) is the name of the i-th open element
+// from the top.  Returns 0 if there is no such matching element.
+func (opts *ParseOptions) closeCount(tagName string, n int, nameAt func(i int) string) int {
+	for i := 0; i < n; i++ {
+		openName := nameAt(i)
+		if strings.EqualFold(openName, tagName) {
+			return i + 1
+		} else if !opts.optionalEnd(openName) {
+			return 0
+		}
+	}
+	return 0
+}
+
+// Whether to keep a node of the given kind and tag name.
+func (opts *ParseOptions) keepNode(kind NodeKind, tagName string) bool {
+	return opts.NodeFilter == nil || opts.NodeFilter(kind, tagName)
+}
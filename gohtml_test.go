@@ -0,0 +1,251 @@
+package gohtml
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStrictTrailingNewlines(t *testing.T) {
+	tests := []string{
+		"<p>a</p>\n",
+		"<!DOCTYPE html><html><head></head><body></body></html>\n",
+		"<!DOCTYPE html><html><head></head><body></body></html>\r\n\r\n",
+	}
+
+	for _, in := range tests {
+		_, err, warns := ParseWithOptions([]byte(in), WithStrict())
+		if err != nil {
+			t.Errorf("Parse(%q): %v", in, err)
+		}
+		if len(warns) > 0 {
+			t.Errorf("Parse(%q): unexpected warnings %v", in, warns)
+		}
+	}
+}
+
+func TestNodeFilter(t *testing.T) {
+	filter := func(kind NodeKind, tagName string) bool {
+		return kind != CommentNode && tagName != "script"
+	}
+	src := "<div><!--c--><p>a<script>x()</script>b</p><script><b>y</b></script></div>"
+	node, err, _ := ParseWithOptions([]byte(src), WithNodeFilter(filter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(node.RenderBytes()), "<div><p>ab</p></div>"; got != want {
+		t.Errorf("Parse(%q) = %q, want %q", src, got, want)
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	src := []byte("<p>a<br>b<x>c</p>")
+	want, _, _ := Parse(src)
+	got, err, _ := ParseWithOptions(src)
+	if err != nil || string(got.RenderBytes()) != string(want.RenderBytes()) {
+		t.Errorf("ParseWithOptions(%q) = %q, %v, want %q", src, got.RenderBytes(), err, want.RenderBytes())
+	}
+
+	// later options override earlier ones
+	voidTags := map[string]bool{"x": true}
+	got, _, _ = ParseWithOptions(src, WithVoidTags(nil), WithVoidTags(voidTags))
+	if len(got.Find("x").Children) != 0 || len(got.Find("br").Children) == 0 {
+		t.Errorf("ParseWithOptions(%q) = %q, want <x> void and <br> not", src, got.RenderBytes())
+	}
+
+	// options are equivalent to setting the fields
+	fromFields, _, _ := ParseOptions{VoidTags: voidTags}.Parse(src)
+	if string(fromFields.RenderBytes()) != string(got.RenderBytes()) {
+		t.Errorf("ParseOptions.Parse(%q) = %q, want %q", src, fromFields.RenderBytes(), got.RenderBytes())
+	}
+}
+
+func TestSelfClosingModes(t *testing.T) {
+	tests := []struct {
+		in   string
+		mode SelfClosingMode
+		want string
+		warn bool
+	}{
+		{"<div/>x", HTML5SelfClosing, "<div>x</div>", true},
+		{"<div/>x", XMLSelfClosing, "<div></div>x", false},
+		{"<br/>x", HTML5SelfClosing, "<br>x", false},
+		{"<br/>x", XMLSelfClosing, "<br>x", false},
+		{"<svg><g/>x</svg>", HTML5SelfClosing, "<svg><g/>x</svg>", false},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := ParseWithOptions([]byte(test.in), WithSelfClosing(test.mode))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) in %v = %q, want %q", test.in, test.mode, got, test.want)
+		}
+		if warned := slices.ContainsFunc(warns, isErr(SelfCloseErr)); warned != test.warn {
+			t.Errorf("Parse(%q) in %v: warnings %v, want %v warning: %v", test.in, test.mode, warns, SelfCloseErr, test.warn)
+		}
+	}
+}
+
+func TestRawTextTags(t *testing.T) {
+	rawTextTags := maps.Clone(DefaultRawTextTags)
+	rawTextTags["x-code"] = true
+	delete(rawTextTags, "style")
+
+	tests := []struct {
+		in   string
+		tag  string
+		text string
+		want string
+	}{
+		{"<x-code><b>{{ a && b }}</b> &amp;</x-code>", "x-code", "<b>{{ a && b }}</b> &amp;", "<x-code><b>{{ a && b }}</b> &amp;</x-code>"},
+		{"<script>a < b</script>", "script", "a < b", "<script>a < b</script>"},
+		{"<style><b>x</b></style>", "style", "", "<style><b>x</b></style>"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := ParseWithOptions([]byte(test.in), WithRawTextTags(rawTextTags))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := strings.Join(childTexts(doc.Find(test.tag)), ""); got != test.text {
+			t.Errorf("Parse(%q): <%s> text = %q, want %q", test.in, test.tag, got, test.text)
+		}
+		buf := strings.Builder{}
+		if err := (&Renderer{RawTextTags: rawTextTags}).Render(&buf, doc); err != nil || buf.String() != test.want {
+			t.Errorf("Render(%q) = %q, %v, want %q", test.in, buf.String(), err, test.want)
+		}
+	}
+
+	if DefaultRawTextTags["x-code"] || !DefaultRawTextTags["style"] {
+		t.Error("DefaultRawTextTags was modified")
+	}
+}
+
+func TestErrorPolicies(t *testing.T) {
+	src := []byte("<div><b>a</i></b>&#0;</div>")
+	tests := []struct {
+		policies map[error]ErrorPolicy
+		strict   bool
+		err      error
+		warns    []error
+	}{
+		{nil, false, nil, []error{TagMismatchErr, EntityErr}},
+		{map[error]ErrorPolicy{EntityErr: IgnorePolicy}, false, nil, []error{TagMismatchErr}},
+		{map[error]ErrorPolicy{TagMismatchErr: AbortPolicy}, false, TagMismatchErr, nil},
+		{map[error]ErrorPolicy{TagMismatchErr: IgnorePolicy, EntityErr: AbortPolicy}, false, EntityErr, nil},
+		{nil, true, TagMismatchErr, nil},
+		{map[error]ErrorPolicy{TagMismatchErr: WarnPolicy, EntityErr: WarnPolicy, UnclosedTagErr: WarnPolicy}, true, nil, []error{TagMismatchErr, EntityErr}},
+	}
+
+	for _, test := range tests {
+		opts := ParseOptions{ErrorPolicies: test.policies, Strict: test.strict}
+		doc, err, warns := opts.Parse(src)
+		if doc == nil {
+			t.Fatalf("%v (strict %v): nil node", test.policies, test.strict)
+		}
+		if !errors.Is(err, test.err) || (err == nil) != (test.err == nil) {
+			t.Errorf("%v (strict %v): err = %v, want %v", test.policies, test.strict, err, test.err)
+		}
+		if err != nil {
+			continue
+		}
+		got := make([]error, 0, len(warns))
+		for _, warn := range warns {
+			for _, class := range []error{TagMismatchErr, EntityErr} {
+				if errors.Is(warn, class) {
+					got = append(got, class)
+				}
+			}
+		}
+		if !slices.Equal(got, test.warns) {
+			t.Errorf("%v (strict %v): warnings %v, want %v", test.policies, test.strict, warns, test.warns)
+		}
+	}
+}
+
+func TestParseContext(t *testing.T) {
+	src := []byte(strings.Repeat("<p>x</p>", 2*cancelCheckInterval))
+
+	node, err, _ := ParseContext(context.Background(), src)
+	if err != nil {
+		t.Fatalf("ParseContext(Background) error: %v", err)
+	} else if got := len(node.Children); got != 2*cancelCheckInterval {
+		t.Errorf("ParseContext(Background) = %d children, want %d", got, 2*cancelCheckInterval)
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"canceled", canceled, context.Canceled},
+		{"expired", expired, context.DeadlineExceeded},
+	}
+
+	for _, test := range tests {
+		node, err, _ := ParseContext(test.ctx, src)
+		if !errors.Is(err, test.want) {
+			t.Errorf("ParseContext(%s) error = %v, want %v", test.name, err, test.want)
+		}
+		if node == nil {
+			t.Errorf("ParseContext(%s) returned nil node", test.name)
+		}
+
+		_, err, _ = ParseOptions{}.ParseContext(test.ctx, src)
+		if !errors.Is(err, test.want) {
+			t.Errorf("ParseOptions.ParseContext(%s) error = %v, want %v", test.name, err, test.want)
+		}
+	}
+}
+
+func TestMaxInputSize(t *testing.T) {
+	src := []byte("<p>abc</p>")
+
+	if _, err, _ := ParseWithOptions(src, WithMaxInputSize(len(src))); err != nil {
+		t.Errorf("Parse(%q) at limit %d error: %v", src, len(src), err)
+	}
+	node, err, _ := ParseWithOptions(src, WithMaxInputSize(len(src)-1))
+	if !errors.Is(err, SizeLimitErr) {
+		t.Errorf("Parse(%q) over limit %d error = %v, want %v", src, len(src)-1, err, SizeLimitErr)
+	}
+	if node == nil {
+		t.Errorf("Parse(%q) over limit returned nil node", src)
+	}
+}
+
+func TestMaxTokenSize(t *testing.T) {
+	const limit = 16
+	long := strings.Repeat("x", limit)
+
+	tests := []struct {
+		in      string
+		wantErr bool
+	}{
+		{"<p>short</p>", false},
+		{"<!--" + long + "-->", true},
+		{`<p title="` + long + `">`, true},
+		{"<p>" + long + long + "</p>", true},
+		{"<script>" + long + long + "</script>", true},
+		{"<script>" + long + "</script>", false},
+		{"<p>" + long[:limit-1] + "</p>", false},
+	}
+
+	for _, test := range tests {
+		_, err, _ := ParseWithOptions([]byte(test.in), WithMaxTokenSize(limit))
+		if got := errors.Is(err, SizeLimitErr); got != test.wantErr {
+			t.Errorf("Parse(%q) with limit %d error = %v, want SizeLimitErr: %t", test.in, limit, err, test.wantErr)
+		}
+	}
+}
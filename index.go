@@ -0,0 +1,129 @@
+package gohtml
+
+import (
+	"strings"
+)
+
+// Inverted indexes of the elements in a tree by tag name, id, and class, for
+// repeated lookups on long-lived documents.  Elements are listed in document
+// order.
+//
+// An Index is a snapshot: it does not reflect changes made to the tree after
+// it was built.
+type Index struct {
+	byName  map[string][]*Node
+	byID    map[string][]*Node
+	byClass map[string][]*Node
+}
+
+// Build an Index of all descendant ElementNodes of doc (including doc itself).
+func BuildIndex(doc *Node) *Index {
+	idx := &Index{
+		byName:  make(map[string][]*Node),
+		byID:    make(map[string][]*Node),
+		byClass: make(map[string][]*Node),
+	}
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(doc)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if node.Kind == ElementNode {
+			idx.byName[node.Content] = append(idx.byName[node.Content], node)
+
+			if id, ok := node.Attrs["id"]; ok {
+				idx.byID[id] = append(idx.byID[id], node)
+			}
+
+			for _, class := range strings.FieldsFunc(node.Attrs["class"], isSpaceR) {
+				idx.byClass[class] = append(idx.byClass[class], node)
+			}
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return idx
+}
+
+// Find the first indexed element with the tag name tagName.  Returns an
+// empty, non-nil *Node of InvalidNode kind if there is none.
+func (idx *Index) Find(tagName string) *Node {
+	if nodes := idx.byName[tagName]; len(nodes) > 0 {
+		return nodes[0]
+	}
+	return EmptyNode()
+}
+
+// Find all indexed elements with the tag name tagName.  Returns an empty,
+// non-nil slice of *Node if there are none.  The returned slice must not be
+// modified.
+func (idx *Index) FindAll(tagName string) []*Node {
+	if nodes := idx.byName[tagName]; nodes != nil {
+		return nodes
+	}
+	return make([]*Node, 0)
+}
+
+// Find the first indexed element that matches tag.  Returns an empty, non-nil
+// *Node of InvalidNode kind if there is none.
+func (idx *Index) FindTag(tag Tag) *Node {
+	for _, node := range idx.candidates(tag) {
+		if node.MatchTag(tag) {
+			return node
+		}
+	}
+	return EmptyNode()
+}
+
+// Find all indexed elements that match tag.  Returns an empty, non-nil slice
+// of *Node if there are none.
+func (idx *Index) FindTagAll(tag Tag) []*Node {
+	matches := make([]*Node, 0, 16)
+	for _, node := range idx.candidates(tag) {
+		if node.MatchTag(tag) {
+			matches = append(matches, node)
+		}
+	}
+	return matches
+}
+
+// Find the first indexed element with the given id.  Returns an empty,
+// non-nil *Node of InvalidNode kind if there is none.
+func (idx *Index) FindByID(id string) *Node {
+	if nodes := idx.byID[id]; len(nodes) > 0 {
+		return nodes[0]
+	}
+	return EmptyNode()
+}
+
+// Find all indexed elements that have class among their classes.  Returns an
+// empty, non-nil slice of *Node if there are none.  The returned slice must
+// not be modified.
+func (idx *Index) FindAllByClass(class string) []*Node {
+	if nodes := idx.byClass[class]; nodes != nil {
+		return nodes
+	}
+	return make([]*Node, 0)
+}
+
+// Smallest indexed list of elements that may match tag.
+func (idx *Index) candidates(tag Tag) []*Node {
+	nodes := idx.byName[tag.Name]
+
+	if id, ok := tag.Attrs["id"]; ok && len(idx.byID[id]) < len(nodes) {
+		nodes = idx.byID[id]
+	}
+
+	// class must match exactly, but any element with that exact class
+	// attribute will have been indexed under each of its classes
+	classes := strings.FieldsFunc(tag.Attrs["class"], isSpaceR)
+	if len(classes) > 0 && len(idx.byClass[classes[0]]) < len(nodes) {
+		nodes = idx.byClass[classes[0]]
+	}
+
+	return nodes
+}
@@ -0,0 +1,47 @@
+package gohtml
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIndex(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div id="main" class="box wide"><p class="box">a</p><p id="x">b<p class="wide">c</p></p></div><span class="box">d</span>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	idx := BuildIndex(doc)
+
+	if got, want := idx.FindAll("p"), doc.FindAll("p", false); !slices.Equal(got, want) {
+		t.Errorf("FindAll(p) = %d nodes, want %d", len(got), len(want))
+	}
+	if got, want := idx.Find("span"), doc.Find("span"); got != want {
+		t.Errorf("Find(span) = %v, want %v", got, want)
+	}
+	if got := idx.Find("table"); got.Kind != InvalidNode {
+		t.Errorf("Find(table) = %v, want an empty node", got.Kind)
+	}
+	if got := idx.FindAll("table"); got == nil || len(got) != 0 {
+		t.Errorf("FindAll(table) = %v, want an empty slice", got)
+	}
+	if got := idx.FindByID("x"); got.OwnText() != "b" {
+		t.Errorf("FindByID(x) = %q", got.OwnText())
+	}
+	if got := idx.FindAllByClass("box"); len(got) != 3 || got[1].Text() != "a" {
+		t.Errorf("FindAllByClass(box) = %d nodes, want 3 in document order", len(got))
+	}
+
+	for _, tag := range []Tag{
+		{Name: "p", Attrs: map[string]string{"class": "box"}},
+		{Name: "div", Attrs: map[string]string{"id": "main", "class": "box wide"}},
+		{Name: "div", Attrs: map[string]string{"class": "box"}},
+		{Name: "p"},
+	} {
+		if got, want := idx.FindTagAll(tag), doc.FindTagAll(tag, false); !slices.Equal(got, want) {
+			t.Errorf("FindTagAll(%v) = %d nodes, want %d", tag, len(got), len(want))
+		}
+		if got, want := idx.FindTag(tag), doc.FindTag(tag); got != want && (got.Kind != InvalidNode || want.Kind != InvalidNode) {
+			t.Errorf("FindTag(%v) = %v, want %v", tag, got, want)
+		}
+	}
+}
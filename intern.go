@@ -0,0 +1,63 @@
+package gohtml
+
+import (
+	"bytes"
+)
+
+// Standard HTML, SVG, and MathML tag names, lowercase, which are interned.
+var internedTagList = []string{
+	"a", "abbr", "address", "area", "article", "aside", "audio", "b", "base",
+	"bdi", "bdo", "blockquote", "body", "br", "button", "canvas", "caption",
+	"center", "cite", "code", "col", "colgroup", "data", "datalist", "dd",
+	"del", "details", "dfn", "dialog", "div", "dl", "dt", "em", "embed",
+	"fieldset", "figcaption", "figure", "font", "footer", "form", "frame",
+	"frameset", "h1", "h2", "h3", "h4", "h5", "h6", "head", "header",
+	"hgroup", "hr", "html", "i", "iframe", "img", "input", "ins", "kbd",
+	"label", "legend", "li", "link", "main", "map", "mark", "menu", "meta",
+	"meter", "nav", "noscript", "object", "ol", "optgroup", "option",
+	"output", "p", "param", "picture", "pre", "progress", "q", "rp", "rt",
+	"ruby", "s", "samp", "script", "search", "section", "select", "slot",
+	"small", "source", "span", "strong", "style", "sub", "summary", "sup",
+	"table", "tbody", "td", "template", "textarea", "tfoot", "th", "thead",
+	"time", "title", "tr", "track", "u", "ul", "var", "video", "wbr",
+	"svg", "circle", "defs", "ellipse", "g", "line", "path", "polygon",
+	"polyline", "rect", "stop", "symbol", "text", "tspan", "use",
+	"math", "mi", "mn", "mo", "mrow", "ms", "mtext",
+}
+
+// Interned tag names, keyed by themselves.
+var internedTagNames = func() map[string]string {
+	names := make(map[string]string, len(internedTagList))
+	for _, name := range internedTagList {
+		names[name] = name
+	}
+	return names
+}()
+
+// Canonical copy of a lowercase tag name.  Standard tag names (e.g. "div")
+// are interned: the parser gives every element with such a name the same
+// copy of it, so that nodes share its storage, and comparing one to another
+// copy returned by InternTagName is as cheap as comparing pointers.  Any other
+// name is returned as is.
+func InternTagName(name string) string {
+	if interned, ok := internedTagNames[name]; ok {
+		return interned
+	}
+	return name
+}
+
+// Tag name from tag data, lowercased and interned.  Only allocates for names
+// that aren't interned.
+func internTagName(name []byte) string {
+	for _, c := range name {
+		if 'A' <= c && c <= 'Z' {
+			name = bytes.ToLower(name)
+			break
+		}
+	}
+	// NOTE: the compiler doesn't allocate for string(name) as a map key
+	if interned, ok := internedTagNames[string(name)]; ok {
+		return interned
+	}
+	return string(name)
+}
@@ -0,0 +1,45 @@
+package gohtml
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestInternTagName(t *testing.T) {
+	for _, name := range []string{"div", "span", "svg", "math"} {
+		copied := strings.Clone(name)
+		if got := InternTagName(copied); got != name || unsafe.StringData(got) != unsafe.StringData(InternTagName(name)) {
+			t.Errorf("InternTagName(%q) isn't the interned copy", name)
+		}
+	}
+	if got := InternTagName("my-widget"); got != "my-widget" {
+		t.Errorf("InternTagName(%q) = %q", "my-widget", got)
+	}
+}
+
+func TestParseInternsTagNames(t *testing.T) {
+	src := "<div><DIV>a</DIV><Div>b</Div><my-widget></my-widget></div>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q) error: %v", src, err)
+	}
+
+	div := unsafe.StringData(InternTagName("div"))
+	outer := doc.Children[0]
+	for _, node := range append([]*Node{outer}, outer.Children[:2]...) {
+		if node.Content != "div" || unsafe.StringData(node.Content) != div {
+			t.Errorf("Parse(%q): tag name %q isn't interned", src, node.Content)
+		}
+	}
+	if got := outer.Children[2].Content; got != "my-widget" {
+		t.Errorf("Parse(%q): custom tag name = %q, want %q", src, got, "my-widget")
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = internTagName([]byte("div"))
+	})
+	if allocs != 0 {
+		t.Errorf("internTagName(%q): %v allocations, want 0", "div", allocs)
+	}
+}
@@ -2,6 +2,7 @@ package gohtml
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 )
 
@@ -16,6 +17,10 @@ const (
 	tagSelfcloseToken
 	commentToken
 	declarationToken
+	cdataToken
+	piToken
+	conditionalStartToken
+	conditionalStopToken
 	eofToken
 )
 
@@ -35,6 +40,14 @@ func (kind tokenKind) String() string {
 		return "commentToken"
 	case declarationToken:
 		return "declarationToken"
+	case cdataToken:
+		return "cdataToken"
+	case piToken:
+		return "piToken"
+	case conditionalStartToken:
+		return "conditionalStartToken"
+	case conditionalStopToken:
+		return "conditionalStopToken"
 	case eofToken:
 		return "eofToken"
 	default:
@@ -45,6 +58,7 @@ func (kind tokenKind) String() string {
 type token struct {
 	Kind tokenKind
 	Loc  Location
+	End  Location
 	Data []byte
 }
 
@@ -52,67 +66,252 @@ func (tok token) String() string {
 	return fmt.Sprintf("%s: %s %q", tok.Loc, tok.Kind, tok.Data)
 }
 
+// Step the line and column over the byte c, without moving the byte offset.
+func stepByte(loc Location, c byte) Location {
+	switch {
+	case c == '\n':
+		loc.Line++
+		loc.Col = 1
+	case c == '\t' && loc.tabWidth > 1:
+		loc.Col += loc.tabWidth - (loc.Col-1)%loc.tabWidth
+	case c != '\r':
+		loc.Col++
+	}
+	return loc
+}
+
+// Step forward to the first byte offset at which pred holds, or to the end of
+// data.  The line and column are only updated once the offset is found.
 func stepUntil(loc Location, data []byte, pred func([]byte) bool) Location {
-	for loc.Pos < len(data) && !pred(data[loc.Pos:]) {
-		if data[loc.Pos] == '\n' {
-			loc.Line++
-			loc.Col = 1
-		} else if data[loc.Pos] != '\r' {
-			loc.Col++
+	end := loc.Pos
+	for end < len(data) && !pred(data[end:]) {
+		end++
+	}
+
+	return stepTo(loc, data, end)
+}
+
+// Step forward to the next occurrence of the byte c, or to the end of data.
+// This is the lexer's inner loop, e.g. finding the next '<' or '>', so it
+// scans with bytes.IndexByte rather than a predicate per byte.
+func stepToByte(loc Location, data []byte, c byte) Location {
+	i := bytes.IndexByte(data[loc.Pos:], c)
+	if i < 0 {
+		return stepTo(loc, data, len(data))
+	}
+	return stepTo(loc, data, loc.Pos+i)
+}
+
+// Step forward to the next occurrence of any of the bytes in chars, or to the
+// end of data.
+func stepToAny(loc Location, data []byte, chars string) Location {
+	i := bytes.IndexAny(data[loc.Pos:], chars)
+	if i < 0 {
+		return stepTo(loc, data, len(data))
+	}
+	return stepTo(loc, data, loc.Pos+i)
+}
+
+// Step forward to the byte offset end, updating the line and column over the
+// whole skipped span at once rather than byte by byte.  If loc has no line
+// (i.e. its Line is 0), only the byte offset is updated, and the line and
+// column are left to be computed from it; see lineIndex.
+func stepTo(loc Location, data []byte, end int) Location {
+	if end <= loc.Pos {
+		return loc
+	} else if loc.Line == 0 {
+		loc.Pos = end
+		return loc
+	}
+
+	span := data[loc.Pos:end]
+	if len(span) < 16 {
+		// short spans are faster to step through byte by byte
+		for _, c := range span {
+			loc = stepByte(loc, c)
 		}
+		loc.Pos = end
+		return loc
+	}
 
-		loc.Pos++
+	if lines := bytes.Count(span, newline); lines > 0 {
+		loc.Line += lines
+		span = span[bytes.LastIndexByte(span, '\n')+1:]
+		loc.Col = 1
 	}
+	if loc.tabWidth > 1 && bytes.IndexByte(span, '\t') >= 0 {
+		// tab stops depend on the columns before each tab
+		for _, c := range span {
+			loc = stepByte(loc, c)
+		}
+	} else {
+		loc.Col += len(span) - bytes.Count(span, carriageReturn)
+	}
+	loc.Pos = end
 
 	return loc
 }
 
+// Step forward n bytes, or to the end of data.
+func stepN(loc Location, data []byte, n int) Location {
+	return stepTo(loc, data, min(loc.Pos+n, len(data)))
+}
+
+// Step forward to the next occurrence of prefix, or to the end of data.
 func stepUntilPrefix(loc Location, data []byte, prefix []byte) Location {
-	return stepUntil(loc, data, func(innerData []byte) bool {
-		return bytes.HasPrefix(innerData, prefix)
-	})
+	i := bytes.Index(data[loc.Pos:], prefix)
+	if i < 0 {
+		return stepTo(loc, data, len(data))
+	}
+	return stepTo(loc, data, loc.Pos+i)
+}
+
+// Memo of the last search for a delimiter ahead in a document, so that
+// lexing that looks ahead for a delimiter far off or missing, e.g. "?>" after
+// each of many "<?", scans each byte at most once rather than once per look.
+type search struct {
+	from int // offset the last search started at
+	at   int // offset of the delimiter found by the last search, or -1
+	done bool
+}
+
+// Offset of the first occurrence of sep in data at or after from, or -1 if
+// there is none.  A nil memo searches anew.
+func (s *search) index(data, sep []byte, from int) int {
+	if s != nil && s.done && from >= s.from && (s.at < 0 || s.at >= from) {
+		return s.at
+	}
+
+	at := bytes.Index(data[from:], sep)
+	if at >= 0 {
+		at += from
+	}
+	if s != nil {
+		*s = search{from: from, at: at, done: true}
+	}
+	return at
 }
 
 var (
+	newline          = []byte("\n")
+	carriageReturn   = []byte("\r")
 	commentStart     = []byte("<!--")
 	commentEnd       = []byte("-->")
 	declarationStart = []byte("<!")
+	doctypeStart     = []byte("<!doctype")
+	cdataStart       = []byte("<![CDATA[")
+	cdataEnd         = []byte("]]>")
+	piStart          = []byte("<?")
+	piEnd            = []byte("?>")
 	closeTagStart    = []byte("</")
+	closeTagEmpty    = []byte("</>")
 	tagStart         = []byte("<")
 	tagEnd           = []byte(">")
 	tagSelfcloseEnd  = []byte("/>")
 )
 
-func lexComment(data []byte, loc Location) (token, Location, error) {
+func lexComment(data []byte, loc Location) (tok token, newLoc Location, err error, warn error) {
+	tok = token{Kind: commentToken, Loc: loc}
+
+	loc = stepN(loc, data, len(commentStart))
+	newLoc = stepUntilPrefix(loc, data, commentEnd)
+	if newLoc.Pos >= len(data) {
+		// NOTE: unterminated comment, likely a truncated document; the
+		// comment runs to the end, as in browsers
+		warn = errorAt(loc, "error lexing comment: %w", EofErr)
+	}
+
+	tok.Data = data[loc.Pos:newLoc.Pos]
+	newLoc = stepN(newLoc, data, len(commentEnd))
+
+	return
+}
+
+func lexDeclaration(data []byte, loc Location) (token, Location, error) {
 	tok := token{Loc: loc}
 
-	loc.Pos += len(commentStart)
-	newLoc := stepUntilPrefix(loc, data, commentEnd)
+	loc = stepN(loc, data, len(declarationStart))
+	newLoc := stepToByte(loc, data, '>')
 	if newLoc.Pos >= len(data) {
-		err := fmt.Errorf("%s: error lexing comment: %w", loc, EofErr)
+		err := errorAt(loc, "error lexing declaration: %w", EofErr)
 		return tok, newLoc, err
 	}
-	tok.Kind = commentToken
+	tok.Kind = declarationToken
 
 	tok.Data = data[loc.Pos:newLoc.Pos]
-	newLoc.Pos += len(commentEnd)
+	newLoc = stepN(newLoc, data, len(tagEnd))
 
 	return tok, newLoc, nil
 }
 
-func lexDeclaration(data []byte, loc Location) (token, Location, error) {
+// Lex markup that browsers treat as a comment up to the next '>', e.g. "<!foo>"
+// or "</3>", starting with the prefix start.
+func lexBogusComment(data []byte, loc Location, start []byte) (tok token, newLoc Location, err error, warn error) {
+	tok = token{Kind: commentToken, Loc: loc}
+
+	loc = stepN(loc, data, len(start))
+	newLoc = stepToByte(loc, data, '>')
+	tok.Data = data[loc.Pos:newLoc.Pos]
+	newLoc = stepN(newLoc, data, len(tagEnd))
+
+	warn = errorAt(tok.Loc, "error lexing comment: %w: %q", BogusCommentErr, data[tok.Loc.Pos:newLoc.Pos])
+	return
+}
+
+// Whether data starts with a DOCTYPE declaration, ignoring case.
+func isDoctype(data []byte) bool {
+	return len(data) >= len(doctypeStart) && bytes.EqualFold(data[:len(doctypeStart)], doctypeStart)
+}
+
+// Whether data starts with a closing tag with a name, i.e. "</" followed by an
+// ASCII letter.
+func hasCloseTagName(data []byte) bool {
+	if len(data) <= len(closeTagStart) || !bytes.HasPrefix(data, closeTagStart) {
+		return false
+	}
+	c := data[len(closeTagStart)]
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func lexCDATA(data []byte, loc Location) (token, Location, error) {
 	tok := token{Loc: loc}
 
-	loc.Pos += len(declarationStart)
-	newLoc := stepUntilPrefix(loc, data, tagEnd)
+	loc = stepN(loc, data, len(cdataStart))
+	newLoc := stepUntilPrefix(loc, data, cdataEnd)
 	if newLoc.Pos >= len(data) {
-		err := fmt.Errorf("%s: error lexing declaration: %w", loc, EofErr)
+		err := errorAt(loc, "error lexing CDATA section: %w", EofErr)
 		return tok, newLoc, err
 	}
-	tok.Kind = declarationToken
+	tok.Kind = cdataToken
+
+	tok.Data = data[loc.Pos:newLoc.Pos]
+	newLoc = stepN(newLoc, data, len(cdataEnd))
+
+	return tok, newLoc, nil
+}
+
+func lexProcessingInstruction(data []byte, loc Location, piEnds *search) (token, Location, error) {
+	tok := token{Loc: loc}
+
+	loc = stepN(loc, data, len(piStart))
+	end := piEnd
+	var newLoc Location
+	if i := piEnds.index(data, piEnd, loc.Pos); i >= 0 {
+		newLoc = stepTo(loc, data, i)
+	} else {
+		// NOTE: HTML has no processing instructions, so browsers end them at
+		// the first '>' as bogus comments, e.g. <?foo>
+		end = tagEnd
+		newLoc = stepToByte(loc, data, '>')
+	}
+	if newLoc.Pos >= len(data) {
+		err := errorAt(loc, "error lexing processing instruction: %w", EofErr)
+		return tok, newLoc, err
+	}
+	tok.Kind = piToken
 
 	tok.Data = data[loc.Pos:newLoc.Pos]
-	newLoc.Pos += 1
+	newLoc = stepN(newLoc, data, len(end))
 
 	return tok, newLoc, nil
 }
@@ -120,16 +319,16 @@ func lexDeclaration(data []byte, loc Location) (token, Location, error) {
 func lexTagClose(data []byte, loc Location) (token, Location, error) {
 	tok := token{Loc: loc}
 
-	loc.Pos += len(closeTagStart)
-	newLoc := stepUntilPrefix(loc, data, tagEnd)
+	loc = stepN(loc, data, len(closeTagStart))
+	newLoc := stepToByte(loc, data, '>')
 	if newLoc.Pos >= len(data) {
-		err := fmt.Errorf("%s: error lexing closing tag: %w", loc, EofErr)
+		err := errorAt(loc, "error lexing closing tag: %w", EofErr)
 		return tok, newLoc, err
 	}
 	tok.Kind = tagCloseToken
 
 	tok.Data = data[loc.Pos:newLoc.Pos]
-	newLoc.Pos += 1
+	newLoc = stepN(newLoc, data, len(tagEnd))
 
 	return tok, newLoc, nil
 }
@@ -137,10 +336,10 @@ func lexTagClose(data []byte, loc Location) (token, Location, error) {
 func lexTagOpen(data []byte, loc Location) (token, Location, error) {
 	tok := token{Loc: loc}
 
-	loc.Pos += 1
-	newLoc := stepUntilPrefix(loc, data, tagEnd)
+	loc = stepN(loc, data, len(tagStart))
+	newLoc := stepToByte(loc, data, '>')
 	if newLoc.Pos >= len(data) {
-		err := fmt.Errorf("%s: error lexing opening tag: %w", loc, EofErr)
+		err := errorAt(loc, "error lexing opening tag: %w", EofErr)
 		return tok, newLoc, err
 	}
 
@@ -152,27 +351,25 @@ func lexTagOpen(data []byte, loc Location) (token, Location, error) {
 		tok.Data = data[loc.Pos:newLoc.Pos]
 	}
 
-	newLoc.Pos += 1
+	newLoc = stepN(newLoc, data, len(tagEnd))
 
 	return tok, newLoc, nil
 }
 
-func lexText(data []byte, loc Location) (tok token, newLoc Location, err error, warn error) {
+// Lex text up to the next '<'; afterHTML is whether the closing </html> tag has
+// been lexed.
+func lexText(data []byte, loc Location, afterHTML bool) (tok token, newLoc Location, err error, warn error) {
 	tok = token{Loc: loc}
-	newLoc = stepUntilPrefix(loc, data, tagStart)
+	newLoc = stepToByte(loc, data, '<')
 	if newLoc.Pos >= len(data) {
 		if len(bytes.TrimSpace(data[loc.Pos:newLoc.Pos])) == 0 {
-			// NOTE: trailing spaces after the closing </html> tag, likely
-			// trailing newlines; warn and ignore
-			warn = fmt.Errorf("%s: error lexing text: %w", loc, EofErr)
-			return
-		} else {
-			// data after closing </html>
-			// TODO: should this be a warning?
-			// it's recoverable by simply ignoring it
-			err = fmt.Errorf("%s: error lexing text: %w", loc, EofErr)
+			// NOTE: trailing spaces, likely trailing newlines; ignore
 			return
 		}
+		if afterHTML {
+			// NOTE: data after the closing </html> tag; warn and keep it
+			warn = errorAt(loc, "error lexing text: %w", EofErr)
+		}
 	}
 	tok.Kind = textToken
 	tok.Data = data[loc.Pos:newLoc.Pos]
@@ -182,23 +379,20 @@ func lexText(data []byte, loc Location) (tok token, newLoc Location, err error,
 func lexVerbatim(data []byte, loc Location, tagName string) (tok token, newLoc Location, err error, warn error) {
 	tok = token{Loc: loc}
 
-	closeTag := []byte("</" + tagName)
-	newLoc = stepUntil(loc, data, func(d []byte) bool {
-		return len(data) < len(closeTag) || bytes.HasPrefix(bytes.ToLower(d[:len(closeTag)]), closeTag)
-	})
+	if tagName == "script" {
+		newLoc = stepTo(loc, data, loc.Pos+scriptDataEnd(data[loc.Pos:]))
+	} else {
+		newLoc = stepTo(loc, data, loc.Pos+rawTextEnd(data[loc.Pos:], tagName))
+	}
 	if newLoc.Pos >= len(data) {
 		if len(bytes.TrimSpace(data[loc.Pos:newLoc.Pos])) == 0 {
-			// NOTE: trailing spaces after the closing </html> tag, likely
-			// trailing newlines; warn and ignore
-			warn = fmt.Errorf("%s: error lexing text: %w", loc, EofErr)
-			return
-		} else {
-			// data after closing </html>
-			// TODO: should this be a warning?
-			// it's recoverable by simply ignoring it
-			err = fmt.Errorf("%s: error lexing text: %w", loc, EofErr)
+			// NOTE: unterminated raw text of only spaces; warn and ignore
+			warn = errorAt(loc, "error lexing text: %w", EofErr)
 			return
 		}
+		// NOTE: unterminated raw text, e.g. a truncated <script>; warn and keep
+		// it
+		warn = errorAt(loc, "error lexing text: %w", EofErr)
 	}
 
 	tok.Data = data[loc.Pos:newLoc.Pos]
@@ -206,13 +400,90 @@ func lexVerbatim(data []byte, loc Location, tagName string) (tok token, newLoc L
 	return
 }
 
+// Offset of the closing tag named tagName that ends raw text data, or len(data)
+// if there is none.
+func rawTextEnd(data []byte, tagName string) int {
+	for i := 0; i < len(data); {
+		j := bytes.Index(data[i:], closeTagStart)
+		if j < 0 {
+			break
+		}
+		i += j
+		if isCloseTag(data[i:], tagName) {
+			return i
+		}
+		i += len(closeTagStart)
+	}
+	return len(data)
+}
+
+var (
+	scriptOpen  = []byte("<script")
+	scriptClose = []byte("</script")
+)
+
+// Offset of the closing tag that ends script data, or len(data) if there is
+// none, following the script data escape rules of the WHATWG HTML standard:
+// within "<!--" and "-->", a "<script>" tag starts a double escaped section in
+// which "</script>" does not end the script, but only the double escaped
+// section.
+func scriptDataEnd(data []byte) int {
+	const (
+		unescaped = iota
+		escaped
+		doubleEscaped
+	)
+	state := unescaped
+
+	for i := 0; i < len(data); {
+		j := bytes.IndexAny(data[i:], "<-")
+		if j < 0 {
+			break
+		}
+		i += j
+		rest := data[i:]
+
+		switch {
+		case state == unescaped && bytes.HasPrefix(rest, commentStart):
+			// NOTE: step over "<!" only, so that "<!-->" ends the escape
+			state = escaped
+			i += len(declarationStart)
+		case state != unescaped && bytes.HasPrefix(rest, commentEnd):
+			state = unescaped
+			i += len(commentEnd)
+		case state != doubleEscaped && isCloseTag(rest, "script"):
+			return i
+		case state == escaped && hasTagPrefix(rest, scriptOpen):
+			state = doubleEscaped
+			i += len(scriptOpen)
+		case state == doubleEscaped && hasTagPrefix(rest, scriptClose):
+			state = escaped
+			i += len(scriptClose)
+		default:
+			i++
+		}
+	}
+
+	return len(data)
+}
+
+// Whether data starts with prefix, ignoring case, followed by the end of a tag
+// name (whitespace, '/', or '>') or the end of data.
+func hasTagPrefix(data []byte, prefix []byte) bool {
+	n := len(prefix)
+	if len(data) < n || !bytes.EqualFold(data[:n], prefix) {
+		return false
+	}
+	return len(data) == n || isSpace(data[n]) || data[n] == '/' || data[n] == '>'
+}
+
 // rune version of isSpace
 func isSpaceR(r rune) bool {
 	return r == '\t' || r == '\n' || r == '\f' || r == '\r' || r == ' '
 }
 
 func extractTagName(tok token) string {
-	if tok.Kind != tagOpenToken {
+	if tok.Kind != tagOpenToken && tok.Kind != tagSelfcloseToken {
 		return ""
 	}
 
@@ -225,67 +496,230 @@ func extractTagName(tok token) string {
 	return string(bytes.ToLower(data))
 }
 
-var verbatimTags = map[string]bool{
+// Split the data of a closing tag into the tag name and any extraneous content
+// after it, e.g. "div" and `class="x"` for `</div class="x">`.
+func splitCloseTag(data []byte) (name []byte, rest []byte) {
+	data = bytes.TrimSpace(data)
+	i := bytes.IndexFunc(data, func(r rune) bool {
+		return isSpaceR(r) || r == '/'
+	})
+	if i < 0 {
+		return data, nil
+	}
+	return data[:i], bytes.TrimSpace(data[i:])
+}
+
+// Raw text elements, whose contents are not parsed (e.g. <script>), for
+// ParseOptions.RawTextTags.
+var DefaultRawTextTags = map[string]bool{
 	"script": true,
 	"style":  true,
-	"pre":    true,
 }
 
-func inVerbatim(tokens []token) bool {
-	if len(tokens) == 0 {
-		return false
+// Elements containing RCDATA: text in which tags are not parsed, but
+// character references are still expanded.
+var rcdataTags = map[string]bool{
+	"title":    true,
+	"textarea": true,
+}
+
+// Name of the raw text (one of rawTextTags) or RCDATA element whose contents
+// are lexed after tok, or "" if tok isn't the opening tag of one.  Only HTML
+// elements are raw text or RCDATA elements, e.g. not <svg>'s <title>, so ns is
+// the namespace of the element tok opens.
+func inVerbatim(tok token, ns Namespace, rawTextTags map[string]bool) string {
+	if tok.Kind != tagOpenToken || ns != HTMLNamespace {
+		return ""
+	} else if name := extractTagName(tok); rawTextTags[name] || rcdataTags[name] {
+		return name
 	}
-	tok := tokens[len(tokens)-1]
-	return tok.Kind == tagOpenToken && verbatimTags[extractTagName(tok)]
+	return ""
 }
 
-func lex(data []byte) (tokens []token, err error, warns []error) {
+// Whether data starts with a closing tag named tagName, ignoring case.
+func isCloseTag(data []byte, tagName string) bool {
+	return bytes.HasPrefix(data, closeTagStart) && hasTagPrefix(data[len(closeTagStart):], []byte(tagName))
+}
+
+// Location of the start of the content of data, past any byte order mark.
+func (opts *ParseOptions) contentStart(data []byte) Location {
+	loc := opts.startLoc()
+	if bytes.HasPrefix(data, utf8BOM) {
+		// NOTE: the byte order mark isn't part of the document's content
+		loc.Pos = len(utf8BOM)
+	}
+	return loc
+}
+
+func lex(data []byte, opts *ParseOptions) (tokens []token, err error, warns []error) {
 	if len(data) == 0 {
 		err = EmptyInputErr
 		return
 	}
 
-	tokens = make([]token, 0, len(data)/5)
-	loc := Location{Line: 1, Col: 1, Pos: 0}
+	tokens = opts.tokenBuf(len(data) / 5)
+	loc := opts.contentStart(data)
+	// NOTE: only byte offsets are tracked from here on; see lineIndex
+	loc.Line, loc.Col = 0, 0
+	lx := lexer{opts: opts}
 
 	for loc.Pos < len(data) {
 		var tok token
 		var warn error
 
-		switch data[loc.Pos] {
-		case '<':
-			// TODO: warning if finding a '<' in a tag token
-			// should be invalid HTML, but it's recoverable by treating the '<'
-			// as text
-			if bytes.HasPrefix(data[loc.Pos:], commentStart) {
-				tok, loc, err = lexComment(data, loc)
-			} else if bytes.HasPrefix(data[loc.Pos:], declarationStart) {
-				tok, loc, err = lexDeclaration(data, loc)
-			} else if bytes.HasPrefix(data[loc.Pos:], closeTagStart) {
-				tok, loc, err = lexTagClose(data, loc)
-			} else {
-				tok, loc, err = lexTagOpen(data, loc)
+		if len(tokens)%cancelCheckInterval == 0 {
+			if err = opts.canceled(loc); err != nil {
+				return
 			}
-		default:
-			if inVerbatim(tokens) {
-				tagName := extractTagName(tokens[len(tokens)-1])
-				tok, loc, err, warn = lexVerbatim(data, loc, tagName)
-			} else {
-				tok, loc, err, warn = lexText(data, loc)
-				if warn != nil {
-					warns = append(warns, warn)
-				}
+		}
+
+		tok, loc, err, warn = lx.next(data, loc)
+		if warn != nil {
+			switch opts.errorPolicy(warn) {
+			case AbortPolicy:
+				err = warn
+			case WarnPolicy:
+				warns = append(warns, warn)
 			}
 		}
 
 		if err != nil {
 			return
+		} else if err = lx.checkSize(tok, loc); err != nil {
+			return
 		} else if tok.Kind != invalidToken {
 			tokens = append(tokens, tok)
 		}
 	}
 
-	eofToken := token{Kind: eofToken, Loc: loc}
+	eofToken := token{Kind: eofToken, Loc: loc, End: loc}
 	tokens = append(tokens, eofToken)
 	return
 }
+
+// State of lexing between tokens; see lex.
+type lexer struct {
+	opts *ParseOptions
+
+	// name of the raw text or RCDATA element whose contents are lexed next,
+	// if any; see inVerbatim
+	verbatim string
+
+	// open foreign elements, innermost last; see lexer.namespace
+	foreign stack[foreignTag]
+
+	// whether within a conditional comment, which can't be nested
+	inConditional bool
+
+	// whether the closing </html> tag has been lexed
+	afterHTML bool
+
+	// searches ahead for the ends of conditional comment openings,
+	// conditional comments, and processing instructions
+	ifEnds, endifs, piEnds search
+}
+
+// Foreign element opened by a tag token; see lexer.namespace.
+type foreignTag struct {
+	ns   Namespace
+	name string
+}
+
+// Namespace of the element opened by tok, if any, tracking the foreign
+// elements that tok opens or closes.  The lexer can't look at the tree being
+// built, so this follows childNamespace with only the open foreign elements,
+// which is enough to tell foreign content from HTML.
+func (lx *lexer) namespace(tok token) Namespace {
+	switch tok.Kind {
+	case tagOpenToken, tagSelfcloseToken:
+		name := extractTagName(tok)
+		parent, _ := lx.foreign.Peek()
+		ns := childNamespace(parent.ns, parent.name, name)
+		if ns != HTMLNamespace && tok.Kind == tagOpenToken {
+			lx.foreign.Push(foreignTag{ns: ns, name: name})
+		}
+		return ns
+	case tagCloseToken:
+		name, _ := splitCloseTag(tok.Data)
+		for i := len(lx.foreign) - 1; i >= 0; i-- {
+			if bytes.EqualFold(name, []byte(lx.foreign[i].name)) {
+				lx.foreign = lx.foreign[:i]
+				break
+			}
+		}
+	}
+	return HTMLNamespace
+}
+
+// Lex the token of data at loc, returning it with tok.End set to the location
+// past it.  Returns a token of invalidToken kind for markup that is skipped
+// rather than lexed, e.g. "</>".
+func (lx *lexer) next(data []byte, loc Location) (tok token, newLoc Location, err error, warn error) {
+	if lx.verbatim != "" && !isCloseTag(data[loc.Pos:], lx.verbatim) {
+		// contents of raw text and RCDATA elements, up to their closing tag
+		tok, loc, err, warn = lexVerbatim(data, loc, lx.verbatim)
+		if rcdataTags[lx.verbatim] && tok.Kind == verbatimToken {
+			tok.Kind = textToken
+		}
+	} else if data[loc.Pos] == '<' {
+		start := loc
+		// TODO: warning if finding a '<' in a tag token
+		// should be invalid HTML, but it's recoverable by treating the '<'
+		// as text
+		if n := conditionalStop(data[loc.Pos:]); lx.inConditional && n > 0 {
+			tok, loc = lexConditionalStop(data, loc, n)
+			lx.inConditional = false
+		} else if n := conditionalStart(data, loc.Pos, &lx.ifEnds, &lx.endifs); !lx.inConditional && n > 0 {
+			tok, loc = lexConditionalStart(data, loc, n)
+			lx.inConditional = true
+		} else if bytes.HasPrefix(data[loc.Pos:], commentStart) {
+			tok, loc, err, warn = lexComment(data, loc)
+		} else if bytes.HasPrefix(data[loc.Pos:], cdataStart) {
+			tok, loc, err = lexCDATA(data, loc)
+		} else if isDoctype(data[loc.Pos:]) {
+			tok, loc, err = lexDeclaration(data, loc)
+		} else if bytes.HasPrefix(data[loc.Pos:], declarationStart) {
+			tok, loc, err, warn = lexBogusComment(data, loc, declarationStart)
+		} else if bytes.HasPrefix(data[loc.Pos:], piStart) {
+			tok, loc, err = lexProcessingInstruction(data, loc, &lx.piEnds)
+		} else if hasCloseTagName(data[loc.Pos:]) {
+			tok, loc, err = lexTagClose(data, loc)
+		} else if bytes.HasPrefix(data[loc.Pos:], closeTagEmpty) {
+			// NOTE: browsers ignore "</>" entirely
+			warn = errorAt(loc, "error lexing closing tag: %w", EmptyContentErr)
+			loc = stepN(loc, data, len(closeTagEmpty))
+		} else if bytes.HasPrefix(data[loc.Pos:], closeTagStart) {
+			tok, loc, err, warn = lexBogusComment(data, loc, closeTagStart)
+		} else {
+			tok, loc, err = lexTagOpen(data, loc)
+		}
+
+		if errors.Is(err, EofErr) {
+			// NOTE: unterminated markup, likely a truncated document; keep
+			// the rest of the document as text
+			warn, err = err, nil
+			tok = token{Kind: textToken, Loc: start, Data: data[start.Pos:]}
+			loc = stepTo(start, data, len(data))
+		}
+	} else {
+		tok, loc, err, warn = lexText(data, loc, lx.afterHTML)
+	}
+
+	if err == nil && tok.Kind != invalidToken {
+		tok.End = loc
+		lx.verbatim = inVerbatim(tok, lx.namespace(tok), lx.opts.rawTextTags())
+		if tok.Kind == tagCloseToken {
+			name, _ := splitCloseTag(tok.Data)
+			lx.afterHTML = lx.afterHTML || bytes.EqualFold(name, []byte("html"))
+		}
+	}
+	return tok, loc, err, warn
+}
+
+// Error if tok, lexed up to end, is bigger than opts.MaxTokenSize.
+func (lx *lexer) checkSize(tok token, end Location) error {
+	if size := end.Pos - tok.Loc.Pos; lx.opts.MaxTokenSize > 0 && size > lx.opts.MaxTokenSize {
+		return errorAt(tok.Loc, "error lexing document: %w: %s of %d bytes, limit %d", SizeLimitErr, tok.Kind, size, lx.opts.MaxTokenSize)
+	}
+	return nil
+}
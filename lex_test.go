@@ -0,0 +1,256 @@
+package gohtml
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestTrailingText(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		warn bool
+	}{
+		{"<p>a</p>b", "<p>a</p>b", false},
+		{"hello", "hello", false},
+		{"<p>a</p>\n", "<p>a</p>", false},
+		{"<html><body></body></html>x", "<html><body></body></html>x", true},
+		{"<html><body></body></html>\n", "<html><body></body></html>", false},
+	}
+
+	for _, test := range tests {
+		node, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		warned := false
+		for _, warn := range warns {
+			warned = warned || errors.Is(warn, EofErr)
+		}
+		if warned != test.warn {
+			t.Errorf("Parse(%q): EOF warning %v, want %v (warnings %v)", test.in, warned, test.warn, warns)
+		}
+	}
+}
+
+// Step through data from loc to end byte by byte.
+func stepBytes(loc Location, data []byte, end int) Location {
+	for _, c := range data[loc.Pos:end] {
+		loc = stepByte(loc, c)
+	}
+	loc.Pos = end
+	return loc
+}
+
+func TestStepTo(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	const alphabet = "ab<&\n\r\t"
+	for range 1000 {
+		data := make([]byte, rng.IntN(100))
+		for i := range data {
+			data[i] = alphabet[rng.IntN(len(alphabet))]
+		}
+		start := rng.IntN(len(data) + 1)
+		end := start + rng.IntN(len(data)-start+1)
+		for _, tabWidth := range []int{0, 4} {
+			loc := stepBytes(Location{Line: 1, Col: 1, tabWidth: tabWidth}, data, start)
+			if got, want := stepTo(loc, data, end), stepBytes(loc, data, end); got != want {
+				t.Fatalf("stepTo(%v, %q, %d) = %v, want %v", loc, data, end, got, want)
+			}
+		}
+	}
+}
+
+func TestStepScanners(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	const alphabet = "ab<>&=\n\r\t "
+	// Offset of the first byte at or after start for which pred holds.
+	scan := func(data []byte, start int, pred func(c byte) bool) int {
+		for start < len(data) && !pred(data[start]) {
+			start++
+		}
+		return start
+	}
+
+	for range 1000 {
+		data := make([]byte, rng.IntN(100))
+		for i := range data {
+			data[i] = alphabet[rng.IntN(len(alphabet))]
+		}
+		start := rng.IntN(len(data) + 1)
+		loc := stepBytes(Location{Line: 1, Col: 1}, data, start)
+
+		tests := []struct {
+			name string
+			got  Location
+			want int
+		}{
+			{"stepToByte", stepToByte(loc, data, '<'), scan(data, start, func(c byte) bool { return c == '<' })},
+			{"stepToAny", stepToAny(loc, data, spacesOrEquals), scan(data, start, func(c byte) bool { return isSpace(c) || c == '=' })},
+			{"stepUntil", stepUntil(loc, data, func(d []byte) bool { return d[0] == '&' }), scan(data, start, func(c byte) bool { return c == '&' })},
+		}
+		for _, test := range tests {
+			if want := stepBytes(loc, data, test.want); test.got != want {
+				t.Fatalf("%s(%v, %q) = %v, want %v", test.name, loc, data, test.got, want)
+			}
+		}
+	}
+}
+
+func TestStepToDelims(t *testing.T) {
+	data := []byte("ab\ncd<e&f-->g")
+	loc := Location{Line: 1, Col: 1}
+	tests := []struct {
+		name string
+		got  Location
+		want int
+	}{
+		{"stepToByte", stepToByte(loc, data, '<'), 5},
+		{"stepToByte missing", stepToByte(loc, data, '"'), len(data)},
+		{"stepToAny", stepToAny(loc, data, "&<"), 5},
+		{"stepUntilPrefix", stepUntilPrefix(loc, data, commentEnd), 9},
+		{"stepUntilPrefix missing", stepUntilPrefix(loc, data, commentStart), len(data)},
+		{"stepN", stepN(loc, data, 100), len(data)},
+	}
+
+	for _, test := range tests {
+		if want := stepBytes(loc, data, test.want); test.got != want {
+			t.Errorf("%s = %v, want %v", test.name, test.got, want)
+		}
+	}
+}
+
+func TestBogusComments(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{"<! bogus>x", "<!-- bogus-->x", BogusCommentErr},
+		{"</3>x", "<!--3-->x", BogusCommentErr},
+		{"</ foo>x", "<!-- foo-->x", BogusCommentErr},
+		{"<!>x", "<!---->x", BogusCommentErr},
+		{"</>x", "x", EmptyContentErr},
+		{"<!DOCTYPE html>x", "<!DOCTYPE html>x", nil},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		if test.err == nil && len(warns) > 0 {
+			t.Errorf("Parse(%q): unexpected warnings %v", test.in, warns)
+		} else if test.err != nil && !slices.ContainsFunc(warns, isErr(test.err)) {
+			t.Errorf("Parse(%q): warnings %v, want %v", test.in, warns, test.err)
+		}
+	}
+}
+
+func TestUnterminatedComments(t *testing.T) {
+	tests := []struct {
+		in      string
+		content string
+		before  int
+	}{
+		{"a<!-- never closed", " never closed", 1},
+		{"<p>a</p><!--", "", 1},
+		{"<p>a<!-- x</p>\n<p>b", " x</p>\n<p>b", 1},
+		{"<!---", "-", 0},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if !slices.ContainsFunc(warns, isErr(EofErr)) {
+			t.Errorf("Parse(%q): warnings %v, want %v", test.in, warns, EofErr)
+		}
+		comment := doc.FindFunc(func(node *Node) bool { return node.Kind == CommentNode })
+		if comment.Kind != CommentNode || comment.Content != test.content || comment.EndLoc.Pos != len(test.in) {
+			t.Errorf("Parse(%q): comment %q ending at %d, want %q to the end", test.in, comment.Content, comment.EndLoc.Pos, test.content)
+		}
+		if n := slices.Index(comment.Parent.Children, comment); n != test.before {
+			t.Errorf("Parse(%q): comment is child %d, want %d", test.in, n, test.before)
+		}
+	}
+}
+
+func TestCDATA(t *testing.T) {
+	tests := []struct {
+		in     string
+		kind   NodeKind
+		want   string
+		render string
+	}{
+		{"<svg><![CDATA[a<b>&amp;]]></svg>", CDATANode, "a<b>&amp;", "<svg><![CDATA[a<b>&amp;]]></svg>"},
+		{"<math><mi><svg><![CDATA[x]]></svg></mi></math>", CDATANode, "x", "<math><mi><svg><![CDATA[x]]></svg></mi></math>"},
+		// NOTE: CDATA sections are recognized within any foreign element,
+		// including integration points, but not within HTML elements
+		{"<svg><foreignObject><![CDATA[x]]></foreignObject></svg>", CDATANode, "x", "<svg><foreignObject><![CDATA[x]]></foreignObject></svg>"},
+		{"<svg><foreignObject><p><![CDATA[x]]></p></foreignObject></svg>", CommentNode, "[CDATA[x]]", "<svg><foreignObject><p><!--[CDATA[x]]--></p></foreignObject></svg>"},
+		{"<p><![CDATA[a<b>]]></p>", CommentNode, "[CDATA[a<b>]]", "<p><!--[CDATA[a<b>]]--></p>"},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		node := doc.FindFunc(func(node *Node) bool {
+			return node.Kind == CDATANode || node.Kind == CommentNode
+		})
+		if node.Kind != test.kind || node.Content != test.want {
+			t.Errorf("Parse(%q) = %v %q, want %v %q", test.in, node.Kind, node.Content, test.kind, test.want)
+		}
+		if len(warns) > 0 {
+			t.Errorf("Parse(%q): unexpected warnings %v", test.in, warns)
+		}
+		if got := string(doc.RenderBytes()); got != test.render {
+			t.Errorf("Parse(%q) renders as %q, want %q", test.in, got, test.render)
+		}
+	}
+}
+
+func TestUnterminatedCDATA(t *testing.T) {
+	tests := []struct {
+		in   string
+		text string
+	}{
+		{"<svg><![CDATA[a<b>c", "<![CDATA[a<b>c"},
+		{"<svg><![CDATA[", "<![CDATA["},
+		{"<p>x<![CDATA[a]]", "<![CDATA[a]]"},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if !slices.ContainsFunc(warns, isErr(EofErr)) {
+			t.Errorf("Parse(%q): warnings %v, want %v", test.in, warns, EofErr)
+		}
+		// NOTE: the rest of the document is kept as text
+		text := doc.FindFunc(func(node *Node) bool {
+			return node.Kind == TextNode && strings.HasPrefix(node.Content, "<![CDATA[")
+		})
+		if text.Content != test.text || text.EndLoc.Pos != len(test.in) {
+			t.Errorf("Parse(%q): text %q ending at %d, want %q to the end", test.in, text.Content, text.EndLoc.Pos, test.text)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package gohtml
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// Index of the newlines in a document, for computing the line and column of a
+// byte offset on demand.  The lexer only tracks byte offsets, leaving the
+// lines of locations 0, so that a parse doesn't pay for stepping the line and
+// column over every token; they're computed for warnings once parsing is
+// done, and for the tree either then or on demand, see resolveLocations and
+// Node.Locate.
+type lineIndex struct {
+	data     []byte
+	start    Location // Location lexing started at, with its line and column
+	newlines []int    // Byte offsets of the newlines after start
+
+	// columns at every lineCheckpointGap bytes from start, computed on
+	// demand up to the furthest location located, so that locating many
+	// locations on one long line isn't quadratic; guarded by mu, since trees
+	// parsed in parallel share their document's index
+	mu   sync.Mutex
+	cols []int
+}
+
+// Byte offsets between the column checkpoints of a lineIndex.
+const lineCheckpointGap = 1024
+
+func newLineIndex(data []byte, start Location) *lineIndex {
+	idx := &lineIndex{data: data, start: start}
+	idx.newlines = make([]int, 0, bytes.Count(data[start.Pos:], newline))
+	for pos := start.Pos; ; pos++ {
+		i := bytes.IndexByte(data[pos:], '\n')
+		if i < 0 {
+			break
+		}
+		pos += i
+		idx.newlines = append(idx.newlines, pos)
+	}
+	return idx
+}
+
+// Compute the line and column of loc from its byte offset, unless they're
+// known already.
+func (idx *lineIndex) locate(loc Location) Location {
+	if loc.Line != 0 || loc.Pos < idx.start.Pos || loc.Pos > len(idx.data) {
+		// NOTE: not a location in the indexed data; leave it be
+		return loc
+	}
+
+	from := idx.start
+	if n := sort.SearchInts(idx.newlines, loc.Pos); n > 0 {
+		from.Line += n
+		from.Col = 1
+		from.Pos = idx.newlines[n-1] + 1
+	}
+	if i := (loc.Pos - idx.start.Pos) / lineCheckpointGap; i > 0 {
+		if pos := idx.start.Pos + i*lineCheckpointGap; pos > from.Pos {
+			// NOTE: the checkpoint is on the same line as loc
+			from.Col, from.Pos = idx.checkpoint(i), pos
+		}
+	}
+
+	from = stepTo(from, idx.data, loc.Pos)
+	loc.Line, loc.Col = from.Line, from.Col
+	return loc
+}
+
+// Column of checkpoint i, at i*lineCheckpointGap bytes from start, computing
+// the checkpoints up to it if need be.
+func (idx *lineIndex) checkpoint(i int) int {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.cols == nil {
+		idx.cols = []int{idx.start.Col}
+	}
+	for len(idx.cols) <= i {
+		n := len(idx.cols) - 1
+		loc := idx.start
+		loc.Pos += n * lineCheckpointGap
+		loc.Col = idx.cols[n]
+		loc = stepTo(loc, idx.data, loc.Pos+lineCheckpointGap)
+		idx.cols = append(idx.cols, loc.Col)
+	}
+	return idx.cols[i]
+}
+
+// Compute the line and column of loc by stepping forward from the location
+// from, whose line and column are known.  Falls back to locate if loc comes
+// before from or far after it.  Returns loc as is if its line and column are
+// already known.
+func (idx *lineIndex) stepFrom(from, loc Location) Location {
+	if loc.Line != 0 {
+		return loc
+	} else if loc.Pos < from.Pos || loc.Pos-from.Pos > lineCheckpointGap || from.Line == 0 {
+		return idx.locate(loc)
+	}
+	from = stepTo(from, idx.data, loc.Pos)
+	loc.Line, loc.Col = from.Line, from.Col
+	return loc
+}
+
+// Compute the lines and columns of the locations of the *ParseErrors among
+// errs, which must have been found in the indexed data.
+func (idx *lineIndex) locateErrors(errs []error) {
+	for _, err := range errs {
+		if parseErr, ok := err.(*ParseError); ok {
+			parseErr.Loc = idx.locate(parseErr.Loc)
+		}
+	}
+}
+
+// Compute the line and column of loc, a location in the document node is a
+// part of, if they aren't known yet, as in a tree parsed with
+// ParseOptions.LazyLocations.  Returns loc as is otherwise.
+func (node *Node) Locate(loc Location) Location {
+	root := node
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	if root.lines == nil {
+		return loc
+	}
+	return root.lines.locate(loc)
+}
+
+// Compute the line and column of every location in a tree lexed with the
+// line index idx.  The tree is walked in document order, entering each node
+// before its children and leaving it after them, so that element and end
+// locations are computed by stepping forward from one to the next; attribute
+// locations are stepped forward from the start of their tag.
+func resolveLocations(node *Node, idx *lineIndex) {
+	type visit struct {
+		node  *Node
+		leave bool
+	}
+	stk := make(stack[visit], 0, 16)
+	stk.Push(visit{node: node})
+
+	from := idx.start
+	step := func(loc *Location) {
+		// NOTE: locations not from the lexer, e.g. the document's, are
+		// skipped, since they needn't be in order
+		if loc.Line == 0 && loc.Pos >= idx.start.Pos {
+			*loc = idx.stepFrom(from, *loc)
+			from = *loc
+		}
+	}
+
+	for v, ok := stk.Pop(); ok; v, ok = stk.Pop() {
+		node := v.node
+		if v.leave {
+			step(&node.EndLoc)
+			continue
+		}
+
+		step(&node.Loc)
+		for key, loc := range node.AttrLocs {
+			loc.Key.Start = idx.stepFrom(node.Loc, loc.Key.Start)
+			loc.Key.End = idx.stepFrom(loc.Key.Start, loc.Key.End)
+			if loc.Val != (Span{}) {
+				loc.Val.Start = idx.stepFrom(loc.Key.End, loc.Val.Start)
+				loc.Val.End = idx.stepFrom(loc.Val.Start, loc.Val.End)
+			}
+			node.AttrLocs[key] = loc
+		}
+
+		stk.Push(visit{node: node, leave: true})
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(visit{node: node.Children[i]})
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package gohtml
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLocations(t *testing.T) {
+	src := "<p>\n  <b x=1>a</b>\r\n<i>é</i>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := doc.Children[0]
+	b, i := p.Children[1], p.Children[3]
+
+	tests := []struct {
+		name string
+		got  Location
+		want Location
+	}{
+		{"p", p.Loc, Location{Line: 1, Col: 1, Pos: 0}},
+		{"b", b.Loc, Location{Line: 2, Col: 3, Pos: 6}},
+		{"b end", b.EndLoc, Location{Line: 2, Col: 15, Pos: 18}},
+		{"x value", b.AttrLocs["x"].Val.Start, Location{Line: 2, Col: 8, Pos: 11}},
+		{"i", i.Loc, Location{Line: 3, Col: 1, Pos: 20}},
+		{"i end", i.EndLoc, Location{Line: 3, Col: 10, Pos: 29}},
+	}
+	for _, test := range tests {
+		// NOTE: locations compare with == like any plain value
+		if test.got != test.want {
+			t.Errorf("%s: got %+v, want %+v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestLazyLocations(t *testing.T) {
+	src := "<p>\n  <b x=1>a</i>"
+	eager, _, _ := Parse([]byte(src))
+	lazy, _, warns := ParseWithOptions([]byte(src), WithLazyLocations())
+
+	b := lazy.Children[0].Children[1]
+	if b.Loc.Line != 0 || b.Loc.Col != 0 || b.Loc.Pos != 6 {
+		t.Errorf("lazy Loc = %+v, want only Pos 6", b.Loc)
+	}
+	if got, want := lazy.Locate(b.Loc), eager.Children[0].Children[1].Loc; got != want {
+		t.Errorf("Locate = %+v, want %+v", got, want)
+	}
+	if got, want := b.Locate(b.AttrLocs["x"].Val.Start), (Location{Line: 2, Col: 8, Pos: 11}); got != want {
+		t.Errorf("Locate(x value) = %+v, want %+v", got, want)
+	}
+
+	for _, warn := range warns {
+		if parseErr, ok := warn.(*ParseError); ok && parseErr.Loc.Line == 0 {
+			t.Errorf("warning %q has no line", warn)
+		}
+	}
+}
+
+func TestTabWidth(t *testing.T) {
+	src := "<div>\n\t<p>\ta\t<b>x</b></p>\n  \t<i x=\"\t\">y</i></div>"
+	tests := []struct {
+		tabWidth int
+		p, b, i  int
+		xVal     int
+	}{
+		{0, 2, 8, 4, 10},
+		{1, 2, 8, 4, 10},
+		{4, 5, 13, 5, 11},
+		{8, 9, 25, 9, 15},
+	}
+
+	for _, test := range tests {
+		for _, opts := range [][]Option{{WithTabWidth(test.tabWidth)}, {WithTabWidth(test.tabWidth), WithLazyLocations()}} {
+			doc, err, _ := ParseWithOptions([]byte(src), opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, b, i := doc.Find("p"), doc.Find("b"), doc.Find("i")
+			xVal := doc.Locate(i.AttrLocs["x"].Val.Start)
+			got := []int{doc.Locate(p.Loc).Col, doc.Locate(b.Loc).Col, doc.Locate(i.Loc).Col, xVal.Col}
+			want := []int{test.p, test.b, test.i, test.xVal}
+			if !slices.Equal(got, want) {
+				t.Errorf("tab width %d (%d options): columns = %v, want %v", test.tabWidth, len(opts), got, want)
+			}
+		}
+	}
+}
+
+func TestFilename(t *testing.T) {
+	src := "<p>\n  <b>a</i></p>"
+	for _, opts := range [][]Option{{WithFilename("index.html")}, {WithFilename("index.html"), WithLazyLocations()}} {
+		doc, err, warns := ParseWithOptions([]byte(src), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(warns) == 0 {
+			t.Fatalf("Parse(%q): no warnings", src)
+		}
+		if got, want := warns[0].Error(), "index.html:2:7: "; !strings.HasPrefix(got, want) {
+			t.Errorf("warning = %q, want prefix %q", got, want)
+		}
+		if b := doc.Find("b"); doc.Locate(b.Loc).String() != "index.html:2:3" {
+			t.Errorf("<b> location = %q, want %q", doc.Locate(b.Loc), "index.html:2:3")
+		}
+	}
+
+	_, _, warns := Parse([]byte(src))
+	if got, want := warns[0].Error(), "2:7: "; !strings.HasPrefix(got, want) {
+		t.Errorf("warning without filename = %q, want prefix %q", got, want)
+	}
+}
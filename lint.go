@@ -0,0 +1,139 @@
+package gohtml
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Problem found in a document, along with machine-applicable edits that fix
+// it.
+type Finding struct {
+	Loc   Location // Location of the problem in the document
+	Err   error    // Description of the problem, wrapping one of the package errors
+	Fixes []Edit   // Edits that fix the problem, in document order; may be empty
+}
+
+// Error message-friendly string representation.
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Loc, f.Err)
+}
+
+// Lint a document node parsed from src, reporting images without alt text,
+// unquoted attribute values, and unclosed elements other than those whose end
+// tags are optional (see ParseOptions.ImpliedEndTags).  Findings are returned
+// in the order of their fixes, such that the fixes may all be applied at once,
+// in order.
+func Lint(src []byte, doc *Node, opts ...Option) []Finding {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.Lint(src, doc)
+}
+
+// Lint a document node parsed from src with opts; see Lint.
+func (opts ParseOptions) Lint(src []byte, doc *Node) []Finding {
+	r := Renderer{Source: src, VoidTags: opts.VoidTags, RawTextTags: opts.RawTextTags}
+
+	type lintNode struct {
+		node  *Node
+		depth int
+	}
+	type lintFinding struct {
+		Finding
+		depth int
+	}
+	findings := make([]lintFinding, 0, 16)
+
+	stk := make(stack[lintNode], 0, 16)
+	stk.Push(lintNode{doc, 0})
+
+	for item, ok := stk.Pop(); ok; item, ok = stk.Pop() {
+		node := item.node
+		if node.Kind == ElementNode && r.inSource(node) {
+			for _, f := range lintElement(&opts, &r, node) {
+				findings = append(findings, lintFinding{f, item.depth})
+			}
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(lintNode{node.Children[i], item.depth + 1})
+		}
+	}
+
+	// order by fixes; where several closing tags are inserted at the same
+	// location, close inner elements first
+	sort.SliceStable(findings, func(i, j int) bool {
+		a, b := findings[i], findings[j]
+		if len(a.Fixes) == 0 || len(b.Fixes) == 0 {
+			return a.Loc.Pos < b.Loc.Pos
+		} else if a.Fixes[0].Span.Start.Pos != b.Fixes[0].Span.Start.Pos {
+			return a.Fixes[0].Span.Start.Pos < b.Fixes[0].Span.Start.Pos
+		}
+		return a.depth > b.depth
+	})
+
+	result := make([]Finding, len(findings))
+	for i, f := range findings {
+		result[i] = f.Finding
+	}
+	return result
+}
+
+func lintElement(opts *ParseOptions, r *Renderer, node *Node) []Finding {
+	var findings []Finding
+
+	tok, end, err := lexTagOpen(r.Source[:node.EndLoc.Pos], node.Loc)
+	if err != nil {
+		return findings
+	}
+
+	if node.Content == "img" {
+		if _, ok := node.Attrs["alt"]; !ok {
+			// insert just before the tag's closing '>' or "/>"
+			pos := end.Pos - len(tagEnd)
+			if tok.Kind == tagSelfcloseToken {
+				pos = end.Pos - len(tagSelfcloseEnd)
+			}
+			loc := stepN(node.Loc, r.Source, pos-node.Loc.Pos)
+			findings = append(findings, Finding{
+				Loc:   node.Loc,
+				Err:   fmt.Errorf("%w: %q on <img>", MissingAttrErr, "alt"),
+				Fixes: []Edit{{Span: Span{Start: loc, End: loc}, NewText: ` alt=""`}},
+			})
+		}
+	}
+
+	for key, raw := range node.RawAttrs {
+		if raw == "" || raw[0] == '"' || raw[0] == '\'' {
+			continue
+		}
+		attrLoc, ok := node.AttrLoc(key)
+		if !ok {
+			continue
+		}
+		findings = append(findings, Finding{
+			Loc: attrLoc.Val.Start,
+			Err: fmt.Errorf("%w: %q", UnquotedAttrErr, key),
+			Fixes: []Edit{{
+				Span:    attrLoc.Val,
+				NewText: `"` + strings.ReplaceAll(raw, `"`, "&quot;") + `"`,
+			}},
+		})
+	}
+
+	// NOTE: optional end tags (e.g. of <li>) may be omitted, and self-closing
+	// tags of foreign elements (or of any, with XMLSelfClosing) need none
+	selfclosed := tok.Kind == tagSelfcloseToken && (node.Namespace != HTMLNamespace || opts.SelfClosing == XMLSelfClosing)
+	if !r.isVoid(node) && !selfclosed && !opts.optionalEnd(node.Content) && r.sourceCloseTagStart(node) < 0 {
+		findings = append(findings, Finding{
+			Loc:   node.Loc,
+			Err:   fmt.Errorf("%w: %q", UnclosedTagErr, node.Content),
+			Fixes: []Edit{{Span: Span{Start: node.EndLoc, End: node.EndLoc}, NewText: "</" + node.Content + ">"}},
+		})
+	}
+
+	return findings
+}
@@ -0,0 +1,140 @@
+package gohtml
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLintVoidTags(t *testing.T) {
+	voidTags := maps.Clone(DefaultVoidTags)
+	voidTags["x-icon"] = true
+
+	src := []byte("<div><x-icon></div>")
+	doc, err, _ := ParseWithOptions(src, WithVoidTags(voidTags))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range Lint(src, doc, WithVoidTags(voidTags)) {
+		if errors.Is(f.Err, UnclosedTagErr) {
+			t.Errorf("unexpected finding: %s", f)
+		}
+	}
+}
+
+func TestLintOptionalEndTags(t *testing.T) {
+	tests := []struct {
+		src      string
+		unclosed []string
+	}{
+		{"<ul><li>a<li>b</ul>", nil},
+		{"<p>a<p>b", nil},
+		{"<table><tr><td>a<td>b</table>", nil},
+		{"<dl><dt>a<dd>b</dl>", nil},
+		{"<div><span>a</div>", []string{"span"}},
+		{"<ul><li><b>a</ul>", []string{"b"}},
+	}
+
+	for _, test := range tests {
+		src := []byte(test.src)
+		doc, err, _ := Parse(src)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		var unclosed []string
+		for _, f := range Lint(src, doc) {
+			if errors.Is(f.Err, UnclosedTagErr) {
+				unclosed = append(unclosed, strings.Trim(f.Fixes[0].NewText, "</>"))
+			}
+		}
+		if !slices.Equal(unclosed, test.unclosed) {
+			t.Errorf("Lint(%q) reports %q unclosed, want %q", test.src, unclosed, test.unclosed)
+		}
+	}
+}
+
+func TestLintSelfClosing(t *testing.T) {
+	tests := []struct {
+		src      string
+		opts     []Option
+		unclosed []string
+	}{
+		{`<svg><circle r="1"/></svg>`, nil, nil},
+		{`<math><mi/></math>`, nil, nil},
+		{`<div><svg><g><rect/></svg></div>`, nil, []string{"g"}},
+		{`<p><div/></p>`, []Option{WithSelfClosing(XMLSelfClosing)}, nil},
+	}
+
+	for _, test := range tests {
+		src := []byte(test.src)
+		doc, err, _ := ParseWithOptions(src, test.opts...)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		var unclosed []string
+		for _, f := range Lint(src, doc, test.opts...) {
+			if errors.Is(f.Err, UnclosedTagErr) {
+				unclosed = append(unclosed, strings.Trim(f.Fixes[0].NewText, "</>"))
+			}
+		}
+		if !slices.Equal(unclosed, test.unclosed) {
+			t.Errorf("Lint(%q) reports %q unclosed, want %q", test.src, unclosed, test.unclosed)
+		}
+	}
+}
+
+func TestLintFixes(t *testing.T) {
+	tests := []struct {
+		src  string
+		errs []error
+		want string
+	}{
+		{`<img src="a.png">`, []error{MissingAttrErr}, `<img src="a.png" alt="">`},
+		{`<img src="a.png"/>`, []error{MissingAttrErr}, `<img src="a.png" alt=""/>`},
+		{`<img src="a.png" alt="">`, nil, `<img src="a.png" alt="">`},
+		{`<p title=a>x</p>`, []error{UnquotedAttrErr}, `<p title="a">x</p>`},
+		{`<p title=a"b>x</p>`, []error{UnquotedAttrErr}, `<p title="a&quot;b">x</p>`},
+		{`<div><span>a</div>`, []error{UnclosedTagErr}, `<div><span>a</span></div>`},
+		{
+			`<div class=x><img src=a.png><svg><circle r=1/></svg><b>y</div>`,
+			[]error{UnquotedAttrErr, UnquotedAttrErr, MissingAttrErr, UnquotedAttrErr, UnclosedTagErr},
+			`<div class="x"><img src="a.png" alt=""><svg><circle r="1"/></svg><b>y</b></div>`,
+		},
+	}
+
+	for _, test := range tests {
+		src := []byte(test.src)
+		doc, err, _ := Parse(src)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		findings := Lint(src, doc)
+
+		var errs []error
+		var edits []Edit
+		for _, f := range findings {
+			for _, target := range []error{MissingAttrErr, UnquotedAttrErr, UnclosedTagErr} {
+				if errors.Is(f.Err, target) {
+					errs = append(errs, target)
+				}
+			}
+			edits = append(edits, f.Fixes...)
+		}
+		if !slices.Equal(errs, test.errs) {
+			t.Errorf("Lint(%q) = %v, want %v", test.src, findings, test.errs)
+		}
+
+		fixed, err := ApplyEdits(src, edits)
+		if err != nil {
+			t.Fatalf("ApplyEdits(%q): %v", test.src, err)
+		}
+		if string(fixed) != test.want {
+			t.Errorf("Lint(%q) fixed = %q, want %q", test.src, fixed, test.want)
+		}
+		if doc, _, _ := Parse(fixed); len(Lint(fixed, doc)) != 0 {
+			t.Errorf("Lint(%q) after fixes = %v, want none", fixed, Lint(fixed, doc))
+		}
+	}
+}
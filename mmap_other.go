@@ -0,0 +1,18 @@
+//go:build !unix
+
+package gohtml
+
+import (
+	"os"
+)
+
+// Read the contents of the file at path, on platforms without memory mapping
+// support.
+func mapFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+// Free data read by mapFile, which is left to the garbage collector.
+func unmapFile(data []byte) error {
+	return nil
+}
@@ -0,0 +1,38 @@
+//go:build unix
+
+package gohtml
+
+import (
+	"os"
+	"syscall"
+)
+
+// Map the contents of the file at path into memory, read-only.  Returns nil
+// for an empty file, which can't be mapped.
+func mapFile(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	} else if info.Size() == 0 {
+		return nil, nil
+	} else if int64(int(info.Size())) != info.Size() {
+		return nil, &os.PathError{Op: "mmap", Path: path, Err: syscall.EFBIG}
+	}
+
+	data, err := syscall.Mmap(int(file.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, &os.PathError{Op: "mmap", Path: path, Err: err}
+	}
+	return data, nil
+}
+
+// Unmap data mapped by mapFile.
+func unmapFile(data []byte) error {
+	return syscall.Munmap(data)
+}
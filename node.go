@@ -1,7 +1,12 @@
 package gohtml
 
 import (
+	"bytes"
+	"cmp"
 	"fmt"
+	"regexp"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -10,13 +15,72 @@ type Location struct {
 	Line int // 1-indexed line number
 	Col  int // 1-indexed column number
 	Pos  int // 0-indexed byte offset
+
+	// Name of the file, if any; see ParseOptions.Filename.
+	File string
+
+	// Number of columns a tab advances to the next tab stop; see
+	// ParseOptions.TabWidth.  Carried along as the lexer steps forward.
+	tabWidth int
 }
 
 // Error message-friendly string representation.
 func (loc Location) String() string {
+	if loc.File != "" {
+		return fmt.Sprintf("%s:%d:%d", loc.File, loc.Line, loc.Col)
+	}
 	return fmt.Sprintf("%d:%d", loc.Line, loc.Col)
 }
 
+// Render the line of data containing the location, followed by a line with a
+// caret under the location's column, as compilers do in diagnostics, e.g.:
+//
+//	<p>fish &chips;</p>
+//	        ^
+//
+// data must be the document the location refers to.  Returns "" if the
+// location isn't within data.
+func (loc Location) Snippet(data []byte) string {
+	if loc.Pos < 0 || loc.Pos > len(data) {
+		return ""
+	}
+
+	start := bytes.LastIndexByte(data[:loc.Pos], '\n') + 1
+	end := bytes.IndexByte(data[loc.Pos:], '\n')
+	if end < 0 {
+		end = len(data)
+	} else {
+		end += loc.Pos
+	}
+	line := bytes.TrimRight(data[start:end], "\r")
+
+	// keep tabs in the caret line, so that it lines up however they're shown
+	var b strings.Builder
+	b.Write(line)
+	b.WriteByte('\n')
+	for _, r := range string(data[start:loc.Pos]) {
+		if r == '\t' {
+			b.WriteByte('\t')
+		} else if r != '\r' {
+			b.WriteByte(' ')
+		}
+	}
+	b.WriteString("^\n")
+	return b.String()
+}
+
+// Range in a file, from Start up to but not including End.
+type Span struct {
+	Start Location
+	End   Location
+}
+
+// Locations of a tag attribute in a file.
+type AttrLoc struct {
+	Key Span // Attribute key
+	Val Span // Attribute value, excluding quotes; zero if there is no value
+}
+
 // Represents an HTML tag.
 type Tag struct {
 	Name  string            // Tag name
@@ -27,12 +91,15 @@ type Tag struct {
 type NodeKind int
 
 const (
-	InvalidNode     NodeKind = iota // Signifies an erroneous or zero node
-	DocumentNode                    // Top-level node for a whole HTML document
-	ElementNode                     // Element
-	TextNode                        // Content between start and end tags
-	CommentNode                     // Comment
-	DeclarationNode                 // Declaration (e.g. <!DOCTYPE html>)
+	InvalidNode               NodeKind = iota // Signifies an erroneous or zero node
+	DocumentNode                              // Top-level node for a whole HTML document
+	ElementNode                               // Element
+	TextNode                                  // Content between start and end tags
+	CommentNode                               // Comment
+	DeclarationNode                           // Declaration (e.g. <!DOCTYPE html>)
+	CDATANode                                 // CDATA section in foreign content
+	ProcessingInstructionNode                 // Processing instruction (e.g. <?php ... ?>)
+	ConditionalCommentNode                    // Conditional comment (e.g. <!--[if mso]>...<![endif]-->)
 )
 
 // Error message-friendly string representation.
@@ -48,6 +115,12 @@ func (kind NodeKind) String() string {
 		return "CommentNode"
 	case DeclarationNode:
 		return "DeclarationNode"
+	case CDATANode:
+		return "CDATANode"
+	case ProcessingInstructionNode:
+		return "ProcessingInstructionNode"
+	case ConditionalCommentNode:
+		return "ConditionalCommentNode"
 	default:
 		return "InvalidNode"
 	}
@@ -59,29 +132,192 @@ type Node struct {
 	// Kind.
 	Kind NodeKind
 
-	// Text for TextNode, CommentNode, and DeclarationNode, and tag name for
-	// ElementNode. Empty otherwise.
+	// Text for TextNode, CommentNode, DeclarationNode, CDATANode, and
+	// ProcessingInstructionNode (e.g. "php echo 1;"), condition for
+	// ConditionalCommentNode (e.g. "gte mso 9"), and tag name for ElementNode.
+	// Empty otherwise.
 	Content string
 
-	// Tag attributes. Only applicable to ElementNode.
+	// Tag attributes. Only applicable to ElementNode; nil if the element has
+	// no attributes, so that assigning to it directly (e.g. Attrs["k"] = v)
+	// panics for such elements.  Use SetAttr to add attributes.
 	Attrs map[string]string
 
-	// Child nodes. Only applicable to ElementNode and DocumentNode.
+	// Keys of Attrs in the order the attributes were written, followed by
+	// those added with SetAttr in the order they were added.  If duplicate
+	// attributes were written, only the first is kept.  Use AttrKeys to list
+	// attributes in order.
+	AttrOrder []string
+
+	// Tag attribute values as originally written, before entity expansion and
+	// including any quotes (e.g. `'a&amp;b'`), keyed the same as Attrs.  Empty
+	// for attributes written without a value. Only applicable to ElementNode.
+	RawAttrs map[string]string
+
+	// Locations of tag attributes in the original document, keyed the same as
+	// Attrs. Only applicable to ElementNode.
+	AttrLocs map[string]AttrLoc
+
+	// Parent node; nil for the root of a tree.  Set by the parser and by Tx
+	// mutations; must be kept up to date by code that modifies Children
+	// directly.
+	Parent *Node
+
+	// Namespace of an element; HTMLNamespace for any other kind of node.
+	// Foreign (SVG and MathML) elements have their tag and attribute names
+	// adjusted to their proper case, e.g. "viewBox" rather than "viewbox".
+	Namespace Namespace
+
+	// Compatibility mode of the document, as determined by its DOCTYPE. Only
+	// applicable to DocumentNode.
+	QuirksMode QuirksMode
+
+	// Whether Content holds text as written, with its character references
+	// (e.g. &amp;) unexpanded; see ParseOptions.DeferEntities.  Only
+	// applicable to TextNode.
+	Unexpanded bool
+
+	// Whether the contents of a conditional comment are also shown by browsers
+	// that don't support conditional comments, as with <!--[if !mso]><!-->.
+	// Only applicable to ConditionalCommentNode.
+	Revealed bool
+
+	// Character encoding label of the document, as set by
+	// ParseOptions.Encoding or detected by DetectCharset (e.g. "utf-8"), or
+	// "" if it doesn't declare one. Only applicable to DocumentNode.
+	Charset string
+
+	// Child nodes. Only applicable to ElementNode, DocumentNode, and
+	// ConditionalCommentNode; nil if the node has no children.
 	Children []*Node
 
 	// Location in the original document where the node began.
 	Loc Location
+
+	// Location in the original document just past the end of the node,
+	// including its closing tag (if any).
+	EndLoc Location
+
+	// Allocator of the nodes of a document parsed with ParseOptions.Arena;
+	// see Release.  Only applicable to DocumentNode.
+	arena *arena
+
+	// Index of the lines of a document parsed with
+	// ParseOptions.LazyLocations; see Locate.  Only applicable to
+	// DocumentNode.
+	lines *lineIndex
+
+	// Raw text elements of a document parsed with ParseOptions.RawTextTags;
+	// see RawText.  Only applicable to DocumentNode.
+	rawTextTags map[string]bool
+}
+
+// Range of the node in the original document, from the start of its opening
+// tag through the end of its closing tag (if any).  For elements left open, the
+// range extends to where they were implicitly closed.
+func (node *Node) Span() Span {
+	return Span{Start: node.Loc, End: node.EndLoc}
 }
 
-// Make a new empty node.
+// Make a new empty node.  Like parsed nodes, it has no attribute maps or
+// children allocated; use SetAttr to add attributes.
 func EmptyNode() (node *Node) {
 	return &Node{
-		Kind:     InvalidNode,
-		Content:  "",
-		Attrs:    make(map[string]string),
-		Children: make([]*Node, 0),
-		Loc:      Location{Line: 0, Col: 0, Pos: -1},
+		Kind:    InvalidNode,
+		Content: "",
+		Loc:     Location{Line: 0, Col: 0, Pos: -1},
+		EndLoc:  Location{Line: 0, Col: 0, Pos: -1},
+	}
+}
+
+// Return the value of the attribute key and whether the element has it.  For
+// foreign elements, key is matched in its proper case, so "viewbox" finds
+// viewBox.
+func (node *Node) Attr(key string) (string, bool) {
+	val, ok := node.Attrs[node.attrKey(key)]
+	return val, ok
+}
+
+// Return the locations of the attribute key and its value in the original
+// document, and whether they are known.  Locations are unknown for attributes
+// added after parsing.
+func (node *Node) AttrLoc(key string) (AttrLoc, bool) {
+	loc, ok := node.AttrLocs[node.attrKey(key)]
+	return loc, ok
+}
+
+// Set the attribute key to val, allocating node.Attrs if needed.  New keys
+// are added after the existing ones in node.AttrOrder.  For foreign elements,
+// key is adjusted to its proper case, e.g. "viewBox" for "viewbox".
+func (node *Node) SetAttr(key string, val string) {
+	key = node.attrKey(key)
+	if node.Attrs == nil {
+		node.Attrs = make(map[string]string)
+	}
+	if _, ok := node.Attrs[key]; !ok {
+		node.AttrOrder = append(node.AttrOrder, key)
 	}
+	node.Attrs[key] = val
+}
+
+// Whether the attribute key was written as a boolean attribute, i.e. without
+// a value (e.g. disabled, as opposed to disabled="").  Both have an empty
+// value in node.Attrs; an attribute given a non-empty value since parsing is
+// no longer boolean.
+func (node *Node) BoolAttr(key string) bool {
+	key = node.attrKey(key)
+	raw, ok := node.RawAttrs[key]
+	val, hasKey := node.Attrs[key]
+	return ok && hasKey && raw == "" && val == ""
+}
+
+// Set the attribute key as a boolean attribute, with an empty value and
+// rendered without one.
+func (node *Node) SetBoolAttr(key string) {
+	key = node.attrKey(key)
+	node.SetAttr(key, "")
+	if node.RawAttrs == nil {
+		node.RawAttrs = make(map[string]string)
+	}
+	node.RawAttrs[key] = ""
+}
+
+// Remove the attribute key, along with its source information.
+func (node *Node) RemoveAttr(key string) {
+	key = node.attrKey(key)
+	delete(node.Attrs, key)
+	delete(node.RawAttrs, key)
+	delete(node.AttrLocs, key)
+	if i := slices.Index(node.AttrOrder, key); i >= 0 {
+		node.AttrOrder = slices.Delete(node.AttrOrder, i, i+1)
+	}
+}
+
+// Return the keys of the node's attributes in order: first as listed in
+// node.AttrOrder, then any keys missing from it (e.g. set directly in
+// node.Attrs) in sorted order.
+func (node *Node) AttrKeys() []string {
+	keys := make([]string, 0, len(node.Attrs))
+	seen := make(map[string]bool, len(node.Attrs))
+	for _, key := range node.AttrOrder {
+		if _, ok := node.Attrs[key]; ok && !seen[key] {
+			keys = append(keys, key)
+			seen[key] = true
+		}
+	}
+
+	if len(keys) == len(node.Attrs) {
+		return keys
+	}
+
+	rest := make([]string, 0, len(node.Attrs)-len(keys))
+	for key := range node.Attrs {
+		if !seen[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	return append(keys, rest...)
 }
 
 // Match an HTML element with a Tag.  Only applicable to ElementNode; returns
@@ -106,6 +342,21 @@ func (node *Node) MatchTag(tag Tag) bool {
 	return true
 }
 
+// Whether the element has name among the whitespace-separated classes of its
+// class attribute.  Only applicable to ElementNode; returns false for any
+// other NodeKind.
+func (node *Node) HasClass(name string) bool {
+	if node.Kind != ElementNode {
+		return false
+	}
+	for _, class := range strings.FieldsFunc(node.Attrs["class"], isSpaceR) {
+		if class == name {
+			return true
+		}
+	}
+	return false
+}
+
 // Find the first descendant node that has the tag name tagName; i.e. returns
 // the first ElementNode with node.Content == tagName.  Returns an empty,
 // non-nil *Node of InvalidNode kind if no matching descendant was
@@ -181,6 +432,38 @@ func (node *Node) FindAll(tagName string, prune bool) []*Node {
 	return matches
 }
 
+// Find at most n descendant nodes that have the tag name tagName, as with
+// FindAll, stopping the search once n are found.  If n < 0, all are found.
+// Returns an empty, non-nil slice of *Node if no matching descendants were
+// found or n == 0.
+func (node *Node) FindAllN(tagName string, n int, prune bool) []*Node {
+	matches := make([]*Node, 0, min(max(n, 0), 16))
+	if n == 0 {
+		return matches
+	}
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if node.Kind == ElementNode && node.Content == tagName {
+			matches = append(matches, node)
+			if len(matches) == n {
+				break
+			} else if prune {
+				continue
+			}
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return matches
+}
+
 // Find all descendant nodes that have the tag name tagName; i.e. returns all
 // ElementNodes with node.Content == tagName.
 //
@@ -209,15 +492,304 @@ func (node *Node) FindTagAll(tag Tag, prune bool) []*Node {
 	return matches
 }
 
-// Return the concatenated contents of all descendent TextNodes.
+// Find the first descendant node of any kind for which pred returns true.
+// Returns an empty, non-nil *Node of InvalidNode kind if no matching
+// descendant was found.
+func (node *Node) FindFunc(pred func(node *Node) bool) *Node {
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if pred(node) {
+			return node
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return EmptyNode()
+}
+
+// Find all descendant nodes of any kind for which pred returns true.  Returns
+// an empty, non-nil slice of *Node if no matching descendants were found.
+//
+// Pass prune == true to prune the search if the descendant node matches, thus
+// returning a flat slice of nodes.
+func (node *Node) FindAllFunc(pred func(node *Node) bool, prune bool) []*Node {
+	matches := make([]*Node, 0, 16)
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if pred(node) {
+			matches = append(matches, node)
+			if prune {
+				continue
+			}
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return matches
+}
+
+// Find the descendant element with the given id.  If several elements share
+// the id, the first in document order wins, as with getElementById in
+// browsers.  Returns an empty, non-nil *Node of InvalidNode kind if there is
+// none.
+func (node *Node) FindByID(id string) *Node {
+	return node.FindFunc(func(node *Node) bool {
+		if node.Kind != ElementNode {
+			return false
+		}
+		nodeID, ok := node.Attrs["id"]
+		return ok && nodeID == id
+	})
+}
+
+// Find the first descendant element that has the class name; see HasClass.
+// Returns an empty, non-nil *Node of InvalidNode kind if no matching
+// descendant was found.
+func (node *Node) FindByClass(name string) *Node {
+	return node.FindFunc(func(node *Node) bool {
+		return node.HasClass(name)
+	})
+}
+
+// Find all descendant elements that have the class name; see HasClass.
+// Returns an empty, non-nil slice of *Node if no matching descendants were
+// found.
+//
+// Pass prune == true to prune the search if the descendant node matches, thus
+// returning a flat slice of nodes.
+func (node *Node) FindAllByClass(name string, prune bool) []*Node {
+	return node.FindAllFunc(func(node *Node) bool {
+		return node.HasClass(name)
+	}, prune)
+}
+
+// Find the first descendant element that has the attribute key, whatever
+// its value; see Attr.  Returns an empty, non-nil *Node of InvalidNode kind
+// if no matching descendant was found.
+func (node *Node) FindByAttr(key string) *Node {
+	return node.FindFunc(func(node *Node) bool {
+		_, ok := node.Attr(key)
+		return ok && node.Kind == ElementNode
+	})
+}
+
+// Find all descendant elements that have the attribute key, whatever its
+// value; see Attr.  Returns an empty, non-nil slice of *Node if no matching
+// descendants were found.
+//
+// Pass prune == true to prune the search if the descendant node matches, thus
+// returning a flat slice of nodes.
+func (node *Node) FindAllByAttr(key string, prune bool) []*Node {
+	return node.FindAllFunc(func(node *Node) bool {
+		_, ok := node.Attr(key)
+		return ok && node.Kind == ElementNode
+	}, prune)
+}
+
+// Find the first descendant element whose attribute key has the value val;
+// see Attr.  Returns an empty, non-nil *Node of InvalidNode kind if no
+// matching descendant was found.
+func (node *Node) FindByAttrValue(key, val string) *Node {
+	return node.FindFunc(func(node *Node) bool {
+		nodeVal, ok := node.Attr(key)
+		return ok && nodeVal == val && node.Kind == ElementNode
+	})
+}
+
+// Find all descendant elements whose attribute key has the value val; see
+// Attr.  Returns an empty, non-nil slice of *Node if no matching descendants
+// were found.
+//
+// Pass prune == true to prune the search if the descendant node matches, thus
+// returning a flat slice of nodes.
+func (node *Node) FindAllByAttrValue(key, val string, prune bool) []*Node {
+	return node.FindAllFunc(func(node *Node) bool {
+		nodeVal, ok := node.Attr(key)
+		return ok && nodeVal == val && node.Kind == ElementNode
+	}, prune)
+}
+
+// Find all descendant TextNodes whose content matches re, e.g. to find the
+// element containing "Price:" as the Parent of the text.  Text left
+// unexpanded by ParseOptions.DeferEntities is matched as expanded.  Returns
+// an empty, non-nil slice of *Node if no matching descendants were found.
+func (node *Node) FindAllText(re *regexp.Regexp) []*Node {
+	return node.FindAllFunc(func(node *Node) bool {
+		if node.Kind != TextNode {
+			return false
+		} else if node.Unexpanded {
+			return re.MatchString(UnescapeString(node.Content))
+		}
+		return re.MatchString(node.Content)
+	}, false)
+}
+
+// Return the concatenated contents of all descendent TextNodes and CDATANodes.
 func (node *Node) Text() string {
+	return node.TextSep("")
+}
+
+// Return the contents of all descendent TextNodes joined by sep, e.g. so that
+// the text of adjacent table cells does not run together.  CDATANodes count
+// as TextNodes.
+func (node *Node) TextSep(sep string) string {
 	contents := make([]string, 0, len(node.Children))
 
 	stk := make(stack[*Node], 0, 16)
 	stk.Push(node)
 
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if node.Kind == TextNode || node.Kind == CDATANode {
+			contents = append(contents, node.Content)
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return strings.Join(contents, sep)
+}
+
+// Return the concatenated text of all descendent TextNodes as it would be
+// written in HTML, i.e. with its character references (e.g. &amp;)
+// unexpanded.  Unexpanded text is returned as is, and other text is escaped
+// again, except within raw text elements (e.g. <script>), per the
+// ParseOptions.RawTextTags the tree was parsed with.
+func (node *Node) RawText() string {
+	contents := make([]string, 0, len(node.Children))
+
+	root := node
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	rawTextTags := root.rawTextTags
+	if rawTextTags == nil {
+		rawTextTags = DefaultRawTextTags
+	}
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
 	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
 		if node.Kind == TextNode {
+			if node.Unexpanded || node.Parent != nil && rawTextTags[node.Parent.Content] {
+				contents = append(contents, node.Content)
+			} else {
+				contents = append(contents, textEscaper.Replace(node.Content))
+			}
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return strings.Join(contents, "")
+}
+
+// Expand the character references in all descendent TextNodes left
+// unexpanded by ParseOptions.DeferEntities.  Returns any invalid character
+// references as warnings, as parsing would.
+func (node *Node) ExpandEntities() []error {
+	var warns []error
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if node.Kind == TextNode && node.Unexpanded {
+			content, entityWarns := expandEntitysString(node.Content, node.Loc, false)
+			node.Content = content
+			node.Unexpanded = false
+			warns = append(warns, entityWarns...)
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return warns
+}
+
+// Return the concatenated contents of the node's immediate TextNode children,
+// excluding text within descendant elements.  CDATANodes count as TextNodes.
+func (node *Node) OwnText() string {
+	contents := make([]string, 0, len(node.Children))
+	for _, child := range node.Children {
+		if child.Kind == TextNode || child.Kind == CDATANode {
+			contents = append(contents, child.Content)
+		}
+	}
+	return strings.Join(contents, "")
+}
+
+// Elements whose contents are never displayed.
+var hiddenTags = map[string]bool{
+	"head":     true,
+	"script":   true,
+	"style":    true,
+	"template": true,
+}
+
+// Whether an element is hidden from users, either by its tag or by its
+// attributes (hidden, aria-hidden="true", or an inline display:none style).
+func isHidden(node *Node) bool {
+	if node.Kind != ElementNode {
+		return false
+	} else if hiddenTags[node.Content] {
+		return true
+	} else if _, ok := node.Attrs["hidden"]; ok {
+		return true
+	} else if strings.EqualFold(strings.TrimSpace(node.Attrs["aria-hidden"]), "true") {
+		return true
+	}
+
+	for _, decl := range strings.Split(node.Attrs["style"], ";") {
+		prop, val, found := strings.Cut(decl, ":")
+		if !found || !strings.EqualFold(strings.TrimSpace(prop), "display") {
+			continue
+		}
+		val = strings.TrimSuffix(strings.TrimSpace(val), "!important")
+		if strings.EqualFold(strings.TrimSpace(val), "none") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Return the concatenated contents of all descendent TextNodes that are
+// visible to users; i.e. like Text, but excluding text within <head>,
+// <script>, <style>, and <template> elements, and within elements hidden by
+// the hidden attribute, aria-hidden="true", or an inline display:none style.
+func (node *Node) VisibleText() string {
+	contents := make([]string, 0, len(node.Children))
+
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if isHidden(node) {
+			continue
+		} else if node.Kind == TextNode {
 			contents = append(contents, node.Content)
 		}
 
@@ -230,11 +802,72 @@ func (node *Node) Text() string {
 	return strings.Join(contents, "")
 }
 
+// Sort nodes in place into document order, i.e. the order in which they
+// began in the original document, with ancestors before their descendants.
+// Nodes are ordered by their recorded locations, so this is only meaningful
+// for nodes parsed from the same document.
+func SortDocumentOrder(nodes []*Node) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		if a.Loc.Pos != b.Loc.Pos {
+			return a.Loc.Pos < b.Loc.Pos
+		}
+		// nodes beginning at the same location; the ancestor ends later
+		return a.EndLoc.Pos > b.EndLoc.Pos
+	})
+}
+
+// Whether other is the node itself or one of its descendants, as with
+// contains() in the DOM.  Follows the Parent links up from other.
+func (node *Node) Contains(other *Node) bool {
+	for ; other != nil; other = other.Parent {
+		if other == node {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare the positions of a and b in their tree: returns -1 if a comes
+// before b in document order, 1 if after, and 0 if they are the same node.
+// Ancestors come before their descendants.  Positions are found through the
+// Parent links, so this holds for trees modified after parsing; nodes in
+// different trees are ordered by their recorded locations instead, as with
+// SortDocumentOrder.
+func CompareDocumentOrder(a, b *Node) int {
+	if a == b {
+		return 0
+	}
+
+	pathA := slices.Collect(a.Ancestors())
+	pathB := slices.Collect(b.Ancestors())
+	slices.Reverse(pathA)
+	slices.Reverse(pathB)
+	pathA, pathB = append(pathA, a), append(pathB, b)
+
+	if pathA[0] != pathB[0] {
+		// NOTE: nodes in different trees, ordered as by SortDocumentOrder
+		if a.Loc.Pos != b.Loc.Pos {
+			return cmp.Compare(a.Loc.Pos, b.Loc.Pos)
+		}
+		return cmp.Compare(b.EndLoc.Pos, a.EndLoc.Pos)
+	}
+
+	i := 1
+	for i < len(pathA) && i < len(pathB) && pathA[i] == pathB[i] {
+		i++
+	}
+	if i == len(pathA) {
+		// a is an ancestor of b
+		return -1
+	} else if i == len(pathB) {
+		return 1
+	}
+	return cmp.Compare(pathA[i].Index(), pathB[i].Index())
+}
+
 // TODO: func (node *Node) TextExclude(tags []Tag) string
 // text that excludes tags (e.g. <script>)
 
 // TODO: func (node *Node) TextFormatted() string
 // expand text elements (e.g. <br>)
-
-// TODO: func (node *Node) Dump() []byte
-// recursively dump node contents as HTML
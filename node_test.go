@@ -0,0 +1,473 @@
+package gohtml
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLazyAttrsAndChildren(t *testing.T) {
+	doc, err, _ := Parse([]byte("<p><br><span></span></p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	br := doc.Children[0].Children[0]
+	span := doc.Children[0].Children[1]
+
+	if _, ok := br.Attr("id"); ok || br.Attrs["id"] != "" {
+		t.Errorf("<br> has an id")
+	}
+	br.SetAttr("id", "x")
+	if val, ok := br.Attr("id"); !ok || val != "x" {
+		t.Errorf("Attr(%q) = %q, %v after SetAttr", "id", val, ok)
+	}
+	span.SetBoolAttr("hidden")
+	span.RemoveAttr("missing")
+	span.Children = append(span.Children, &Node{Kind: TextNode, Content: "y", Parent: span})
+
+	if got, want := string(doc.RenderBytes()), `<p><br id="x"><span hidden>y</span></p>`; got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+
+	empty := EmptyNode()
+	if empty.Attrs != nil || empty.RawAttrs != nil || empty.AttrLocs != nil || empty.Children != nil {
+		t.Errorf("EmptyNode() allocated attributes or children: %#v", empty)
+	}
+	empty.SetAttr("id", "x")
+	if val, ok := empty.Attr("id"); !ok || val != "x" {
+		t.Errorf("Attr(%q) = %q, %v after SetAttr on EmptyNode()", "id", val, ok)
+	}
+}
+
+func TestTextCDATA(t *testing.T) {
+	doc, err, _ := Parse([]byte("<svg><text>a<![CDATA[<b>]]>c<tspan>d</tspan></text></svg>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := doc.Children[0].Children[0]
+
+	if got, want := text.Text(), "a<b>cd"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+	if got, want := text.TextSep("|"), "a|<b>|c|d"; got != want {
+		t.Errorf("TextSep() = %q, want %q", got, want)
+	}
+	if got, want := text.OwnText(), "a<b>c"; got != want {
+		t.Errorf("OwnText() = %q, want %q", got, want)
+	}
+}
+
+func TestSortDocumentOrder(t *testing.T) {
+	doc, err, _ := Parse([]byte("<div><p>a<b>b</b></p><!--c--><ul><li>d<li>e</ul></div>f"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := slices.Collect(doc.Descendants())
+
+	nodes := slices.Clone(want)
+	rand.New(rand.NewPCG(1, 2)).Shuffle(len(nodes), func(i, j int) {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	})
+	SortDocumentOrder(nodes)
+	if !slices.Equal(nodes, want) {
+		t.Errorf("SortDocumentOrder didn't restore document order")
+	}
+}
+
+func TestTextSepAndOwnText(t *testing.T) {
+	doc, err, _ := Parse([]byte("<table><tr><td>a</td><td>b</td></tr></table><p>x<b>y</b>z</p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := doc.Find("table").TextSep(" "), "a b"; got != want {
+		t.Errorf("TextSep = %q, want %q", got, want)
+	}
+	if got, want := doc.Find("table").Text(), "ab"; got != want {
+		t.Errorf("Text = %q, want %q", got, want)
+	}
+	if got, want := doc.Find("p").OwnText(), "xz"; got != want {
+		t.Errorf("OwnText = %q, want %q", got, want)
+	}
+}
+
+func TestVisibleText(t *testing.T) {
+	src := `<html><head><title>t</title></head><body>a<script>s()</script><style>p{}</style>` +
+		`<template>u</template><p hidden>h</p><p aria-hidden="true">h</p><p style="color: red; display : none">h</p>` +
+		`<p style="display:block">b</p><div aria-hidden="false">c</div></body></html>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := doc.VisibleText(), "abc"; got != want {
+		t.Errorf("VisibleText = %q, want %q", got, want)
+	}
+}
+
+func TestAttrKeys(t *testing.T) {
+	src := `<p z=1 a=2 m=3 a=4 id=5>x</p>`
+	doc, err, warns := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	p := doc.Find("p")
+
+	if got, want := p.AttrKeys(), []string{"z", "a", "m", "id"}; !slices.Equal(got, want) {
+		t.Errorf("AttrKeys = %q, want %q", got, want)
+	}
+	if got, _ := p.Attr("a"); got != "2" || !slices.ContainsFunc(warns, func(err error) bool { return errors.Is(err, AttrKeyErr) }) {
+		t.Errorf("repeated key: Attr(%q) = %q with warnings %v, want %q and an %v", "a", got, warns, "2", AttrKeyErr)
+	}
+	for range 10 {
+		if got, want := string(doc.RenderBytes()), `<p z=1 a=2 m=3 id=5>x</p>`; got != want {
+			t.Fatalf("RenderBytes = %q, want %q", got, want)
+		}
+	}
+
+	p.SetAttr("b", "6")
+	p.Attrs["y"] = "7"
+	p.Attrs["c"] = "8"
+	p.RemoveAttr("m")
+	if got, want := p.AttrKeys(), []string{"z", "a", "id", "b", "c", "y"}; !slices.Equal(got, want) {
+		t.Errorf("modified AttrKeys = %q, want %q", got, want)
+	}
+}
+
+func TestBoolAttr(t *testing.T) {
+	src := `<input a b="" c=''><input>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	input := doc.Find("input")
+
+	tests := []struct {
+		key     string
+		isBool  bool
+		present bool
+	}{
+		{"a", true, true},
+		{"b", false, true},
+		{"c", false, true},
+		{"d", false, false},
+	}
+	for _, test := range tests {
+		val, ok := input.Attr(test.key)
+		if got := input.BoolAttr(test.key); got != test.isBool || ok != test.present || val != "" {
+			t.Errorf("%q: BoolAttr = %v, Attr = %q, %v, want %v, %q, %v", test.key, got, val, ok, test.isBool, "", test.present)
+		}
+	}
+	if got := string(doc.RenderBytes()); got != src {
+		t.Errorf("RenderBytes = %q, want %q", got, src)
+	}
+
+	input.SetAttr("a", "x")
+	input.SetBoolAttr("b")
+	bare := doc.Children[1]
+	bare.SetBoolAttr("required")
+	bare.SetAttr("value", "")
+	if got, want := string(doc.RenderBytes()), `<input a="x" b c=''><input required value="">`; got != want {
+		t.Errorf("modified RenderBytes = %q, want %q", got, want)
+	}
+	if input.BoolAttr("a") || !input.BoolAttr("b") || !bare.BoolAttr("required") || bare.BoolAttr("value") {
+		t.Error("BoolAttr after modification doesn't match how the attributes were set")
+	}
+}
+
+func TestFindFunc(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div><p data-x="1">a</p><!--note--><p>b<span data-y="2">c</span></p></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hasData := func(node *Node) bool {
+		for _, key := range node.AttrKeys() {
+			if strings.HasPrefix(key, "data-") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if got := doc.FindFunc(hasData); got.Content != "p" || got.Attrs["data-x"] != "1" {
+		t.Errorf("FindFunc(data-*) = %q %v, want p with data-x", got.Content, got.Attrs)
+	}
+	if got := doc.FindFunc(func(node *Node) bool { return node.Kind == CommentNode }); got.Content != "note" {
+		t.Errorf("FindFunc(comment) = %q, want %q", got.Content, "note")
+	}
+	if got := doc.FindFunc(func(node *Node) bool { return false }); got == nil || got.Kind != InvalidNode {
+		t.Errorf("FindFunc(none) = %v, want empty node", got)
+	}
+
+	matches := doc.FindAllFunc(hasData, false)
+	if len(matches) != 2 || matches[0].Content != "p" || matches[1].Content != "span" {
+		t.Errorf("FindAllFunc(data-*) = %d matches, want p and span", len(matches))
+	}
+	isElement := func(node *Node) bool { return node.Kind == ElementNode }
+	if got := len(doc.FindAllFunc(isElement, false)); got != 4 {
+		t.Errorf("FindAllFunc(element) = %d matches, want 4", got)
+	}
+	if got := doc.FindAllFunc(isElement, true); len(got) != 1 || got[0].Content != "div" {
+		t.Errorf("FindAllFunc(element, prune) = %d matches, want div", len(got))
+	}
+	text := doc.FindAllFunc(func(node *Node) bool { return node.Kind == TextNode }, true)
+	if got := childTexts(&Node{Children: text}); !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Errorf("FindAllFunc(text) = %q, want %q", got, []string{"a", "b", "c"})
+	}
+	if got := doc.FindAllFunc(func(node *Node) bool { return false }, false); got == nil || len(got) != 0 {
+		t.Errorf("FindAllFunc(none) = %v, want empty slice", got)
+	}
+}
+
+func TestFindByID(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div id="main"><p id="x">first</p><section><p id="x">second</p></section></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{"main", "div"},
+		{"x", "first"},
+		{"X", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		got := doc.FindByID(test.id)
+		if got == nil {
+			t.Fatalf("FindByID(%q) = nil", test.id)
+		}
+		switch test.want {
+		case "":
+			if got.Kind != InvalidNode {
+				t.Errorf("FindByID(%q) = %q, want empty node", test.id, got.Content)
+			}
+		case "div":
+			if got.Content != "div" {
+				t.Errorf("FindByID(%q) = %q, want div", test.id, got.Content)
+			}
+		default:
+			if got.Content != "p" || got.Text() != test.want {
+				t.Errorf("FindByID(%q) = %q, want p with %q", test.id, got.Text(), test.want)
+			}
+		}
+	}
+}
+
+func TestHasClass(t *testing.T) {
+	tests := []struct {
+		class string
+		name  string
+		want  bool
+	}{
+		{"a", "a", true},
+		{"a b c", "b", true},
+		{"\ta\n b\f", "b", true},
+		{"ab", "a", false},
+		{"a-b", "a", false},
+		{"A", "a", false},
+		{"", "", false},
+		{"a  b", "", false},
+	}
+
+	for _, test := range tests {
+		node := &Node{Kind: ElementNode, Content: "p"}
+		node.SetAttr("class", test.class)
+		if got := node.HasClass(test.name); got != test.want {
+			t.Errorf("HasClass(%q) with class %q = %v, want %v", test.name, test.class, got, test.want)
+		}
+	}
+
+	text := &Node{Kind: TextNode, Attrs: map[string]string{"class": "a"}}
+	if text.HasClass("a") {
+		t.Error("HasClass on a text node = true")
+	}
+}
+
+func TestFindByClass(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div class="card wide"><p class="title">a</p><div class="card"><p class="x title">b</p></div></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := doc.FindByClass("title"); got.Text() != "a" {
+		t.Errorf("FindByClass(title) = %q, want %q", got.Text(), "a")
+	}
+	if got := doc.FindByClass("none"); got == nil || got.Kind != InvalidNode {
+		t.Errorf("FindByClass(none) = %v, want empty node", got)
+	}
+	if got := doc.FindAllByClass("title", false); len(got) != 2 || got[1].Text() != "b" {
+		t.Errorf("FindAllByClass(title) = %d matches, want 2", len(got))
+	}
+	if got := len(doc.FindAllByClass("card", false)); got != 2 {
+		t.Errorf("FindAllByClass(card) = %d matches, want 2", got)
+	}
+	if got := len(doc.FindAllByClass("card", true)); got != 1 {
+		t.Errorf("FindAllByClass(card, prune) = %d matches, want 1", got)
+	}
+	if got := doc.FindAllByClass("none", false); got == nil || len(got) != 0 {
+		t.Errorf("FindAllByClass(none) = %v, want empty slice", got)
+	}
+}
+
+func TestFindByAttr(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div data-testid="root"><p data-testid="">a</p><!--data-testid--><span data-testid="x">b<i data-testid="x">c</i></span><svg viewBox="0 0 1 1"></svg></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := doc.FindByAttr("data-testid"); got.Content != "div" {
+		t.Errorf("FindByAttr(data-testid) = %q, want div", got.Content)
+	}
+	if got := len(doc.FindAllByAttr("data-testid", false)); got != 4 {
+		t.Errorf("FindAllByAttr(data-testid) = %d matches, want 4", got)
+	}
+	if got := len(doc.FindAllByAttr("data-testid", true)); got != 1 {
+		t.Errorf("FindAllByAttr(data-testid, prune) = %d matches, want 1", got)
+	}
+	if got := doc.FindByAttr("viewbox"); got.Content != "svg" {
+		t.Errorf("FindByAttr(viewbox) = %q, want svg", got.Content)
+	}
+
+	if got := doc.FindByAttrValue("data-testid", ""); got.Content != "p" {
+		t.Errorf("FindByAttrValue(data-testid, \"\") = %q, want p", got.Content)
+	}
+	if got := doc.FindAllByAttrValue("data-testid", "x", false); len(got) != 2 || got[0].Content != "span" || got[1].Content != "i" {
+		t.Errorf("FindAllByAttrValue(data-testid, x) = %d matches, want span and i", len(got))
+	}
+	if got := len(doc.FindAllByAttrValue("data-testid", "x", true)); got != 1 {
+		t.Errorf("FindAllByAttrValue(data-testid, x, prune) = %d matches, want 1", got)
+	}
+
+	if got := doc.FindByAttr("missing"); got == nil || got.Kind != InvalidNode {
+		t.Errorf("FindByAttr(missing) = %v, want empty node", got)
+	}
+	if got := doc.FindByAttrValue("data-testid", "y"); got == nil || got.Kind != InvalidNode {
+		t.Errorf("FindByAttrValue(data-testid, y) = %v, want empty node", got)
+	}
+	if got := doc.FindAllByAttr("missing", false); got == nil || len(got) != 0 {
+		t.Errorf("FindAllByAttr(missing) = %v, want empty slice", got)
+	}
+	if got := doc.FindAllByAttrValue("data-testid", "y", false); got == nil || len(got) != 0 {
+		t.Errorf("FindAllByAttrValue(data-testid, y) = %v, want empty slice", got)
+	}
+}
+
+func TestFindAllText(t *testing.T) {
+	src := []byte("<div><p>Price: $5</p><p>Name</p><span>price:<b>Price: &#36;7</b></span></div>")
+	re := regexp.MustCompile(`^Price: \$\d+$`)
+
+	for _, opts := range [][]Option{nil, {WithDeferredEntities()}} {
+		doc, err, _ := ParseWithOptions(src, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches := doc.FindAllText(re)
+		if len(matches) != 2 {
+			t.Fatalf("FindAllText(%v) = %d matches, want 2", re, len(matches))
+		}
+		for i, want := range []string{"p", "b"} {
+			if matches[i].Kind != TextNode || matches[i].Parent.Content != want {
+				t.Errorf("FindAllText(%v)[%d] in %q, want text in %q", re, i, matches[i].Parent.Content, want)
+			}
+		}
+		if got := doc.FindAllText(regexp.MustCompile("missing")); got == nil || len(got) != 0 {
+			t.Errorf("FindAllText(missing) = %v, want empty slice", got)
+		}
+	}
+}
+
+func TestFindAllN(t *testing.T) {
+	doc, err, _ := Parse([]byte("<ul><li>1<ul><li>1a</li></ul></li><li>2</li><li>3</li></ul>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		n     int
+		prune bool
+		want  int
+	}{
+		{-1, false, 4},
+		{-1, true, 3},
+		{0, false, 0},
+		{2, false, 2},
+		{2, true, 2},
+		{10, false, 4},
+	}
+	for _, test := range tests {
+		got := doc.FindAllN("li", test.n, test.prune)
+		if got == nil || len(got) != test.want {
+			t.Errorf("FindAllN(li, %d, %v) = %d matches, want %d", test.n, test.prune, len(got), test.want)
+			continue
+		}
+		if all := doc.FindAll("li", test.prune); !slices.Equal(got, all[:len(got)]) {
+			t.Errorf("FindAllN(li, %d, %v) isn't a prefix of FindAll", test.n, test.prune)
+		}
+	}
+
+	if got := doc.FindAllN("table", 3, false); got == nil || len(got) != 0 {
+		t.Errorf("FindAllN(table, 3) = %v, want empty slice", got)
+	}
+}
+
+func TestContains(t *testing.T) {
+	doc, err, _ := Parse([]byte("<div><p>a<b>b</b></p></div><p>c</p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	div, b, p := doc.Find("div"), doc.Find("b"), doc.Children[1]
+
+	tests := []struct {
+		node, other *Node
+		want        bool
+	}{
+		{doc, b, true},
+		{div, b, true},
+		{b, b, true},
+		{b, div, false},
+		{p, b, false},
+		{div, p, false},
+		{div, &Node{}, false},
+		{div, nil, false},
+	}
+	for _, test := range tests {
+		if got := test.node.Contains(test.other); got != test.want {
+			t.Errorf("%q.Contains(%p) = %v, want %v", test.node.Content, test.other, got, test.want)
+		}
+	}
+}
+
+func TestCompareDocumentOrder(t *testing.T) {
+	doc, err, _ := Parse([]byte("<div><p>a<b>b</b></p><!--c--><ul><li>d<li>e</ul></div>f"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes := append([]*Node{doc}, slices.Collect(doc.Descendants())...)
+
+	for i, a := range nodes {
+		for j, b := range nodes {
+			if got, want := CompareDocumentOrder(a, b), cmp.Compare(i, j); got != want {
+				t.Errorf("CompareDocumentOrder(%q, %q) = %d, want %d", a.Content, b.Content, got, want)
+			}
+		}
+	}
+
+	// order follows the tree as modified, not the recorded locations
+	div, ul, p := doc.Find("div"), doc.Find("ul"), doc.Find("p")
+	slices.Reverse(div.Children)
+	if got := CompareDocumentOrder(ul.Children[0], p); got != -1 {
+		t.Errorf("CompareDocumentOrder(li, p) after reordering = %d, want -1", got)
+	}
+
+	// nodes in different trees are ordered by location
+	other, _, _ := Parse([]byte("<i>x</i><s>y</s>"))
+	if got := CompareDocumentOrder(other.Find("s"), p); got != 1 {
+		t.Errorf("CompareDocumentOrder across trees = %d, want 1", got)
+	}
+}
@@ -0,0 +1,249 @@
+package gohtml
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Minimum number of tokens in a document for parsing it in parallel to be
+// worthwhile.
+const minParallelTokens = 1 << 14
+
+// Find the indexes of the tokens delimiting the direct children of <body>, by
+// tracking open elements the same way parse does.  Returns the index of the
+// first token after each child (starting with the index of the first child),
+// or nil if the document has no suitable <body>, e.g. if a child of <body> is
+// left open until <body> is closed.
+func bodyChildBounds(tokens []token, opts *ParseOptions) []int {
+	type openTag struct {
+		name string
+		ns   Namespace
+	}
+	tags := make(stack[openTag], 0, 16)
+	open := newOpenIndex()
+	push := func(tag openTag) {
+		open.push(tag.name, len(tags))
+		tags.Push(tag)
+	}
+	pop := func() {
+		if tag, ok := tags.Pop(); ok {
+			open.pop(tag.name, len(tags))
+		}
+	}
+	bodyLevel := -1
+	var bounds []int
+
+	for i, tok := range tokens {
+		switch tok.Kind {
+		case tagOpenToken, tagSelfcloseToken:
+			name := extractTagName(tok)
+			if name == "" {
+				return nil
+			}
+			if top, _ := tags.Peek(); name == "table" && tableChildren[top.name] != nil && open.top("table") >= 0 {
+				// a table opened within a table element closes the table
+				for n := tags.Len() - open.top("table"); n > 0; n-- {
+					pop()
+				}
+			}
+			for tags.Len() > 0 {
+				if top, _ := tags.Peek(); !opts.impliedEnd(name, top.name) {
+					break
+				}
+				// NOTE: no bound here, since the chunk would end with the
+				// element still open
+				pop()
+			}
+			parent, _ := tags.Peek()
+			ns := childNamespace(parent.ns, parent.name, name)
+			selfclosing := tok.Kind == tagSelfcloseToken && (ns != HTMLNamespace || opts.SelfClosing == XMLSelfClosing)
+			if (ns != HTMLNamespace || !opts.voidTags()[name]) && !selfclosing {
+				push(openTag{name, ns})
+			}
+			if name == "body" && bodyLevel < 0 {
+				bodyLevel = tags.Len()
+				bounds = append(bounds, i+1)
+				continue
+			}
+		case tagCloseToken:
+			name, _ := splitCloseTag(tok.Data)
+			if len(name) == 0 {
+				return nil
+			}
+			nameAt := func(i int) string {
+				return tags[len(tags)-1-i].name
+			}
+			n := opts.closeCount(string(name), tags.Len(), nameAt)
+			if n == 0 {
+				n = open.searchCloseCount(string(name), tags.Len(), tags.Len())
+			}
+			for ; n > 0; n-- {
+				pop()
+			}
+		case conditionalStartToken:
+			// NOTE: an unnamed entry, so that closing tags within the
+			// conditional comment can't close elements outside it
+			parent, _ := tags.Peek()
+			push(openTag{"", parent.ns})
+		case conditionalStopToken:
+			n := 0
+			for i := len(tags) - 1; i >= 0; i-- {
+				if tags[i].name == "" {
+					n = len(tags) - i
+					break
+				}
+			}
+			for ; n > 0; n-- {
+				pop()
+			}
+		case eofToken:
+			return nil
+		}
+
+		if bodyLevel < 0 {
+			continue
+		} else if tags.Len() < bodyLevel {
+			// <body> closed; if a child was still open, its end (and the
+			// rest of the children) can't be split off
+			if bounds[len(bounds)-1] != i {
+				return nil
+			}
+			return bounds
+		} else if tags.Len() == bodyLevel {
+			bounds = append(bounds, i+1)
+		}
+	}
+
+	return nil
+}
+
+// Parse tokens with the direct children of <body> split into chunks that are
+// parsed concurrently by up to opts.Workers goroutines, falling back to
+// parsing sequentially if the document can't be split.
+func parseParallel(tokens []token, opts *ParseOptions) (docNode *Node, err error, warns []error) {
+	bounds := bodyChildBounds(tokens, opts)
+	if len(bounds) < 2 {
+		return parse(tokens, opts)
+	}
+
+	// split children into chunks of roughly equal numbers of tokens
+	first, last := bounds[0], bounds[len(bounds)-1]
+	chunkSize := (last - first + opts.Workers - 1) / opts.Workers
+	chunks := make([][]token, 0, opts.Workers)
+	start := first
+	for _, bound := range bounds[1:] {
+		if bound-start >= chunkSize || bound == last {
+			chunks = append(chunks, tokens[start:bound])
+			start = bound
+		}
+	}
+
+	type result struct {
+		node  *Node
+		err   error
+		warns []error
+	}
+	results := make([]result, len(chunks))
+
+	// NOTE: chunks can't share a Parser's buffers or an arena with each other
+	chunkOpts := *opts
+	chunkOpts.scratch = nil
+	chunkOpts.arena = nil
+
+	wg := sync.WaitGroup{}
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk []token) {
+			defer wg.Done()
+			node, err, warns := parse(chunk, &chunkOpts)
+			results[i] = result{node, err, warns}
+		}(i, chunk)
+	}
+
+	// parse the rest of the document while the chunks are parsed
+	skeleton := make([]token, 0, first+len(tokens)-last)
+	skeleton = append(skeleton, tokens[:first]...)
+	skeleton = append(skeleton, tokens[last:]...)
+	docNode, err, warns = parse(skeleton, opts)
+
+	wg.Wait()
+
+	if err != nil {
+		return
+	}
+
+	// stitch the chunks' nodes into <body>
+	body := docNode.FindTag(Tag{Name: "body"})
+	if body.Loc.Pos != tokens[first-1].Loc.Pos {
+		// a <body> at a different location isn't from the same token
+		return parse(tokens, opts)
+	}
+	for _, res := range results {
+		warns = append(warns, res.warns...)
+		if res.err != nil {
+			return docNode, res.err, warns
+		}
+		for _, child := range res.node.Children {
+			child.Parent = body
+		}
+		body.Children = append(body.Children, res.node.Children...)
+	}
+
+	return
+}
+
+// Result of parsing one of the documents passed to ParseAll, with the same
+// values as Parse returns.
+type ParseResult struct {
+	Node  *Node
+	Err   error
+	Warns []error
+}
+
+// Parse many documents with options applied, concurrently by up to workers
+// goroutines (or runtime.GOMAXPROCS(0) if workers is not positive).  Returns
+// a result for each document in the order given, along with the warnings of
+// all documents, each wrapped with the index of its document.
+func ParseAll(docs [][]byte, workers int, opts ...Option) (results []ParseResult, warns []error) {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.ParseAll(docs, workers)
+}
+
+// Parse many documents according to the options; see ParseAll.
+func (opts ParseOptions) ParseAll(docs [][]byte, workers int) (results []ParseResult, warns []error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(docs))
+
+	results = make([]ParseResult, len(docs))
+	next := atomic.Int64{}
+
+	wg := sync.WaitGroup{}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// NOTE: one Parser per goroutine, to reuse its buffers
+			p := Parser{Options: opts}
+			for i := int(next.Add(1) - 1); i < len(docs); i = int(next.Add(1) - 1) {
+				node, err, warns := p.Parse(docs[i])
+				results[i] = ParseResult{node, err, warns}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, res := range results {
+		for _, warn := range res.Warns {
+			warns = append(warns, fmt.Errorf("document %d: %w", i, warn))
+		}
+	}
+
+	return results, warns
+}
@@ -0,0 +1,117 @@
+package gohtml
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// Check that every node's children point back to it.
+func checkParents(t *testing.T, node *Node) {
+	t.Helper()
+	for _, child := range node.Children {
+		if child.Parent != node {
+			t.Fatalf("child %q of %q has parent %p, want %p", child.Content, node.Content, child.Parent, node)
+		}
+		checkParents(t, child)
+	}
+}
+
+func TestParseParallel(t *testing.T) {
+	parts := []string{
+		"<p>para %d<p>implied end",
+		"<ul><li>a %d<li>b</ul>",
+		"<table>foster %d<tr><td>x<td>y</table>",
+		"<div><span>%d</div>",
+		"<!--[if IE]><b>%d</b><![endif]-->",
+		"<svg><circle r=%d /></svg>",
+		"text %d &amp; more ",
+	}
+	buf := strings.Builder{}
+	buf.WriteString("<!DOCTYPE html><html><head><title>t</title></head><body>")
+	for i := 0; i < 8000; i++ {
+		fmt.Fprintf(&buf, parts[i%len(parts)], i)
+	}
+	buf.WriteString("</body></html>\n")
+	src := []byte(buf.String())
+
+	want, err, _ := Parse(src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	got, err, _ := ParseWithOptions(src, WithWorkers(4))
+	if err != nil {
+		t.Fatalf("Parse with workers: %v", err)
+	}
+	if !bytes.Equal(got.RenderBytes(), want.RenderBytes()) {
+		t.Error("Parse with workers rendered differently from Parse")
+	}
+	checkParents(t, got)
+
+	// a child of <body> left open holds the rest of the children
+	divs := func(from, to int) string {
+		buf := strings.Builder{}
+		for i := from; i < to; i++ {
+			fmt.Fprintf(&buf, "<div>%d</div>", i)
+		}
+		return buf.String()
+	}
+	for _, open := range []string{"<b>open", "<svg><p>y"} {
+		src := []byte("<html><body>" + divs(0, 3001) + open + divs(3001, 6000) + "</body></html>")
+		want, _, _ := Parse(src)
+		got, err, _ := ParseWithOptions(src, WithWorkers(4))
+		if err != nil {
+			t.Fatalf("Parse with %q left open, with workers: %v", open, err)
+		}
+		if !bytes.Equal(got.RenderBytes(), want.RenderBytes()) {
+			t.Errorf("Parse with %q left open, with workers, rendered differently from Parse", open)
+		}
+		checkParents(t, got)
+	}
+
+	for _, src := range []string{"<p>no body", "<body><p>a</p></body>"} {
+		got, err, _ := ParseWithOptions([]byte(src), WithWorkers(4))
+		want, _, _ := Parse([]byte(src))
+		if err != nil || !bytes.Equal(got.RenderBytes(), want.RenderBytes()) {
+			t.Errorf("Parse(%q) with workers = %q, %v, want %q", src, got.RenderBytes(), err, want.RenderBytes())
+		}
+	}
+}
+
+func TestParseAll(t *testing.T) {
+	docs := make([][]byte, 50)
+	for i := range docs {
+		docs[i] = []byte(fmt.Sprintf("<div><p>doc %d</p>%s</div>", i, strings.Repeat("<b>x</b>", i)))
+	}
+	docs[7] = []byte("<div><b>a</i></b></div>")
+
+	for _, workers := range []int{0, 1, 4, 100} {
+		results, warns := ParseAll(docs, workers)
+		if len(results) != len(docs) {
+			t.Fatalf("ParseAll with %d workers = %d results, want %d", workers, len(results), len(docs))
+		}
+		for i, res := range results {
+			want, wantErr, wantWarns := Parse(docs[i])
+			if res.Err != wantErr || len(res.Warns) != len(wantWarns) {
+				t.Errorf("ParseAll with %d workers: document %d = %v, %d warnings, want %v, %d", workers, i, res.Err, len(res.Warns), wantErr, len(wantWarns))
+			}
+			if !bytes.Equal(res.Node.RenderBytes(), want.RenderBytes()) {
+				t.Errorf("ParseAll with %d workers: document %d = %q, want %q", workers, i, res.Node.RenderBytes(), want.RenderBytes())
+			}
+		}
+		if len(warns) != len(results[7].Warns) || len(warns) == 0 {
+			t.Fatalf("ParseAll with %d workers warnings = %v, want those of document 7", workers, warns)
+		}
+		for _, warn := range warns {
+			if !strings.HasPrefix(warn.Error(), "document 7: ") || !errors.Is(warn, TagMismatchErr) {
+				t.Errorf("ParseAll with %d workers warning = %v, want document 7 %v", workers, warn, TagMismatchErr)
+			}
+		}
+	}
+
+	if results, warns := ParseAll(nil, 4); len(results) != 0 || len(warns) != 0 {
+		t.Errorf("ParseAll(nil) = %d results, %d warnings", len(results), len(warns))
+	}
+}
@@ -3,146 +3,257 @@ package gohtml
 import (
 	"bytes"
 	"fmt"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 )
 
-var unpairedTags = map[string]bool{
-	"area":     true,
-	"base":     true,
-	"br":       true,
-	"colgroup": true,
-	"col":      true,
-	"embed":    true,
-	"hr":       true,
-	"image":    true,
-	"img":      true,
-	"input":    true,
-	"link":     true,
-	"meta":     true,
-	"param":    true,
-	"source":   true,
-	"track":    true,
-	"wbr":      true,
+// Void elements, which have no closing tag or children (e.g. <br>), for
+// ParseOptions.VoidTags.
+var DefaultVoidTags = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
 }
 
 var (
 	equals = []byte("=")
-	amp    = []byte("&")
+	nul    = []byte{0}
 )
 
-func parseEntity(data []byte) (exp string, entityLen int, err error) {
-	const longestEntity = 33      // &CounterClockwiseContourIntegral;
-	const shortestEntity = 4      // &gt;
-	const longestNoscEntity = 7   // &aacute
-	const shortestNoscEntity = 33 // &gt
+// Replacements for numeric character references to C1 control characters,
+// which browsers interpret as windows-1252.
+var c1Replacements = map[int64]rune{
+	0x80: '\u20ac', 0x82: '\u201a', 0x83: '\u0192', 0x84: '\u201e',
+	0x85: '\u2026', 0x86: '\u2020', 0x87: '\u2021', 0x88: '\u02c6',
+	0x89: '\u2030', 0x8a: '\u0160', 0x8b: '\u2039', 0x8c: '\u0152',
+	0x8e: '\u017d', 0x91: '\u2018', 0x92: '\u2019', 0x93: '\u201c',
+	0x94: '\u201d', 0x95: '\u2022', 0x96: '\u2013', 0x97: '\u2014',
+	0x98: '\u02dc', 0x99: '\u2122', 0x9a: '\u0161', 0x9b: '\u203a',
+	0x9c: '\u0153', 0x9e: '\u017e', 0x9f: '\u0178',
+}
+
+// Whether c is an ASCII letter or digit.
+func isAlnum(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// Whether c is a digit in base 10 or 16.
+func isDigit(c byte, base int) bool {
+	if '0' <= c && c <= '9' {
+		return true
+	}
+	c |= 0x20 // lowercase
+	return base == 16 && 'a' <= c && c <= 'f'
+}
+
+// Named character reference, e.g. "gt;" for "&gt;", and its expansion.
+type namedEntity struct {
+	Name string // Name, without the leading '&'
+	Exp  string // Expansion
+}
 
+// Longest named entity at the start of data, which follows an '&' (e.g. "gt;"
+// in "gt;x", or "not" in "notit;"), found without allocating by narrowing
+// down the range of entityTable sharing ever longer prefixes of data.
+// Returns its expansion and length, and whether there is one.
+func lookupEntity(data []byte) (exp string, n int, ok bool) {
+	lo, hi := 0, len(entityTable)
+	for i, c := range data {
+		// entries in [lo, hi) all start with data[:i]; those that are
+		// exactly data[:i] come first
+		lo += sort.Search(hi-lo, func(j int) bool {
+			name := entityTable[lo+j].Name
+			return len(name) > i && name[i] >= c
+		})
+		hi = lo + sort.Search(hi-lo, func(j int) bool {
+			name := entityTable[lo+j].Name
+			return len(name) > i && name[i] > c
+		})
+		if lo == hi {
+			break
+		} else if len(entityTable[lo].Name) == i+1 {
+			exp, n, ok = entityTable[lo].Exp, i+1, true
+		}
+	}
+	return exp, n, ok
+}
+
+// Parse the character reference at the start of data, which starts with '&'.
+// Returns its expansion and length, or data[:1] and 1 if there is no valid
+// reference.  Within attribute values, legacy references without a
+// terminating semicolon (e.g. "&amp") are left as is if followed by a letter,
+// digit, or '=', as in browsers.
+func parseEntity(data []byte, inAttr bool) (exp string, entityLen int, err error) {
 	// empty or insufficient data; i.e. data == "" || data == "&"
 	if len(data) < 2 {
-		err = fmt.Errorf("%w: no data", EntityErr)
-		return string(data), len(data), err
+		return string(data), len(data), nil
 	}
 
-	entityLen = bytes.IndexByte(data, ';') + 1
+	if data[1] == '#' {
+		return parseNumericEntity(data)
+	}
 
-	if entityLen == 2 {
-		// empty entity, data[:2] == "&;"
+	// name, e.g. data == "&gt;"
+	nameLen := 1
+	for nameLen < len(data) && isAlnum(data[nameLen]) {
+		nameLen++
+	}
+	if nameLen == 1 && data[1] == ';' {
 		err = fmt.Errorf("%w: empty entity", EntityErr)
+		return string(data[:2]), 2, err
+	}
 
-	} else if entityLen <= 0 || entityLen > longestEntity {
-		// no semicolon, data == "&...
-		// NOTE: entities without semicolon terminators are invalid, but are
-		// explicitly named in the HTML spec and browsers tend to support them.
-		// see: <https://html.spec.whatwg.org/multipage/named-characters.html#named-character-references>
-		for entityLen = shortestNoscEntity; entityLen <= longestNoscEntity; entityLen++ {
-			var ok bool
-			exp, ok = entityMap[string(data[:entityLen])]
-			if ok {
-				err = fmt.Errorf("%w: no terminating semicolon", EntityErr)
-				return
-			}
+	// NOTE: entities without semicolon terminators are invalid, but are
+	// explicitly named in the HTML spec and browsers tend to support them.
+	// see: <https://html.spec.whatwg.org/multipage/named-characters.html#named-character-references>
+	if exp, n, ok := lookupEntity(data[1 : nameLen+min(1, len(data)-nameLen)]); ok {
+		entityLen = n + 1
+		if data[n] == ';' {
+			return exp, entityLen, nil
+		} else if !inAttr || entityLen >= len(data) || !isAlnum(data[entityLen]) && data[entityLen] != '=' {
+			err = fmt.Errorf("%w: no terminating semicolon", EntityErr)
+			return exp, entityLen, err
 		}
+	}
+
+	if nameLen > 1 && nameLen < len(data) && data[nameLen] == ';' {
 		err = fmt.Errorf("%w: no matching entity", EntityErr)
-		entityLen = 1
-
-	} else if data[1] != '#' {
-		// ordinary entity, e.g. data == "&gt;"
-		var ok bool
-		exp, ok = entityMap[string(data[:entityLen])]
-		if !ok {
-			err = fmt.Errorf("%w: no matching entity", EntityErr)
-		}
-
-		// } else if len(data) <= 2 {
-		// 	err = fmt.Errorf("%w: insufficient data for number entity", EntityErr)
-	} else if data[2] != 'x' {
-		// number entity, e.g. data == "&#35;"
-		var codepoint int
-		codepoint, err = strconv.Atoi(string(data[2 : entityLen-1]))
-		if err == nil {
-			exp = string(rune(codepoint))
-		} else {
-			err = fmt.Errorf("%w: %w", EntityErr, err)
-		}
+	}
+	return string(data[:1]), 1, err
+}
 
-	} else if len(data) <= 3 {
-		err = fmt.Errorf("%w: insufficient data for hex number entity", EntityErr)
-	} else if data[3] == 'x' {
-		// hex number entity, e.g. data == "&#x23;"
-		var codepoint int64
-		codepoint, err = strconv.ParseInt(string(data[3:entityLen-1]), 16, 0)
-		if err == nil {
-			exp = string(rune(codepoint))
-		} else {
-			err = fmt.Errorf("%w: %w", EntityErr, err)
-		}
+// Parse the numeric character reference at the start of data, which starts
+// with "&#".  Returns the same values as parseEntity.
+func parseNumericEntity(data []byte) (exp string, entityLen int, err error) {
+	base, start := 10, 2
+	if len(data) > 2 && (data[2] == 'x' || data[2] == 'X') {
+		base, start = 16, 3
 	}
 
-	if exp == "" {
-		exp = string(data[:entityLen])
+	end := start
+	for end < len(data) && isDigit(data[end], base) {
+		end++
 	}
-	return
+	if end == start {
+		err = fmt.Errorf("%w: no digits in number entity", EntityErr)
+		return string(data[:1]), 1, err
+	}
+
+	entityLen = end
+	if end < len(data) && data[end] == ';' {
+		entityLen++
+	} else {
+		err = fmt.Errorf("%w: no terminating semicolon", EntityErr)
+	}
+
+	codepoint, parseErr := strconv.ParseInt(string(data[start:end]), base, 32)
+	switch {
+	case parseErr != nil || codepoint == 0 || codepoint > 0x10ffff || 0xd800 <= codepoint && codepoint <= 0xdfff:
+		err = fmt.Errorf("%w: invalid code point %q", EntityErr, data[start:end])
+		exp = "\ufffd"
+	case c1Replacements[codepoint] != 0:
+		exp = string(c1Replacements[codepoint])
+	default:
+		exp = string(rune(codepoint))
+	}
+	return exp, entityLen, err
 }
 
 // Expand entities in a data slice and return the expanded data and any entity
 // parse errors as warnings. 'loc' is needed to report warning locations.
-func expandEntitys(data []byte, loc Location) ([]byte, []error) {
+// inAttr is whether data is an attribute value; see parseEntity.
+func expandEntitys(data []byte, loc Location, inAttr bool) (string, []error) {
 	var warns []error
 
-	buf := bytes.Buffer{}
+	i := bytes.IndexByte(data, '&')
+	if i < 0 {
+		return string(data), warns
+	}
+
+	buf := entityBufs.Get().(*bytes.Buffer)
+	defer releaseEntityBuf(buf)
 	buf.Grow(len(data))
 
-	for {
-		loc.Pos = 0
-		loc := stepUntilPrefix(loc, data, amp)
-		buf.Write(data[:loc.Pos])
-		if loc.Pos >= len(data) {
-			break
-		}
+	// NOTE: step through data with positions relative to it
+	base := loc.Pos
+	loc.Pos = 0
+	pos := 0
+	for i >= 0 {
+		buf.Write(data[pos : pos+i])
+		pos += i
+		loc = stepTo(loc, data, pos)
 
-		data = data[loc.Pos:]
-		exp, entityLen, warn := parseEntity(data)
+		exp, entityLen, warn := parseEntity(data[pos:], inAttr)
 		if warn != nil {
-			warn = fmt.Errorf("%s: %w", loc, warn)
+			warnLoc := loc
+			warnLoc.Pos += base
+			warn = errorAt(warnLoc, "%w", warn)
 			warns = append(warns, warn)
 		}
 		buf.WriteString(exp)
-		data = data[entityLen:]
+		pos += entityLen
+
+		i = bytes.IndexByte(data[pos:], '&')
+	}
+	buf.Write(data[pos:])
+
+	return buf.String(), warns
+}
+
+// Expand entities in a string as expandEntitys does, returning s itself
+// without copying if it has no entities.
+func expandEntitysString(s string, loc Location, inAttr bool) (string, []error) {
+	if strings.IndexByte(s, '&') < 0 {
+		return s, nil
 	}
+	return expandEntitys([]byte(s), loc, inAttr)
+}
 
-	return buf.Bytes(), warns
+func parseComment(tok token, a *arena) (*Node, error) {
+	node := a.node()
+	*node = Node{Kind: CommentNode, Loc: tok.Loc, Content: a.string(tok.Data)}
+	return node, nil
 }
 
-func parseComment(tok token) (*Node, error) {
-	node := &Node{Kind: CommentNode, Loc: tok.Loc, Content: string(tok.Data)}
+// Parse a CDATA section opened within parent.  CDATA sections are only
+// recognized in foreign content; elsewhere they are bogus comments, as in
+// browsers.
+func parseCDATA(tok token, parent *Node, a *arena) (*Node, error) {
+	node := a.node()
+	if parent.Namespace == HTMLNamespace {
+		*node = Node{Kind: CommentNode, Loc: tok.Loc, Content: "[CDATA[" + string(tok.Data) + "]]"}
+		return node, nil
+	}
+	*node = Node{Kind: CDATANode, Loc: tok.Loc, Content: a.string(tok.Data)}
 	return node, nil
 }
 
-func parseDeclaration(tok token) (*Node, error) {
-	node := &Node{Kind: DeclarationNode, Loc: tok.Loc}
+func parseProcessingInstruction(tok token, a *arena) (*Node, error) {
+	node := a.node()
+	*node = Node{Kind: ProcessingInstructionNode, Loc: tok.Loc, Content: a.string(tok.Data)}
+	return node, nil
+}
+
+func parseDeclaration(tok token, a *arena) (*Node, error) {
+	node := a.node()
+	*node = Node{Kind: DeclarationNode, Loc: tok.Loc}
 
 	data := bytes.TrimSpace(tok.Data)
 	if len(data) == 0 {
-		err := fmt.Errorf("%s: error parsing declaration: %w", tok.Loc, EmptyContentErr)
+		err := errorAt(tok.Loc, "error parsing declaration: %w", EmptyContentErr)
 		return node, err
 	}
 
@@ -150,92 +261,165 @@ func parseDeclaration(tok token) (*Node, error) {
 	return node, nil
 }
 
-func parseText(tok token) (node *Node, err error, warns []error) {
-	node = &Node{Kind: TextNode, Loc: tok.Loc}
+// Parse text, expanding character references unless deferred.
+func parseText(tok token, deferEntities bool, a *arena) (node *Node, err error, warns []error) {
+	node = a.node()
+	*node = Node{Kind: TextNode, Loc: tok.Loc}
 
 	// NOTE: don't discard leading and trailing spaces that may have been
 	// intentionally added to the content
 	// data := bytes.TrimSpace(tok.Data)
 	if len(tok.Data) == 0 {
-		err = fmt.Errorf("%s: error parsing text: %w", tok.Loc, EmptyContentErr)
+		err = errorAt(tok.Loc, "error parsing text: %w", EmptyContentErr)
 		return node, err, warns
 	}
 
-	content, warns := expandEntitys(tok.Data, tok.Loc)
-	node.Content = string(content)
+	if deferEntities {
+		node.Content = a.string(tok.Data)
+		node.Unexpanded = true
+		return node, nil, warns
+	} else if bytes.IndexByte(tok.Data, '&') < 0 {
+		node.Content = a.string(tok.Data)
+		return node, nil, warns
+	}
+
+	node.Content, warns = expandEntitys(tok.Data, tok.Loc, false)
 	return node, nil, warns
 }
 
-func parseCloseTag(tok token) (*Node, error) {
-	node := &Node{Kind: InvalidNode, Loc: tok.Loc}
+// Handle NUL characters in a token, as browsers do: in text and other
+// character data, they are replaced with U+FFFD; in tags, parseOpenTag and
+// parseCloseTag drop them.  Returns the token, along with a warning if it
+// contained any.
+func replaceNULs(tok token) (token, error) {
+	if bytes.IndexByte(tok.Data, 0) < 0 {
+		return tok, nil
+	}
 
-	data := bytes.TrimSpace(tok.Data)
-	if len(data) == 0 {
-		err := fmt.Errorf("%s: error parsing closing tag: %w", tok.Loc, EmptyContentErr)
-		return node, err
+	warn := errorAt(tok.Loc, "error parsing document: %w: %s", NULErr, tok.Kind)
+	switch tok.Kind {
+	case tagOpenToken, tagSelfcloseToken, tagCloseToken:
+		return tok, warn
 	}
+	tok.Data = bytes.ReplaceAll(tok.Data, nul, replacementChar)
+	return tok, warn
+}
 
-	node.Content = string(data)
-	return node, nil
+var (
+	crlf = []byte("\r\n")
+	lf   = []byte("\n")
+)
+
+// Normalize newlines in the data of a token other than a tag to "\n"; see
+// ParseOptions.NormalizeNewlines.
+func normalizeNewlines(tok token) token {
+	switch tok.Kind {
+	case tagOpenToken, tagSelfcloseToken, tagCloseToken:
+		return tok
+	}
+	if bytes.IndexByte(tok.Data, '\r') < 0 {
+		return tok
+	}
+	tok.Data = bytes.ReplaceAll(tok.Data, crlf, lf)
+	tok.Data = bytes.ReplaceAll(tok.Data, carriageReturn, lf)
+	return tok
+}
+
+// Normalize newlines in an element's attribute values to "\n", re-expanding
+// the values from their raw text; see ParseOptions.NormalizeNewlines.
+func normalizeAttrNewlines(node *Node) {
+	for key, raw := range node.RawAttrs {
+		if strings.IndexByte(raw, '\r') < 0 {
+			continue
+		}
+		data := bytes.ReplaceAll([]byte(raw), crlf, lf)
+		data = bytes.ReplaceAll(data, carriageReturn, lf)
+		node.Attrs[key], _ = expandEntitys(unquote(data), node.AttrLocs[key].Val.Start, true)
+	}
 }
 
+// Drop any NUL characters from tag content.
+func dropNULs(s string) string {
+	if strings.IndexByte(s, 0) < 0 {
+		return s
+	}
+	return strings.ReplaceAll(s, "\x00", "")
+}
+
+func parseCloseTag(tok token) (node *Node, err error, warns []error) {
+	node = &Node{Kind: InvalidNode, Loc: tok.Loc}
+
+	name, rest := splitCloseTag(tok.Data)
+	if len(name) == 0 {
+		err = errorAt(tok.Loc, "error parsing closing tag: %w", EmptyContentErr)
+		return node, err, warns
+	} else if len(rest) > 0 {
+		// NOTE: browsers ignore anything after the tag name, e.g. </div x>
+		warn := errorAt(tok.Loc, "error parsing closing tag: %w: %q", CloseTagContentErr, rest)
+		warns = append(warns, warn)
+	}
+
+	node.Content = dropNULs(string(name))
+	return node, nil, warns
+}
+
+// Bytes that end an attribute key or unquoted value; see isSpace.
+const spacesOrEquals = "\t\n\f\r ="
+
 func isSpace(c byte) bool {
 	return c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
 }
 
+// Field of tag data: the tag name or an attribute, as sub-slices of the data.
+type tagField struct {
+	Key    []byte  // Tag name or attribute key
+	Val    []byte  // Attribute value as written, including any quotes
+	HasVal bool    // Whether the attribute was written with '='
+	Loc    AttrLoc // Locations of Key and Val, the latter excluding quotes
+}
+
 // Split tag data by fields such that the first field is the tag name and
-// subsequent fields are attributes
-func splitTagFields(data []byte, loc Location) []token {
-	fields := make([]token, 0, 16)
+// subsequent fields are attributes, reusing the storage of buf.  loc is the
+// location of the start of data in the document.  If max is positive,
+// splitting stops after max fields.
+func splitTagFields(buf []tagField, data []byte, loc Location, max int) []tagField {
+	fields := buf[:0]
 
 	notSpaces := func(d []byte) bool {
 		return len(d) <= 0 || !isSpace(d[0])
 	}
 
-	isSpacesOrEquals := func(d []byte) bool {
-		return len(d) <= 0 || isSpace(d[0]) || bytes.HasPrefix(d, equals)
-	}
-
+	// NOTE: step through data with positions relative to it
+	base := loc.Pos
 	loc.Pos = 0
-	for loc.Pos < len(data) {
+	for loc.Pos < len(data) && (max <= 0 || len(fields) < max) {
 		// skip over leading spaces
 		loc = stepUntil(loc, data, notSpaces)
 		if loc.Pos >= len(data) {
 			break
 		}
-		field := token{Kind: textToken, Loc: loc}
+		var field tagField
+		field.Loc.Key.Start = loc
 
 		// skip to space or '='
-		loc = stepUntil(loc, data, isSpacesOrEquals)
-		field.Data = data[field.Loc.Pos:loc.Pos]
-		if loc.Pos >= len(data) {
-			fields = append(fields, field)
-			break
-		}
+		loc = stepToAny(loc, data, spacesOrEquals)
+		field.Key = data[field.Loc.Key.Start.Pos:loc.Pos]
+		field.Loc.Key.End = loc
 
 		// skip spaces until '='
 		loc = stepUntil(loc, data, notSpaces)
-		if loc.Pos >= len(data) {
-			fields = append(fields, field)
-			break
-		} else if !bytes.HasPrefix(data[loc.Pos:], equals) {
-			fields = append(fields, field)
+		if loc.Pos >= len(data) || !bytes.HasPrefix(data[loc.Pos:], equals) {
+			fields = append(fields, field.relativeTo(base))
 			continue
 		}
 
-		// append '=' to field
-		field.Data = append(field.Data, equals...)
-		loc.Pos += len(equals)
-		loc.Col += len(equals)
-		if loc.Pos >= len(data) {
-			fields = append(fields, field)
-			break
-		}
-
-		// skip spaces until attribute value
+		// skip '=' and spaces until attribute value
+		field.HasVal = true
+		loc = stepN(loc, data, len(equals))
 		loc = stepUntil(loc, data, notSpaces)
+		field.Loc.Val = Span{Start: loc, End: loc}
 		if loc.Pos >= len(data) {
-			fields = append(fields, field)
+			fields = append(fields, field.relativeTo(base))
 			break
 		}
 
@@ -243,76 +427,114 @@ func splitTagFields(data []byte, loc Location) []token {
 		if data[loc.Pos] == '"' || data[loc.Pos] == '\'' {
 			// step until matching quote
 			quote := data[loc.Pos]
-
-			newLoc := loc
-			newLoc.Pos++
-			newLoc.Col++
-
-			newLoc = stepUntil(newLoc, data, func(d []byte) bool {
-				return len(d) <= 0 || d[0] == quote
-			})
-			if newLoc.Pos < len(data) {
-				newLoc.Pos++
-			}
-
-			field.Data = append(field.Data, data[loc.Pos:newLoc.Pos]...)
-			loc = newLoc
-
+			field.Loc.Val.Start = stepN(loc, data, 1)
+			field.Loc.Val.End = stepToByte(field.Loc.Val.Start, data, quote)
+			loc = stepN(field.Loc.Val.End, data, 1)
+			field.Val = data[field.Loc.Val.Start.Pos-1 : loc.Pos]
 		} else {
 			// step until space (or equals)
-			newLoc := stepUntil(loc, data, isSpacesOrEquals)
+			loc = stepToAny(loc, data, spacesOrEquals)
+			field.Loc.Val.End = loc
 
 			// check that next char is not '=' (that would indicate this is
-			// the next key); if not, append to field.Data
-			if !bytes.HasPrefix(data[newLoc.Pos:], equals) {
-				field.Data = append(field.Data, data[loc.Pos:newLoc.Pos]...)
+			// the next key); if not, it's the value
+			if !bytes.HasPrefix(data[loc.Pos:], equals) {
+				field.Val = data[field.Loc.Val.Start.Pos:loc.Pos]
 			}
-
-			loc = newLoc
 		}
 
-		fields = append(fields, field)
+		fields = append(fields, field.relativeTo(base))
 	}
 
 	return fields
 }
 
-func parseAttr(field token) (key string, val string, warns []error) {
-	keyData, valData, found := bytes.Cut(field.Data, equals)
-	if found {
-		// attribute with a value (e.g. key="val")
+// Field with the byte offsets of its locations made relative to the document
+// rather than the tag data, which starts at the byte offset base.
+func (field tagField) relativeTo(base int) tagField {
+	field.Loc.Key.Start.Pos += base
+	field.Loc.Key.End.Pos += base
+	if field.HasVal {
+		field.Loc.Val.Start.Pos += base
+		field.Loc.Val.End.Pos += base
+	}
+	return field
+}
 
-		// advance field location to the value
-		field.Loc.Pos = len(keyData) + len(equals)
-		field.Loc.Col += len(keyData) + len(equals)
+// Strip the quotes, if any, around an attribute value.
+func unquote[T string | []byte](data T) T {
+	if len(data) >= 2 && (data[0] == '"' || data[0] == '\'') && data[len(data)-1] == data[0] {
+		return data[1 : len(data)-1]
+	}
+	return data
+}
 
-		valData = bytes.Trim(valData, "\"'")
-		valData, warns = expandEntitys(valData, field.Loc)
+// Parse an attribute field into its key, its value, and the raw value text as
+// written (including quotes).  The value shares the raw text's memory unless
+// it has character references to expand.
+func parseAttr(field tagField) (key string, val string, raw string, warns []error) {
+	key = dropNULs(string(field.Key))
+	if !field.HasVal {
+		return key, "", "", warns
 	}
-	return string(keyData), string(valData), warns
+
+	// attribute with a value (e.g. key="val")
+	raw = string(field.Val)
+	val, warns = expandEntitysString(unquote(raw), field.Loc.Val.Start, true)
+	return key, dropNULs(val), dropNULs(raw), warns
 }
 
-func parseOpenTag(tok token) (node *Node, err error, warns []error) {
-	node = &Node{Kind: ElementNode, Loc: tok.Loc}
+// Parse an opening tag, keeping at most maxAttrs attributes if maxAttrs is
+// positive.
+func parseOpenTag(tok token, maxAttrs int, a *arena) (node *Node, err error, warns []error) {
+	node = a.node()
+	*node = Node{Kind: ElementNode, Loc: tok.Loc}
+
+	// location of the tag data, just past the opening '<'
+	dataLoc := tok.Loc
+	dataLoc.Pos += len(tagStart)
+	if dataLoc.Line != 0 {
+		dataLoc.Col += len(tagStart)
+	}
 
-	fields := splitTagFields(tok.Data, tok.Loc)
+	maxFields := 0
+	if maxAttrs > 0 {
+		// split one more field than kept, to tell if any are left over
+		maxFields = maxAttrs + 2
+	}
+	fieldBuf := tagFieldBufs.Get().(*[]tagField)
+	defer releaseTagFields(fieldBuf)
+	fields := splitTagFields(*fieldBuf, tok.Data, dataLoc, maxFields)
+	*fieldBuf = fields
 	if len(fields) == 0 {
-		err = fmt.Errorf("%s: error parsing opening tag: %w", tok.Loc, EmptyContentErr)
+		err = errorAt(tok.Loc, "error parsing opening tag: %w", EmptyContentErr)
 		return
+	} else if maxAttrs > 0 && len(fields) > maxAttrs+1 {
+		warn := errorAt(tok.Loc, "error parsing opening tag: %w: more than %d in %q", AttrLimitErr, maxAttrs, fields[0].Key)
+		warns = append(warns, warn)
+		fields = fields[:maxAttrs+1]
 	}
 
-	node.Children = make([]*Node, 0, 16)
+	node.Content = dropNULs(internTagName(fields[0].Key))
 
-	node.Content = string(bytes.ToLower(fields[0].Data))
-
-	node.Attrs = make(map[string]string, len(fields)-1)
+	// NOTE: Children and attribute maps are left nil until needed, since most
+	// elements have few children and no attributes
+	if len(fields) > 1 {
+		node.Attrs = make(map[string]string, len(fields)-1)
+		node.AttrLocs = make(map[string]AttrLoc, len(fields)-1)
+		node.RawAttrs = make(map[string]string, len(fields)-1)
+		node.AttrOrder = make([]string, 0, len(fields)-1)
+	}
 	for _, field := range fields[1:] {
-		key, val, fieldWarns := parseAttr(field)
+		key, val, raw, fieldWarns := parseAttr(field)
 		if _, ok := node.Attrs[key]; ok {
-			warn := fmt.Errorf("%s:%w: repeated key %q", field.Loc, AttrKeyErr, key)
+			warn := errorAt(field.Loc.Key.Start, "%w: repeated key %q", AttrKeyErr, key)
 			warns = append(warns, warn)
 		} else {
 			node.Attrs[key] = val
+			node.AttrOrder = append(node.AttrOrder, key)
+			node.AttrLocs[key] = field.Loc
+			node.RawAttrs[key] = raw
 		}
 		warns = append(warns, fieldWarns...)
 	}
@@ -320,77 +542,700 @@ func parseOpenTag(tok token) (node *Node, err error, warns []error) {
 	return
 }
 
-func parse(tokens []token) (docNode *Node, err error, warns []error) {
-	docNode = &Node{Kind: DocumentNode, Children: make([]*Node, 0, 4)}
-	tags := make(stack[*Node], 0, 16)
-	tags.Push(docNode)
+// Elements allowed as children of table elements.  Other elements and
+// non-whitespace text found directly within table elements are foster
+// parented, i.e. moved to just before the table, as browsers do.
+var tableChildren = map[string]map[string]bool{
+	"table": {
+		"caption": true, "colgroup": true, "col": true, "thead": true,
+		"tbody": true, "tfoot": true, "tr": true, "td": true, "th": true,
+		"script": true, "style": true, "template": true,
+	},
+	"thead": {"tr": true, "td": true, "th": true, "script": true, "style": true, "template": true},
+	"tbody": {"tr": true, "td": true, "th": true, "script": true, "style": true, "template": true},
+	"tfoot": {"tr": true, "td": true, "th": true, "script": true, "style": true, "template": true},
+	"tr":    {"td": true, "th": true, "script": true, "style": true, "template": true},
+}
+
+// Foster parent node if the current open element is a table element that
+// can't contain it, inserting it just before the innermost open table instead.
+// idx is the index of tags.  Returns whether node was foster parented.
+func fosterParent(tags stack[*Node], idx *openIndex, node *Node) bool {
+	parent, ok := tags.Peek()
+	if !ok || parent.Kind != ElementNode {
+		return false
+	}
+
+	allowed, ok := tableChildren[parent.Content]
+	if !ok {
+		return false
+	}
+
+	switch node.Kind {
+	case ElementNode:
+		if allowed[node.Content] {
+			return false
+		}
+	case TextNode:
+		if len(strings.TrimFunc(node.Content, isSpaceR)) == 0 {
+			return false
+		}
+	default:
+		return false
+	}
+
+	i := idx.top("table")
+	if i <= 0 || tags[i].Parent == nil {
+		return false
+	}
+	table := tags[i]
+
+	// NOTE: an open table is the last child of its parent but for nodes
+	// foster parented out of it, which are inserted before it
+	children := table.Parent.Children
+	for k := len(children) - 1; k >= 0; k-- {
+		if children[k] == table {
+			table.Parent.Children = slices.Insert(children, k, node)
+			node.Parent = table.Parent
+			return true
+		}
+	}
+	return false
+}
+
+// Number of open elements that an opening tag named tagName closes because
+// it can't be nested in the current open element, as with a table opened
+// directly within a table element, which closes the open table (e.g.
+// "<table><tr><table>" is two sibling tables).  idx is the index of tags.
+func tableCloseCount(tags stack[*Node], idx *openIndex, tagName string) int {
+	parent, ok := tags.Peek()
+	if !ok || parent.Kind != ElementNode || tableChildren[parent.Content] == nil || tagName != "table" {
+		return 0
+	}
+	if i := idx.top("table"); i > 0 {
+		return len(tags) - i
+	}
+	return 0
+}
+
+// Elements in the special category of the WHATWG HTML standard, keyed by
+// lowercase tag name.  The closing tag of any other element can't close them.
+var specialTags = map[string]bool{
+	"address": true, "applet": true, "area": true, "article": true,
+	"aside": true, "base": true, "basefont": true, "bgsound": true,
+	"blockquote": true, "body": true, "br": true, "button": true,
+	"caption": true, "center": true, "col": true, "colgroup": true,
+	"dd": true, "details": true, "dir": true, "div": true, "dl": true,
+	"dt": true, "embed": true, "fieldset": true, "figcaption": true,
+	"figure": true, "footer": true, "form": true, "frame": true,
+	"frameset": true, "h1": true, "h2": true, "h3": true, "h4": true,
+	"h5": true, "h6": true, "head": true, "header": true, "hgroup": true,
+	"hr": true, "html": true, "iframe": true, "img": true, "input": true,
+	"keygen": true, "li": true, "link": true, "listing": true, "main": true,
+	"marquee": true, "menu": true, "meta": true, "nav": true,
+	"noembed": true, "noframes": true, "noscript": true, "object": true,
+	"ol": true, "p": true, "param": true, "plaintext": true, "pre": true,
+	"script": true, "search": true, "section": true, "select": true,
+	"source": true, "style": true, "summary": true, "table": true,
+	"tbody": true, "td": true, "template": true, "textarea": true,
+	"tfoot": true, "th": true, "thead": true, "title": true, "tr": true,
+	"track": true, "ul": true, "wbr": true, "xmp": true,
+	// foreign integration points
+	"mi": true, "mo": true, "mn": true, "ms": true, "mtext": true,
+	"annotation-xml": true, "foreignobject": true, "desc": true,
+}
+
+// Elements that delimit a scope which no closing tag from outside can reach
+// into, keyed by lowercase tag name.
+var scopeTags = map[string]bool{
+	"applet":   true,
+	"caption":  true,
+	"html":     true,
+	"marquee":  true,
+	"object":   true,
+	"table":    true,
+	"td":       true,
+	"template": true,
+	"th":       true,
+}
+
+// Closing tags that also close the table cell or caption they are in, e.g.
+// </table> in <table><tr><td><b>.
+var tableCloseTags = map[string]bool{
+	"table": true,
+	"tbody": true,
+	"tfoot": true,
+	"thead": true,
+	"tr":    true,
+}
+
+// Index of a stack of open elements by lowercase name, along with the
+// positions of the elements that closing tags can't search past, so that
+// finding the element a misnested closing tag closes takes constant time
+// rather than time proportional to the number of open elements.
+type openIndex struct {
+	// positions of open elements by lowercase name
+	names map[string][]int
+	// positions of special elements, which stop the search of closing tags of
+	// other elements
+	specials []int
+	// positions of scope elements other than table cells and captions, which
+	// stop the search of closing tags of special elements
+	scopes []int
+	// positions of table cells and captions, which also stop the search of
+	// closing tags of special elements other than those in tableCloseTags
+	cells []int
+}
+
+func newOpenIndex() *openIndex {
+	return &openIndex{names: make(map[string][]int)}
+}
+
+// Add the element named name, or an entry that no closing tag reaches past if
+// name is "", at position pos of the stack.
+func (idx *openIndex) push(name string, pos int) {
+	if name == "" {
+		idx.specials = append(idx.specials, pos)
+		idx.scopes = append(idx.scopes, pos)
+		return
+	}
+
+	name = strings.ToLower(name)
+	idx.names[name] = append(idx.names[name], pos)
+	if specialTags[name] {
+		idx.specials = append(idx.specials, pos)
+	}
+	if name == "td" || name == "th" || name == "caption" {
+		idx.cells = append(idx.cells, pos)
+	} else if scopeTags[name] {
+		idx.scopes = append(idx.scopes, pos)
+	}
+}
+
+// Remove the entry at position pos of the stack, which was added as name.
+func (idx *openIndex) pop(name string, pos int) {
+	popPos := func(positions []int) []int {
+		if n := len(positions); n > 0 && positions[n-1] == pos {
+			return positions[:n-1]
+		}
+		return positions
+	}
+
+	idx.specials = popPos(idx.specials)
+	idx.scopes = popPos(idx.scopes)
+	idx.cells = popPos(idx.cells)
+	if name == "" {
+		return
+	}
+	name = strings.ToLower(name)
+	if positions := popPos(idx.names[name]); len(positions) > 0 {
+		idx.names[name] = positions
+	} else {
+		delete(idx.names, name)
+	}
+}
+
+// Position of the topmost open element named tagName, or -1 if there is none.
+func (idx *openIndex) top(tagName string) int {
+	positions := idx.names[strings.ToLower(tagName)]
+	if len(positions) == 0 {
+		return -1
+	}
+	return positions[len(positions)-1]
+}
+
+// Number of the n topmost of size open elements that a misnested closing tag
+// named tagName closes, searching past elements that it doesn't close
+// implicitly, as browsers do; e.g. </div> closes both elements in
+// <div><span>.  The closing tag of a special element (e.g. </div>) reaches
+// past any element within its scope, while that of any other element (e.g.
+// </span>) stops at special elements.  Returns 0 if there is no such matching
+// element.
+func (idx *openIndex) searchCloseCount(tagName string, n int, size int) int {
+	pos := idx.top(tagName)
+	if pos < 0 || pos < size-n {
+		return 0
+	}
 
-	i := 0
+	above := func(positions []int) bool {
+		return len(positions) > 0 && positions[len(positions)-1] > pos
+	}
+	tagName = strings.ToLower(tagName)
+	if !specialTags[tagName] {
+		if above(idx.specials) {
+			return 0
+		}
+	} else if above(idx.scopes) || !tableCloseTags[tagName] && above(idx.cells) {
+		return 0
+	}
+	return size - pos
+}
 
-loop:
-	for ; i < len(tokens); i++ {
-		tok := tokens[i]
+// Number of open elements that a closing tag named tagName closes, counting
+// elements foster parented out of a table within the element being closed,
+// and everything open within them, as implicitly closed; e.g. </table> closes
+// the <div> in "<table><div>a</table>".  Returns 0 if there is no matching
+// open element.
+func fosterCloseCount(tags stack[*Node], idx *openIndex, fostered map[*Node]bool, tagName string, opts *ParseOptions) int {
+	j := idx.top(tagName)
+	if j <= 0 {
+		return 0
+	}
 
-		parent, ok := tags.Peek()
-		if !ok {
-			err = fmt.Errorf("%s: error parsing document: %w", tok.Loc, EmptyTagStackErr)
-			return
+	inFostered := false
+	for _, node := range tags[j+1:] {
+		if fostered[node] {
+			inFostered = true
+		} else if !inFostered && !opts.optionalEnd(node.Content) {
+			return 0
 		}
+	}
+	return len(tags) - j
+}
+
+// Kinds of nodes that tokens are parsed into.
+var tokenNodeKinds = map[tokenKind]NodeKind{
+	textToken:             TextNode,
+	verbatimToken:         TextNode,
+	tagOpenToken:          ElementNode,
+	tagSelfcloseToken:     ElementNode,
+	commentToken:          CommentNode,
+	declarationToken:      DeclarationNode,
+	cdataToken:            CDATANode,
+	piToken:               ProcessingInstructionNode,
+	conditionalStartToken: ConditionalCommentNode,
+}
 
-		var node *Node
-		var tokWarns []error
+// Builder of a tree from tokens, one token at a time, keeping the state of
+// parsing between them; see parse.
+type treeBuilder struct {
+	opts    *ParseOptions
+	docNode *Node
+
+	// open nodes, starting with docNode
+	tags stack[*Node]
+	// index of tags by name, for misnested closing tags
+	open *openIndex
+
+	// nesting depth within an element dropped by opts.NodeFilter
+	skipDepth int
+
+	// nodes foster parented out of tables; allocated when needed
+	fostered map[*Node]bool
+
+	warns []error
+	// number of warnings that error policies have been applied to
+	checked int
+	// number of nodes added to the tree, for opts.MaxNodes
+	nodes int
+
+	// node added to the tree by the last token, if any
+	last *Node
+}
+
+func newTreeBuilder(opts *ParseOptions) *treeBuilder {
+	b := &treeBuilder{
+		opts: opts,
+		docNode: &Node{
+			Kind:     DocumentNode,
+			Children: make([]*Node, 0, 4),
+			Loc:      opts.startLoc(),
+		},
+		tags: opts.tagStack(),
+		open: newOpenIndex(),
+	}
+	b.pushTag(b.docNode)
+	return b
+}
 
+// Name of an open node in the index of open nodes, which is "" for nodes that
+// no closing tag reaches past.
+func openName(node *Node) string {
+	if node.Kind == ElementNode {
+		return node.Content
+	}
+	return ""
+}
+
+// Push node onto the stack of open nodes.
+func (b *treeBuilder) pushTag(node *Node) {
+	b.open.push(openName(node), len(b.tags))
+	b.tags.Push(node)
+}
+
+// Pop the topmost node off the stack of open nodes.
+func (b *treeBuilder) popTag() {
+	if node, ok := b.tags.Pop(); ok {
+		b.open.pop(openName(node), len(b.tags))
+	}
+}
+
+// Return the builder's buffers to opts.scratch for reuse, once done.
+func (b *treeBuilder) release() {
+	b.opts.releaseTagStack(b.tags)
+	b.tags = nil
+}
+
+func parse(tokens []token, opts *ParseOptions) (docNode *Node, err error, warns []error) {
+	b := newTreeBuilder(opts)
+	defer b.release()
+
+	for i, tok := range tokens {
+		if i%cancelCheckInterval == 0 {
+			if err = opts.canceled(tok.Loc); err != nil {
+				return b.docNode, err, b.warns
+			}
+		}
+
+		done, err := b.push(tok)
+		if err != nil {
+			return b.docNode, err, b.warns
+		} else if done {
+			break
+		}
+	}
+
+	err = b.finish(tokens[len(tokens)-1].End, tokens[len(tokens)-1].Loc)
+	return b.docNode, err, b.warns
+}
+
+// Add the node parsed from tok to the tree.  Returns whether tok ends the
+// document.
+func (b *treeBuilder) push(tok token) (done bool, err error) {
+	b.last = nil
+
+	// apply error policies to any warnings from the previous token
+	if b.warns, err = b.opts.applyErrorPolicies(b.warns, b.checked); err != nil {
+		return false, err
+	}
+	b.checked = len(b.warns)
+
+	if b.skipDepth > 0 {
 		switch tok.Kind {
 		case eofToken:
-			break loop
-		case commentToken:
-			node, err = parseComment(tok)
-		case declarationToken:
-			node, err = parseDeclaration(tok)
-		case verbatimToken:
-			node = &Node{Kind: TextNode, Loc: tok.Loc, Content: string(tok.Data)}
-		case textToken:
-			node, err, tokWarns = parseText(tok)
-		case tagSelfcloseToken:
-			node, err, tokWarns = parseOpenTag(tok)
+			return true, nil
 		case tagOpenToken:
-			node, err, tokWarns = parseOpenTag(tok)
-		case tagCloseToken:
-			node, err = parseCloseTag(tok)
-			if err != nil {
-				break
-			} else if node.Content == parent.Content {
-				tags.Pop()
-				continue loop
-			} else {
-				warn := fmt.Errorf("%s: error parsing closing tag: %w: expected %q but got %q", node.Loc, TagMismatchErr, parent.Content, node.Content)
-				tokWarns = append(tokWarns, warn)
+			if !b.opts.voidTags()[extractTagName(tok)] {
+				b.skipDepth++
 			}
-		default:
-			err = fmt.Errorf("%s: error parsing document: %w: %s", tok.Loc, TokenErr, tok.Kind)
+		case conditionalStartToken:
+			b.skipDepth++
+		case tagCloseToken, conditionalStopToken:
+			b.skipDepth--
 		}
+		return false, nil
+	}
+
+	if kind, ok := tokenNodeKinds[tok.Kind]; ok && !b.opts.keepNode(kind, extractTagName(tok)) {
+		if tok.Kind == tagOpenToken && !b.opts.voidTags()[extractTagName(tok)] || tok.Kind == conditionalStartToken {
+			b.skipDepth = 1
+		}
+		return false, nil
+	}
+
+	parent, ok := b.tags.Peek()
+	if !ok {
+		err = errorAt(tok.Loc, "error parsing document: %w", EmptyTagStackErr)
+		return false, err
+	}
+
+	var node *Node
+	var tokWarns []error
+
+	if tok, err = replaceNULs(tok); err != nil {
+		b.warns = append(b.warns, err)
+		err = nil
+	}
+	if b.opts.NormalizeNewlines {
+		tok = normalizeNewlines(tok)
+	}
 
+	switch tok.Kind {
+	case eofToken:
+		return true, nil
+	case commentToken:
+		node, err = parseComment(tok, b.opts.arena)
+	case declarationToken:
+		node, err = parseDeclaration(tok, b.opts.arena)
+	case cdataToken:
+		node, err = parseCDATA(tok, parent, b.opts.arena)
+	case piToken:
+		node, err = parseProcessingInstruction(tok, b.opts.arena)
+	case conditionalStartToken:
+		node, err = parseConditionalStart(tok, b.opts.arena)
+	case conditionalStopToken:
+		// close elements left open within the conditional comment
+		n := conditionalCloseCount(b.tags)
+		if n == 0 {
+			warn := errorAt(tok.Loc, "error parsing conditional comment: %w", TagMismatchErr)
+			b.warns = append(b.warns, warn)
+			return false, nil
+		}
+		for ; n > 1; n-- {
+			warn := errorAt(parent.Loc, "error parsing conditional comment: %w: %q", UnclosedTagErr, parent.Content)
+			b.warns = append(b.warns, warn)
+			parent.EndLoc = tok.Loc
+			b.popTag()
+			parent, _ = b.tags.Peek()
+		}
+		parent.EndLoc = tok.End
+		b.popTag()
+		return false, nil
+	case verbatimToken:
+		node = b.opts.arena.node()
+		*node = Node{Kind: TextNode, Loc: tok.Loc, Content: b.opts.arena.string(tok.Data)}
+	case textToken:
+		node, err, tokWarns = parseText(tok, b.opts.DeferEntities, b.opts.arena)
+	case tagSelfcloseToken:
+		node, err, tokWarns = parseOpenTag(tok, b.opts.MaxAttrs, b.opts.arena)
+		if b.opts.NormalizeNewlines {
+			normalizeAttrNewlines(node)
+		}
+	case tagOpenToken:
+		node, err, tokWarns = parseOpenTag(tok, b.opts.MaxAttrs, b.opts.arena)
+		if b.opts.NormalizeNewlines {
+			normalizeAttrNewlines(node)
+		}
+	case tagCloseToken:
+		node, err, tokWarns = parseCloseTag(tok)
 		if err != nil {
-			return
+			break
 		}
 
-		parent.Children = append(parent.Children, node)
+		// implicitly close elements open within the one being closed
+		nameAt := func(i int) string {
+			if node := b.tags[len(b.tags)-1-i]; node.Kind == ElementNode {
+				return node.Content
+			}
+			return ""
+		}
+		n := b.opts.closeCount(node.Content, b.tags.Len()-1, nameAt)
+		if n == 0 && len(b.fostered) > 0 {
+			n = fosterCloseCount(b.tags, b.open, b.fostered, node.Content, b.opts)
+		}
+		if n == 0 {
+			// misnested closing tag, e.g. </div> in <div><span>
+			if n = b.open.searchCloseCount(node.Content, b.tags.Len()-1, b.tags.Len()); n > 0 {
+				warn := errorAt(node.Loc, "error parsing closing tag: %w: expected %q but got %q", TagMismatchErr, parent.Content, node.Content)
+				tokWarns = append(tokWarns, warn)
+			}
+		}
+		for ; n > 1; n-- {
+			parent.EndLoc = tok.Loc
+			b.popTag()
+			parent, _ = b.tags.Peek()
+		}
 
-		if node.Kind == ElementNode && !unpairedTags[node.Content] {
-			tags.Push(node)
+		if parent.Kind == ElementNode && strings.EqualFold(node.Content, parent.Content) {
+			parent.EndLoc = tok.End
+			b.popTag()
+			b.warns = append(b.warns, tokWarns...)
+			return false, nil
+		} else {
+			warn := errorAt(node.Loc, "error parsing closing tag: %w: expected %q but got %q", TagMismatchErr, parent.Content, node.Content)
+			tokWarns = append(tokWarns, warn)
 		}
+	default:
+		err = errorAt(tok.Loc, "error parsing document: %w: %s", TokenErr, tok.Kind)
+	}
 
-		warns = append(warns, tokWarns...)
+	if err != nil {
+		return false, err
 	}
 
-	if len(tags) > 1 {
-		node, _ := tags.Peek()
-		warn := fmt.Errorf("%s: error parsing document: %w: %q", node.Loc, UnclosedTagErr, node.Content)
-		warns = append(warns, warn)
-	} else if len(tags) < 1 {
-		warn := fmt.Errorf("%s: error parsing document: %w", tokens[len(tokens)-1].Loc, EmptyTagStackErr)
-		warns = append(warns, warn)
+	if node.Kind == ElementNode {
+		// implicitly close open elements that the new element ends
+		for n := tableCloseCount(b.tags, b.open, node.Content); n > 0; n-- {
+			parent.EndLoc = tok.Loc
+			b.popTag()
+			parent, _ = b.tags.Peek()
+		}
+		for parent.Kind == ElementNode && b.opts.impliedEnd(node.Content, parent.Content) {
+			parent.EndLoc = tok.Loc
+			b.popTag()
+			parent, _ = b.tags.Peek()
+		}
+		setNamespace(node, parent)
 	}
 
-	return
+	if b.nodes++; b.opts.MaxNodes > 0 && b.nodes > b.opts.MaxNodes {
+		// elements left open end where parsing stops
+		for _, open := range b.tags {
+			open.EndLoc = tok.Loc
+		}
+		err = errorAt(tok.Loc, "error parsing document: %w: more than %d nodes", NodeLimitErr, b.opts.MaxNodes)
+		return false, err
+	}
+
+	node.EndLoc = tok.End
+	b.last = node
+	if fosterParent(b.tags, b.open, node) {
+		if b.fostered == nil {
+			b.fostered = make(map[*Node]bool)
+		}
+		b.fostered[node] = true
+		warn := errorAt(node.Loc, "error parsing document: %w: %s in %q", FosterParentErr, node.Kind, parent.Content)
+		tokWarns = append(tokWarns, warn)
+	} else {
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	// foreign elements may be self-closing, e.g. <circle/>, as may any
+	// element with XMLSelfClosing
+	selfclosing := tok.Kind == tagSelfcloseToken && b.opts.selfCloses(node)
+	if tok.Kind == tagSelfcloseToken && node.Kind == ElementNode && !b.opts.isVoid(node) && !selfclosing {
+		warn := errorAt(node.Loc, "error parsing opening tag: %w: %q", SelfCloseErr, node.Content)
+		tokWarns = append(tokWarns, warn)
+	}
+	if node.Kind == ElementNode && !b.opts.isVoid(node) && !selfclosing || node.Kind == ConditionalCommentNode {
+		b.pushTag(node)
+	}
+
+	b.warns = append(b.warns, tokWarns...)
+	return false, nil
+}
+
+// Finish the tree once all tokens are pushed, ending elements left open at
+// end, the end of the last token, whose location is last.
+func (b *treeBuilder) finish(end Location, last Location) (err error) {
+	// elements left open end where the document ends
+	for _, node := range b.tags {
+		node.EndLoc = end
+	}
+
+	if len(b.tags) > 1 {
+		node, _ := b.tags.Peek()
+		warn := errorAt(node.Loc, "error parsing document: %w: %q", UnclosedTagErr, node.Content)
+		b.warns = append(b.warns, warn)
+	} else if len(b.tags) < 1 {
+		warn := errorAt(last, "error parsing document: %w", EmptyTagStackErr)
+		b.warns = append(b.warns, warn)
+	}
+
+	b.warns, err = b.opts.applyErrorPolicies(b.warns, b.checked)
+	return err
+}
+
+// Elements that belong in <head>.
+var headTags = map[string]bool{
+	"base":     true,
+	"basefont": true,
+	"bgsound":  true,
+	"link":     true,
+	"meta":     true,
+	"noscript": true,
+	"script":   true,
+	"style":    true,
+	"template": true,
+	"title":    true,
+}
+
+// Whether node may precede <html> at the top level of a document.
+func isProlog(node *Node) bool {
+	return node.Kind == DeclarationNode || node.Kind == CommentNode ||
+		node.Kind == ProcessingInstructionNode ||
+		(node.Kind == TextNode && len(strings.TrimFunc(node.Content, isSpaceR)) == 0)
+}
+
+// Make an element that is implied by the document rather than written in it,
+// which has no location.
+func impliedElement(name string) *Node {
+	return &Node{
+		Kind:    ElementNode,
+		Content: name,
+		Loc:     Location{Line: 0, Col: 0, Pos: -1},
+		EndLoc:  Location{Line: 0, Col: 0, Pos: -1},
+	}
+}
+
+// Move nodes to the end of parent's children.
+func adopt(parent *Node, nodes []*Node) {
+	for _, node := range nodes {
+		node.Parent = parent
+	}
+	parent.Children = append(parent.Children, nodes...)
+}
+
+// Insert any missing <html>, <head>, and <body> elements into a document, as
+// browsers do, moving head content (e.g. <title>) into <head> and everything
+// else into <body>.
+func implyDocument(doc *Node) {
+	// <html>, containing everything but the prolog
+	var html *Node
+	prolog, rest := make([]*Node, 0, 4), make([]*Node, 0, len(doc.Children))
+	for _, child := range doc.Children {
+		if html == nil && child.Kind == ElementNode && child.Content == "html" {
+			html = child
+		} else if len(rest) == 0 && html == nil && isProlog(child) {
+			prolog = append(prolog, child)
+		} else {
+			rest = append(rest, child)
+		}
+	}
+	if html == nil {
+		html = impliedElement("html")
+	}
+	before, after := rest, []*Node(nil)
+	for i, child := range rest {
+		if child.Loc.Pos > html.Loc.Pos && html.Loc.Pos >= 0 {
+			before, after = rest[:i], rest[i:]
+			break
+		}
+	}
+	html.Children = slices.Concat(before, html.Children, after)
+	for _, child := range html.Children {
+		child.Parent = html
+	}
+	doc.Children = append(prolog, html)
+	html.Parent = doc
+
+	// <head>, containing head content up to the first other content
+	var head, body *Node
+	for _, child := range html.Children {
+		if child.Kind == ElementNode && child.Content == "head" && head == nil {
+			head = child
+		} else if child.Kind == ElementNode && child.Content == "body" && body == nil {
+			body = child
+		}
+	}
+	children := html.Children
+	html.Children = make([]*Node, 0, 2)
+	if head == nil {
+		head = impliedElement("head")
+		i := 0
+		for i < len(children) && children[i] != body &&
+			(isProlog(children[i]) || (children[i].Kind == ElementNode && headTags[children[i].Content])) {
+			i++
+		}
+		adopt(head, children[:i])
+		children = children[i:]
+	}
+	adopt(html, []*Node{head})
+
+	// <body>, containing everything else
+	if body == nil {
+		body = impliedElement("body")
+	}
+	bodyChildren := make([]*Node, 0, len(children)+len(body.Children))
+	afterBody := false
+	for _, child := range children {
+		if child == head {
+			continue
+		} else if child == body {
+			bodyChildren = append(bodyChildren, body.Children...)
+			afterBody = true
+		} else if !afterBody && isProlog(child) && len(bodyChildren) == 0 {
+			// whitespace and comments between <head> and <body>
+			adopt(html, []*Node{child})
+		} else {
+			bodyChildren = append(bodyChildren, child)
+		}
+	}
+	if !afterBody {
+		bodyChildren = append(bodyChildren, body.Children...)
+	}
+	body.Children = nil
+	adopt(body, bodyChildren)
+	adopt(html, []*Node{body})
 }
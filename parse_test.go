@@ -0,0 +1,774 @@
+package gohtml
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestMisnestedCloseTags(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<div><span>a</div>b", "<div><span>a</span></div>b"},
+		{"<b><i>x</b>y</i>", "<b><i>x</i></b>y"},
+		{"<b>x</i>y", "<b>xy</b>"},
+		{"<span><div>x</span>y", "<span><div>xy</div></span>"},
+		{"<table><tr><td><b>x</table>y", "<table><tr><td><b>x</b></td></tr></table>y"},
+		{"<i><div><b></i>x", "<i><div><b>x</b></div></i>"},
+		{"<!--[if IE]><b><![endif]-->x", "<!--[if IE]><b></b><![endif]-->x"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestFosterParent(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<table>oops<tr><td>x</td></tr></table>", "oops<table><tr><td>x</td></tr></table>"},
+		{"<table><tr>a<td>b</td></tr></table>", "a<table><tr><td>b</td></tr></table>"},
+		{"<table><div>x</div><tr></tr></table>", "<div>x</div><table><tr></tr></table>"},
+		{"<table> <tr></tr></table>", "<table> <tr></tr></table>"},
+		{"<table>a<table>b", "a<table></table>b<table></table>"},
+		{"<table><tr><td><table>x</table></td></tr></table>", "<table><tr><td>x<table></table></td></tr></table>"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestAttrLocs(t *testing.T) {
+	src := "<p>\n<a href='x&amp;y' id=z\n  hidden>t</a>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	node := doc.Find("a")
+
+	tests := []struct {
+		key string
+		val string
+	}{
+		{"href", "x&amp;y"},
+		{"id", "z"},
+		{"hidden", ""},
+	}
+	for _, test := range tests {
+		loc, ok := node.AttrLoc(test.key)
+		if !ok {
+			t.Errorf("AttrLoc(%q) not found", test.key)
+			continue
+		}
+		if got := src[loc.Key.Start.Pos:loc.Key.End.Pos]; got != test.key {
+			t.Errorf("AttrLoc(%q) key span = %q", test.key, got)
+		}
+		if test.val == "" {
+			if loc.Val != (Span{}) {
+				t.Errorf("AttrLoc(%q) val span = %v, want zero", test.key, loc.Val)
+			}
+		} else if got := src[loc.Val.Start.Pos:loc.Val.End.Pos]; got != test.val {
+			t.Errorf("AttrLoc(%q) val span = %q, want %q", test.key, got, test.val)
+		}
+	}
+
+	if loc, _ := node.AttrLoc("hidden"); loc.Key.Start.Line != 3 || loc.Key.Start.Col != 3 {
+		t.Errorf("AttrLoc(%q) starts at %v, want 3:3", "hidden", loc.Key.Start)
+	}
+	node.SetAttr("title", "new")
+	if _, ok := node.AttrLoc("title"); ok {
+		t.Errorf("AttrLoc(%q) found for an attribute added after parsing", "title")
+	}
+}
+
+func TestRawAttrs(t *testing.T) {
+	src := `<a href='x&amp;y' title="a &lt; b" id=z hidden>t</a>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	node := doc.Find("a")
+
+	tests := []struct {
+		key string
+		raw string
+		val string
+	}{
+		{"href", `'x&amp;y'`, "x&y"},
+		{"title", `"a &lt; b"`, "a < b"},
+		{"id", "z", "z"},
+		{"hidden", "", ""},
+	}
+	for _, test := range tests {
+		if got := node.RawAttrs[test.key]; got != test.raw {
+			t.Errorf("RawAttrs[%q] = %q, want %q", test.key, got, test.raw)
+		}
+		if got, _ := node.Attr(test.key); got != test.val {
+			t.Errorf("Attr(%q) = %q, want %q", test.key, got, test.val)
+		}
+	}
+	if !node.BoolAttr("hidden") || node.BoolAttr("id") {
+		t.Errorf("BoolAttr = %v, %v, want true, false", node.BoolAttr("hidden"), node.BoolAttr("id"))
+	}
+
+	if got := string(doc.RenderBytes()); got != src {
+		t.Errorf("Parse(%q) = %q, want it unchanged", src, got)
+	}
+	node.SetAttr("id", "w")
+	want := `<a href='x&amp;y' title="a &lt; b" id="w" hidden>t</a>`
+	if got := string(doc.RenderBytes()); got != want {
+		t.Errorf("after SetAttr = %q, want %q", got, want)
+	}
+}
+
+func TestImpliedEndTags(t *testing.T) {
+	rules := map[string][]string{"item": {"item"}, "else": {"if"}}
+	tests := []struct {
+		in    string
+		rules map[string][]string
+		want  string
+	}{
+		{"<ul><li>a<li>b</ul>", nil, "<ul><li>a</li><li>b</li></ul>"},
+		{"<p>a<div>b</div>", nil, "<p>a</p><div>b</div>"},
+		{"<ul><li>a<li>b</ul>", map[string][]string{}, "<ul><li>a<li>b</li></li></ul>"},
+		{"<list><item>a<item>b</list>", rules, "<list><item>a</item><item>b</item></list>"},
+		{"<if>a<else>b</else>", rules, "<if>a</if><else>b</else>"},
+		{"<p>a<div>b</div>", rules, "<p>a<div>b</div></p>"},
+		{"<list><item>a</list>b", rules, "<list><item>a</item></list>b"},
+		{"<div><p>a</div>b", nil, "<div><p>a</p></div>b"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := ParseWithOptions([]byte(test.in), WithImpliedEndTags(test.rules))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) with %v = %q, want %q", test.in, test.rules, got, test.want)
+		}
+	}
+}
+
+func TestEndLocs(t *testing.T) {
+	src := "<div id=a>\n  <p>one<p>two</div><!--c--><br>tail"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+
+	tests := []struct {
+		node *Node
+		want string
+	}{
+		{doc, src},
+		{doc.Find("div"), "<div id=a>\n  <p>one<p>two</div>"},
+		{doc.Find("p"), "<p>one"},
+		{doc.FindAll("p", false)[1], "<p>two"},
+		{doc.Find("p").Children[0], "one"},
+		{doc.Children[1], "<!--c-->"},
+		{doc.Find("br"), "<br>"},
+		{doc.Children[3], "tail"},
+	}
+
+	for _, test := range tests {
+		start, end := test.node.Loc, test.node.EndLoc
+		if got := src[start.Pos:end.Pos]; got != test.want {
+			t.Errorf("%v %q spans %q, want %q", test.node.Kind, test.node.Content, got, test.want)
+		}
+		if want := stepBytes(Location{Line: 1, Col: 1}, []byte(src), end.Pos); end != want {
+			t.Errorf("%v %q ends at %v, want %v", test.node.Kind, test.node.Content, end, want)
+		}
+	}
+}
+
+func TestAttrLocForeign(t *testing.T) {
+	src := "<svg VIEWBOX='0 0 1 1'\n\txlink:href=#x></svg>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	svg := doc.Find("svg")
+
+	for _, key := range []string{"viewBox", "viewbox", "VIEWBOX"} {
+		loc, ok := svg.AttrLoc(key)
+		if !ok || src[loc.Key.Start.Pos:loc.Key.End.Pos] != "VIEWBOX" || src[loc.Val.Start.Pos:loc.Val.End.Pos] != "0 0 1 1" {
+			t.Errorf("AttrLoc(%q) = %v, %v, want the VIEWBOX attribute", key, loc, ok)
+		}
+	}
+	if loc, ok := svg.AttrLoc("xlink:href"); !ok || loc.Key.Start.Line != 2 || loc.Val.Start.Pos != strings.Index(src, "#x") {
+		t.Errorf("AttrLoc(%q) = %v, %v", "xlink:href", loc, ok)
+	}
+}
+
+func TestImpliedDocument(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<p>hi", "<html><head></head><body><p>hi</p></body></html>"},
+		{"hi", "<html><head></head><body>hi</body></html>"},
+		{"<title>t</title><p>x", "<html><head><title>t</title></head><body><p>x</p></body></html>"},
+		{"<!DOCTYPE html><meta charset=utf-8>x", "<!DOCTYPE html><html><head><meta charset=utf-8></head><body>x</body></html>"},
+		{"<html><body><p>x</p></body></html>", "<html><head></head><body><p>x</p></body></html>"},
+		{"<head><link rel=a></head><div>x</div>", "<html><head><link rel=a></head><body><div>x</div></body></html>"},
+		{"<!--c--><p>x", "<!--c--><html><head></head><body><p>x</p></body></html>"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := ParseWithOptions([]byte(test.in), WithImpliedDocument())
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestOptionalEndTags(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<div><p>a<p>b</div>", "<div><p>a</p><p>b</p></div>"},
+		{"<p>a<div>b</div>", "<p>a</p><div>b</div>"},
+		{"<div><p>a<span>b</span></div>", "<div><p>a<span>b</span></p></div>"},
+		{"<ul><li>a<li>b<ul><li>c</ul></ul>", "<ul><li>a</li><li>b<ul><li>c</li></ul></li></ul>"},
+		{"<dl><dt>a<dd>b<dt>c</dl>", "<dl><dt>a</dt><dd>b</dd><dt>c</dt></dl>"},
+		{"<table><tr><td>a<td>b<tr><th>c</table>", "<table><tr><td>a</td><td>b</td></tr><tr><th>c</th></tr></table>"},
+		{"<select><option>a<option>b<optgroup><option>c</select>", "<select><option>a</option><option>b</option><optgroup><option>c</option></optgroup></select>"},
+	}
+
+	for _, test := range tests {
+		node, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		for _, warn := range warns {
+			if errors.Is(warn, UnclosedTagErr) {
+				t.Errorf("Parse(%q): unexpected warning %v", test.in, warn)
+			}
+		}
+	}
+}
+
+func TestFosterParentContexts(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<table><tbody>x<tr><td>y</td></tr></tbody></table>", "x<table><tbody><tr><td>y</td></tr></tbody></table>"},
+		{"<table><tr><span>a</span><td>b</td></tr></table>", "<span>a</span><table><tr><td>b</td></tr></table>"},
+		{"<div><table>a<tr><td>b</table></div>", "<div>a<table><tr><td>b</td></tr></table></div>"},
+		{"<table><caption>c</caption>x</table>", "x<table><caption>c</caption></table>"},
+		{"<table><!--c--><tr><td>x</td></tr></table>", "<table><!--c--><tr><td>x</td></tr></table>"},
+		{"<table><script>s</script><tr></tr></table>", "<table><script>s</script><tr></tr></table>"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(node.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		checkParents(t, node)
+	}
+}
+
+func TestColgroup(t *testing.T) {
+	tests := []struct {
+		in   string
+		cols int
+		want string
+	}{
+		{"<table><colgroup><col><col></colgroup><tr><td>x</td></tr></table>", 2, "<table><colgroup><col><col></colgroup><tr><td>x</td></tr></table>"},
+		{"<table><colgroup span=2></colgroup></table>", 0, "<table><colgroup span=2></colgroup></table>"},
+		{"<table><colgroup><col></table>", 1, "<table><colgroup><col></colgroup></table>"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.in, err)
+		}
+		colgroup := doc.Find("colgroup")
+		if n := len(colgroup.Children); colgroup.Kind != ElementNode || n != test.cols {
+			t.Errorf("Parse(%q): <colgroup> has %d children, want %d", test.in, n, test.cols)
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		checkParents(t, doc)
+	}
+}
+
+// Content of each of node's child text nodes.
+func childTexts(node *Node) []string {
+	texts := make([]string, 0, len(node.Children))
+	for _, child := range node.Children {
+		if child.Kind == TextNode {
+			texts = append(texts, child.Content)
+		}
+	}
+	return texts
+}
+
+func TestRCDATA(t *testing.T) {
+	tests := []struct {
+		in   string
+		tag  string
+		text string
+		want string
+	}{
+		{"<textarea><b>hi</b> &amp; &lt;</textarea>", "textarea", "<b>hi</b> & <", "<textarea>&lt;b&gt;hi&lt;/b&gt; &amp; &lt;</textarea>"},
+		{"<title>a<i>b</i>&copy;</title>", "title", "a<i>b</i>©", "<title>a&lt;i&gt;b&lt;/i&gt;©</title>"},
+		{"<TEXTAREA>x</TextArea>y", "textarea", "x", "<textarea>x</textarea>y"},
+		{"<title></title>", "title", "", "<title></title>"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		node := doc.Find(test.tag)
+		if got := strings.Join(childTexts(node), ""); len(node.Children) > 1 || got != test.text {
+			t.Errorf("Parse(%q): <%s> contains %d nodes with text %q, want %q", test.in, test.tag, len(node.Children), got, test.text)
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestPreformatted(t *testing.T) {
+	src := "<pre>\n  a  <code>x  <b>y</b>\n\tz</code>\n</pre><p>a   b</p>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+
+	pre := doc.Find("pre")
+	if code := pre.Find("code"); code.Kind != ElementNode || code.Find("b").Kind != ElementNode {
+		t.Errorf("Parse(%q): <pre> children not parsed as elements: %q", src, pre.InnerHTMLBytes())
+	}
+	if got, want := pre.Text(), "\n  a  x  y\n\tz\n"; got != want {
+		t.Errorf("<pre> Text = %q, want %q", got, want)
+	}
+	if got := string(doc.RenderBytes()); got != src {
+		t.Errorf("RenderBytes = %q, want %q", got, src)
+	}
+
+	buf := strings.Builder{}
+	if err := (&Renderer{Minify: true}).Render(&buf, doc); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "<pre>\n  a  <code>x  <b>y</b>\n\tz</code>\n</pre><p>a b</p>"; got != want {
+		t.Errorf("minified = %q, want %q", got, want)
+	}
+}
+
+func TestScriptData(t *testing.T) {
+	tests := []struct {
+		in   string
+		text string
+		rest string
+	}{
+		{`<script>var s = "</scr" + "ipt>";</script>p`, `var s = "</scr" + "ipt>";`, "p"},
+		{`<script>if (a<b && c>d) {}</script>p`, `if (a<b && c>d) {}`, "p"},
+		{`<script><!-- document.write("<script></script>"); --></script>p`, `<!-- document.write("<script></script>"); -->`, "p"},
+		{`<script><!--<script> x </script> y --></script>p`, `<!--<script> x </script> y -->`, "p"},
+		{`<script><!-- x </script>p`, `<!-- x `, "p"},
+		{`<script>a = "</script>";</script>p`, `a = "`, `";p`},
+		{`<script>x</SCRIPT >p`, "x", "p"},
+		{`<script>x</scripts></script>p`, "x</scripts>", "p"},
+		{`<style>a::after { content: "</b>" }</style>p`, `a::after { content: "</b>" }`, "p"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		script := doc.Children[0]
+		if got := strings.Join(childTexts(script), ""); len(script.Children) != 1 || got != test.text {
+			t.Errorf("Parse(%q): <%s> text = %q in %d nodes, want %q", test.in, script.Content, got, len(script.Children), test.text)
+		}
+		if got := strings.Join(childTexts(doc), ""); got != test.rest {
+			t.Errorf("Parse(%q): text after <%s> = %q, want %q", test.in, script.Content, got, test.rest)
+		}
+	}
+}
+
+func TestProcessingInstructions(t *testing.T) {
+	tests := []struct {
+		in      string
+		content string
+		want    string
+	}{
+		{`<?xml version="1.0"?><p>x</p>`, `xml version="1.0"`, `<?xml version="1.0"?><p>x</p>`},
+		{`<p><?php echo "<b>"; ?></p>`, `php echo "<b>"; `, `<p><?php echo "<b>"; ?></p>`},
+		{`<p><?= $a > $b ?>x</p>`, `= $a > $b `, `<p><?= $a > $b ?>x</p>`},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		pi := doc.FindFunc(func(node *Node) bool { return node.Kind == ProcessingInstructionNode })
+		if pi.Kind != ProcessingInstructionNode || pi.Content != test.content {
+			t.Errorf("Parse(%q): processing instruction %q, want %q", test.in, pi.Content, test.content)
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+
+	src := "<p><?php echo 1"
+	doc, _, warns := Parse([]byte(src))
+	if got := strings.Join(childTexts(doc.Find("p")), ""); got != "<?php echo 1" || !slices.ContainsFunc(warns, isErr(EofErr)) {
+		t.Errorf("Parse(%q): text %q with warnings %v, want the unterminated instruction as text", src, got, warns)
+	}
+}
+
+// Whether an error wraps target.
+func isErr(target error) func(err error) bool {
+	return func(err error) bool {
+		return errors.Is(err, target)
+	}
+}
+
+func TestCloseTagContent(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`<div>a</div class="x">b`, "<div>a</div>b"},
+		{`<div><p>a</p foo>b</div >c`, "<div><p>a</p>b</div>c"},
+		{"<span>a</span\n\tid=1 />b", "<span>a</span>b"},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		if !slices.ContainsFunc(warns, isErr(CloseTagContentErr)) {
+			t.Errorf("Parse(%q): warnings %v, want %v", test.in, warns, CloseTagContentErr)
+		}
+		if slices.ContainsFunc(warns, isErr(TagMismatchErr)) {
+			t.Errorf("Parse(%q): unexpected warnings %v", test.in, warns)
+		}
+	}
+
+	if _, _, warns := Parse([]byte("<div>a</div >")); len(warns) > 0 {
+		t.Errorf("Parse(%q): unexpected warnings %v", "<div>a</div >", warns)
+	}
+}
+
+func TestDeferredEntities(t *testing.T) {
+	src := "<p>a &amp; b &lt;c&gt; &copy &#x41;<script>x && y</script></p>"
+	doc, err, warns := ParseWithOptions([]byte(src), WithDeferredEntities())
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	if len(warns) > 0 {
+		t.Errorf("Parse(%q): unexpected warnings %v", src, warns)
+	}
+
+	p := doc.Find("p")
+	text := p.Children[0]
+	if !text.Unexpanded || text.Content != "a &amp; b &lt;c&gt; &copy &#x41;" {
+		t.Errorf("deferred text = %q (unexpanded %v), want it as written", text.Content, text.Unexpanded)
+	}
+	if got, want := p.RawText(), "a &amp; b &lt;c&gt; &copy &#x41;x && y"; got != want {
+		t.Errorf("RawText = %q, want %q", got, want)
+	}
+	if got := string(doc.RenderBytes()); got != src {
+		t.Errorf("RenderBytes = %q, want %q", got, src)
+	}
+
+	expanded, _, parseWarns := Parse([]byte(src))
+	if got, want := fmt.Sprint(doc.ExpandEntities()), fmt.Sprint(parseWarns); got != want {
+		t.Errorf("ExpandEntities warnings = %s, want %s as parsing gives", got, want)
+	}
+	if text.Unexpanded || text.Content != "a & b <c> © A" {
+		t.Errorf("expanded text = %q (unexpanded %v), want %q", text.Content, text.Unexpanded, "a & b <c> © A")
+	}
+	if got, want := p.RawText(), "a &amp; b &lt;c&gt; © Ax && y"; got != want {
+		t.Errorf("expanded RawText = %q, want %q", got, want)
+	}
+
+	if got, want := string(doc.RenderBytes()), string(expanded.RenderBytes()); got != want {
+		t.Errorf("expanded RenderBytes = %q, want %q as parsed without deferring", got, want)
+	}
+	if warns := doc.ExpandEntities(); len(warns) > 0 || text.Content != "a & b <c> © A" {
+		t.Errorf("second ExpandEntities = %q, %v, want no change", text.Content, warns)
+	}
+}
+
+func TestRawTextConfigured(t *testing.T) {
+	tests := []struct {
+		in   string
+		opts []Option
+		want string
+	}{
+		{"<script>a > b && c</script>", nil, "a > b && c"},
+		{"<style>a > b</style><p>c > d</p>", nil, "a > bc &gt; d"},
+		{"<xmp>a > b && c</xmp><script>d > e</script>", []Option{WithRawTextTags(map[string]bool{"xmp": true})}, "a > b && cd &gt; e"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := ParseWithOptions([]byte(test.in), test.opts...)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.in, err)
+		}
+		if got := doc.RawText(); got != test.want {
+			t.Errorf("Parse(%q).RawText() = %q, want %q", test.in, got, test.want)
+		}
+		first := doc.FindFunc(func(node *Node) bool { return node.Kind == ElementNode })
+		if got, want := first.RawText(), first.Children[0].Content; got != want {
+			t.Errorf("Parse(%q): <%s>.RawText() = %q, want %q", test.in, first.Content, got, want)
+		}
+	}
+}
+
+func TestNULs(t *testing.T) {
+	tests := []struct {
+		in    string
+		want  string
+		warns int
+	}{
+		{"<p\x00 ti\x00tle=\"a\x00b\">x\x00y</p>", `<p title="ab">x�y</p>`, 2},
+		{"<!--c\x00-->", "<!--c�-->", 1},
+		{"<script>a\x00b</script>", "<script>a�b</script>", 1},
+		{"<textarea>a\x00</textarea>", "<textarea>a�</textarea>", 1},
+		{"<svg><![CDATA[\x00]]></svg>", "<svg><![CDATA[�]]></svg>", 1},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("Parse(%q) = %q, want %q", test.in, got, test.want)
+		}
+		var nulWarns []error
+		for _, warn := range warns {
+			if errors.Is(warn, NULErr) {
+				nulWarns = append(nulWarns, warn)
+			}
+		}
+		if len(nulWarns) != test.warns {
+			t.Errorf("Parse(%q): %d %v warnings %v, want %d", test.in, len(nulWarns), NULErr, warns, test.warns)
+		}
+	}
+}
+
+func TestNormalizedNewlines(t *testing.T) {
+	src := "<p title=\"a\r\nb\rc\">x\r\ny\rz</p>\r\n<!--\r\n--><pre>\r\n&#13;</pre>"
+	doc, err, _ := ParseWithOptions([]byte(src), WithNormalizedNewlines())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := doc.Find("p")
+	if title, _ := p.Attr("title"); title != "a\nb\nc" {
+		t.Errorf("title = %q, want %q", title, "a\nb\nc")
+	}
+	if got := p.Text(); got != "x\ny\nz" {
+		t.Errorf("text = %q, want %q", got, "x\ny\nz")
+	}
+	if got := doc.Children[2].Content; got != "\n" {
+		t.Errorf("comment = %q, want %q", got, "\n")
+	}
+	if got := doc.Find("pre").Text(); got != "\n\r" {
+		t.Errorf("<pre> text = %q, want %q", got, "\n\r")
+	}
+	if pre := doc.Find("pre"); src[pre.Loc.Pos:pre.EndLoc.Pos] != "<pre>\r\n&#13;</pre>" {
+		t.Errorf("<pre> spans %q, want locations in the original input", src[pre.Loc.Pos:pre.EndLoc.Pos])
+	}
+
+	doc, _, _ = Parse([]byte(src))
+	if got := doc.Find("p").Text(); got != "x\r\ny\rz" {
+		t.Errorf("text without normalizing = %q, want %q", got, "x\r\ny\rz")
+	}
+}
+
+func TestMaxAttrs(t *testing.T) {
+	src := []byte(`<div><p a="1" b="2" c="3" d="4">x</p></div>`)
+
+	tests := []struct {
+		max  int
+		want []string
+		warn bool
+	}{
+		{0, []string{"a", "b", "c", "d"}, false},
+		{4, []string{"a", "b", "c", "d"}, false},
+		{2, []string{"a", "b"}, true},
+		{1, []string{"a"}, true},
+	}
+
+	for _, test := range tests {
+		doc, err, warns := ParseWithOptions(src, WithMaxAttrs(test.max))
+		if err != nil {
+			t.Fatalf("Parse(%q) with max %d error: %v", src, test.max, err)
+		}
+		p := doc.Children[0].Children[0]
+		if !slices.Equal(p.AttrOrder, test.want) {
+			t.Errorf("Parse(%q) with max %d attributes = %q, want %q", src, test.max, p.AttrOrder, test.want)
+		}
+		if len(p.Attrs) != len(test.want) || len(p.RawAttrs) != len(test.want) {
+			t.Errorf("Parse(%q) with max %d kept %d attributes, %d raw, want %d", src, test.max, len(p.Attrs), len(p.RawAttrs), len(test.want))
+		}
+		if got := slices.ContainsFunc(warns, isErr(AttrLimitErr)); got != test.warn {
+			t.Errorf("Parse(%q) with max %d warns = %v, want AttrLimitErr: %t", src, test.max, warns, test.warn)
+		}
+	}
+
+	opts := ParseOptions{MaxAttrs: 2, ErrorPolicies: map[error]ErrorPolicy{AttrLimitErr: AbortPolicy}}
+	if doc, err, _ := opts.Parse(src); !errors.Is(err, AttrLimitErr) || doc == nil {
+		t.Errorf("Parse(%q) aborting on AttrLimitErr error = %v, want %v", src, err, AttrLimitErr)
+	}
+}
+
+func TestMaxNodes(t *testing.T) {
+	src := []byte("<div><p>a</p><p>b</p><p>c</p></div>")
+
+	if _, err, _ := ParseWithOptions(src, WithMaxNodes(7)); err != nil {
+		t.Errorf("Parse(%q) with max 7 nodes error: %v", src, err)
+	}
+
+	doc, err, _ := ParseWithOptions(src, WithMaxNodes(5))
+	if !errors.Is(err, NodeLimitErr) {
+		t.Fatalf("Parse(%q) with max 5 nodes error = %v, want %v", src, err, NodeLimitErr)
+	}
+	if doc == nil || len(doc.Children) != 1 {
+		t.Fatalf("Parse(%q) with max 5 nodes returned no partial tree", src)
+	}
+	div := doc.Children[0]
+	var got []string
+	for _, p := range div.Children {
+		got = append(got, childTexts(p)...)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Parse(%q) with max 5 nodes = %q, want %q", src, got, want)
+	}
+	if want := strings.Index(string(src), "<p>c"); div.EndLoc.Pos != want {
+		t.Errorf("Parse(%q) with max 5 nodes: div ends at %d, want %d", src, div.EndLoc.Pos, want)
+	}
+	checkParents(t, doc)
+}
+
+func TestSplitTagFields(t *testing.T) {
+	type field struct {
+		key, val string
+		hasVal   bool
+	}
+	tests := []struct {
+		in   string
+		max  int
+		want []field
+	}{
+		{"p", 0, []field{{"p", "", false}}},
+		{`p a="1" b='x y' c d=e`, 0, []field{{"p", "", false}, {"a", `"1"`, true}, {"b", `'x y'`, true}, {"c", "", false}, {"d", "e", true}}},
+		{`div  class = "q" `, 0, []field{{"div", "", false}, {"class", `"q"`, true}}},
+		{"p a= ", 0, []field{{"p", "", false}, {"a", "", true}}},
+		{"p a b c", 2, []field{{"p", "", false}, {"a", "", false}}},
+	}
+
+	buf := make([]tagField, 0, 8)
+	for _, test := range tests {
+		data := []byte(test.in)
+		fields := splitTagFields(buf, data, Location{}, test.max)
+		got := make([]field, len(fields))
+		for i, f := range fields {
+			got[i] = field{string(f.Key), string(f.Val), f.HasVal}
+			for _, b := range [][]byte{f.Key, f.Val} {
+				if len(b) > 0 && (cap(b) == 0 || &b[:cap(b)][cap(b)-1] != &data[:cap(data)][cap(data)-1]) {
+					t.Errorf("splitTagFields(%q) field %q is not a slice of the tag data", test.in, b)
+				}
+			}
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("splitTagFields(%q, %d) = %v, want %v", test.in, test.max, got, test.want)
+		}
+		if len(fields) > 0 && &fields[:1][0] != &buf[:1][0] {
+			t.Errorf("splitTagFields(%q) didn't reuse its buffer", test.in)
+		}
+	}
+}
+
+func TestExpandEntitysString(t *testing.T) {
+	tests := []struct {
+		in, want string
+		inAttr   bool
+	}{
+		{"plain text", "plain text", false},
+		{"", "", false},
+		{"a &amp; b", "a & b", false},
+		{"&copy=2", "©=2", false},
+		{"?a=1&copy=2", "?a=1&copy=2", true},
+	}
+
+	for _, test := range tests {
+		if got, _ := expandEntitysString(test.in, Location{}, test.inAttr); got != test.want {
+			t.Errorf("expandEntitysString(%q, %t) = %q, want %q", test.in, test.inAttr, got, test.want)
+		}
+		if got, _ := expandEntitys([]byte(test.in), Location{}, test.inAttr); got != test.want {
+			t.Errorf("expandEntitys(%q, %t) = %q, want %q", test.in, test.inAttr, got, test.want)
+		}
+	}
+
+	s := strings.Repeat("no entities here ", 16)
+	allocs := testing.AllocsPerRun(100, func() {
+		if got, _ := expandEntitysString(s, Location{}, false); got != s {
+			t.Fatalf("expandEntitysString(%q) = %q", s, got)
+		}
+		_ = UnescapeString(s)
+		_ = UnescapeAttrString(s)
+	})
+	if allocs != 0 {
+		t.Errorf("expanding entity-free strings: %v allocations, want 0", allocs)
+	}
+}
@@ -0,0 +1,137 @@
+package gohtml
+
+import (
+	"bytes"
+	"sync"
+)
+
+// Parser for many documents, which reuses its internal buffers (e.g. for
+// tokens) from one call to Parse to the next, so as to allocate less when
+// parsing documents in bulk.  Not safe for concurrent use; use one Parser per
+// goroutine.
+type Parser struct {
+	// Options to parse with.
+	Options ParseOptions
+
+	scratch scratch
+}
+
+// Make a new Parser with options applied.
+func NewParser(opts ...Option) *Parser {
+	p := &Parser{}
+	for _, opt := range opts {
+		opt(&p.Options)
+	}
+	return p
+}
+
+// Parse HTML according to p.Options.  Returns the same values as Parse.
+func (p *Parser) Parse(data []byte) (node *Node, err error, warns []error) {
+	opts := p.Options
+	opts.scratch = &p.scratch
+	return opts.Parse(data)
+}
+
+// Buffers reused across parses by a Parser.
+type scratch struct {
+	tokens []token
+	tags   stack[*Node]
+}
+
+// Buffers reused across parses without a Parser, including concurrent ones.
+var (
+	tokenBufs    sync.Pool // *[]token
+	tagFieldBufs = sync.Pool{
+		New: func() any {
+			fields := make([]tagField, 0, 8)
+			return &fields
+		},
+	}
+	entityBufs = sync.Pool{
+		New: func() any {
+			return new(bytes.Buffer)
+		},
+	}
+)
+
+// Maximum capacity of a token slice, tag field slice, or entity buffer kept
+// for reuse without a Parser, so that a rare huge document, tag, or text
+// doesn't hold on to its memory.
+const (
+	maxPooledTokens    = 16 << 10
+	maxPooledTagFields = 64
+	maxPooledEntityBuf = 64 << 10
+)
+
+// Empty token slice with capacity for at least n tokens, taken from
+// opts.scratch if it has one big enough, or else from tokenBufs.
+func (opts *ParseOptions) tokenBuf(n int) []token {
+	if opts.scratch == nil {
+		if tokens, ok := tokenBufs.Get().(*[]token); ok && cap(*tokens) >= n {
+			return (*tokens)[:0]
+		}
+		return make([]token, 0, n)
+	} else if cap(opts.scratch.tokens) < n {
+		return make([]token, 0, n)
+	}
+	tokens := opts.scratch.tokens[:0]
+	opts.scratch.tokens = nil
+	return tokens
+}
+
+// Return tokens to opts.scratch for reuse, if bigger than the slice it has,
+// or else to tokenBufs, if no bigger than maxPooledTokens.
+func (opts *ParseOptions) releaseTokens(tokens []token) {
+	if cap(tokens) == 0 || opts.scratch != nil && cap(tokens) <= cap(opts.scratch.tokens) ||
+		opts.scratch == nil && cap(tokens) > maxPooledTokens {
+		return
+	}
+	// NOTE: clear references into the document so that it can be freed
+	clear(tokens)
+	if opts.scratch == nil {
+		tokens = tokens[:0]
+		tokenBufs.Put(&tokens)
+		return
+	}
+	opts.scratch.tokens = tokens[:0]
+}
+
+// Return a tag field slice to tagFieldBufs for reuse.
+func releaseTagFields(fields *[]tagField) {
+	if cap(*fields) > maxPooledTagFields {
+		return
+	}
+	// NOTE: clear references into the document so that it can be freed
+	clear(*fields)
+	*fields = (*fields)[:0]
+	tagFieldBufs.Put(fields)
+}
+
+// Return an entity expansion buffer to entityBufs for reuse.
+func releaseEntityBuf(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledEntityBuf {
+		return
+	}
+	buf.Reset()
+	entityBufs.Put(buf)
+}
+
+// Empty stack of open nodes, taken from opts.scratch if it has one.
+func (opts *ParseOptions) tagStack() stack[*Node] {
+	if opts.scratch == nil || opts.scratch.tags == nil {
+		return make(stack[*Node], 0, 16)
+	}
+	tags := opts.scratch.tags[:0]
+	opts.scratch.tags = nil
+	return tags
+}
+
+// Return a stack of open nodes to opts.scratch for reuse.
+func (opts *ParseOptions) releaseTagStack(tags stack[*Node]) {
+	if opts.scratch == nil {
+		return
+	}
+	// NOTE: clear references into the tree so that it can be freed
+	clear(tags[:cap(tags)])
+	opts.scratch.tags = tags[:0]
+}
@@ -0,0 +1,73 @@
+package gohtml
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTokenBufsCapped(t *testing.T) {
+	opts := ParseOptions{}
+	opts.releaseTokens(make([]token, 0, 4*maxPooledTokens))
+	for i := 0; i < 4; i++ {
+		if tokens, ok := tokenBufs.Get().(*[]token); ok && cap(*tokens) > maxPooledTokens {
+			t.Fatalf("pooled %d tokens, limit %d", cap(*tokens), maxPooledTokens)
+		}
+	}
+
+	// documents of any size still parse
+	data := []byte(strings.Repeat("<b>x</b>", maxPooledTokens))
+	for i := 0; i < 2; i++ {
+		if _, err, _ := Parse(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Rendering of node, failing the test on errors.
+func rendered(t *testing.T, node *Node) string {
+	t.Helper()
+	buf := bytes.Buffer{}
+	if err := node.Render(&buf); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	return buf.String()
+}
+
+func TestParserReuse(t *testing.T) {
+	docs := []string{
+		"<div><p>a</p><p>b &amp; c</p></div>",
+		strings.Repeat("<ul><li>x<li>y</ul>", 100),
+		"<table><tr><td>1</td></tr></table><svg><circle r='1'/></svg>",
+		"<p>short",
+	}
+
+	p := NewParser(WithMaxNodes(10000))
+	if p.Options.MaxNodes != 10000 {
+		t.Errorf("NewParser(WithMaxNodes(10000)).Options.MaxNodes = %d", p.Options.MaxNodes)
+	}
+	nodes := make([]*Node, len(docs))
+	want := make([]string, len(docs))
+	for i, doc := range docs {
+		node, err, warns := p.Parse([]byte(doc))
+		wantNode, wantErr, wantWarns := Parse([]byte(doc))
+		if (err == nil) != (wantErr == nil) || len(warns) != len(wantWarns) {
+			t.Errorf("Parser.Parse(%q) = %v, %d warnings, want %v, %d", doc, err, len(warns), wantErr, len(wantWarns))
+		}
+		nodes[i], want[i] = node, rendered(t, wantNode)
+		if got := rendered(t, node); got != want[i] {
+			t.Errorf("Parser.Parse(%q) = %q, want %q", doc, got, want[i])
+		}
+		checkParents(t, node)
+	}
+	if cap(p.scratch.tokens) == 0 || p.scratch.tags == nil {
+		t.Errorf("Parser kept no buffers for reuse")
+	}
+
+	// trees from earlier parses aren't touched by later ones
+	for i, node := range nodes {
+		if got := rendered(t, node); got != want[i] {
+			t.Errorf("after reuse, Parser.Parse(%q) = %q, want %q", docs[i], got, want[i])
+		}
+	}
+}
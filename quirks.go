@@ -0,0 +1,195 @@
+package gohtml
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Compatibility mode of a document, as determined by its DOCTYPE per the
+// WHATWG HTML standard.
+type QuirksMode int
+
+const (
+	NoQuirks      QuirksMode = iota // Standards mode
+	LimitedQuirks                   // Almost standards mode
+	Quirks                          // Quirks mode
+)
+
+// Error message-friendly string representation.
+func (mode QuirksMode) String() string {
+	switch mode {
+	case LimitedQuirks:
+		return "LimitedQuirks"
+	case Quirks:
+		return "Quirks"
+	default:
+		return "NoQuirks"
+	}
+}
+
+// Public identifier prefixes that trigger quirks mode.
+var quirksPublicPrefixes = []string{
+	"+//silmaril//dtd html pro v0r11 19970101//",
+	"-//as//dtd html 3.0 aswedit + extensions//",
+	"-//advasoft ltd//dtd html 3.0 aswedit + extensions//",
+	"-//ietf//dtd html 2.0 level 1//",
+	"-//ietf//dtd html 2.0 level 2//",
+	"-//ietf//dtd html 2.0 strict level 1//",
+	"-//ietf//dtd html 2.0 strict level 2//",
+	"-//ietf//dtd html 2.0 strict//",
+	"-//ietf//dtd html 2.0//",
+	"-//ietf//dtd html 2.1e//",
+	"-//ietf//dtd html 3.0//",
+	"-//ietf//dtd html 3.2 final//",
+	"-//ietf//dtd html 3.2//",
+	"-//ietf//dtd html 3//",
+	"-//ietf//dtd html level 0//",
+	"-//ietf//dtd html level 1//",
+	"-//ietf//dtd html level 2//",
+	"-//ietf//dtd html level 3//",
+	"-//ietf//dtd html strict level 0//",
+	"-//ietf//dtd html strict level 1//",
+	"-//ietf//dtd html strict level 2//",
+	"-//ietf//dtd html strict level 3//",
+	"-//ietf//dtd html strict//",
+	"-//ietf//dtd html//",
+	"-//metrius//dtd metrius presentational//",
+	"-//microsoft//dtd internet explorer 2.0 html strict//",
+	"-//microsoft//dtd internet explorer 2.0 html//",
+	"-//microsoft//dtd internet explorer 2.0 tables//",
+	"-//microsoft//dtd internet explorer 3.0 html strict//",
+	"-//microsoft//dtd internet explorer 3.0 html//",
+	"-//microsoft//dtd internet explorer 3.0 tables//",
+	"-//netscape comm. corp.//dtd html//",
+	"-//netscape comm. corp.//dtd strict html//",
+	"-//o'reilly and associates//dtd html 2.0//",
+	"-//o'reilly and associates//dtd html extended 1.0//",
+	"-//o'reilly and associates//dtd html extended relaxed 1.0//",
+	"-//sq//dtd html 2.0 hotmetal + extensions//",
+	"-//softquad software//dtd hotmetal pro 6.0::19990601::extensions to html 4.0//",
+	"-//softquad//dtd hotmetal pro 4.0::19970916::extensions to html 4.0//",
+	"-//spyglass//dtd html 2.0 extended//",
+	"-//sun microsystems corp.//dtd hotjava html//",
+	"-//sun microsystems corp.//dtd hotjava strict html//",
+	"-//w3c//dtd html 3 1995-03-24//",
+	"-//w3c//dtd html 3.2 draft//",
+	"-//w3c//dtd html 3.2 final//",
+	"-//w3c//dtd html 3.2//",
+	"-//w3c//dtd html 3.2s draft//",
+	"-//w3c//dtd html 4.0 frameset//",
+	"-//w3c//dtd html 4.0 transitional//",
+	"-//w3c//dtd html experimental 19960712//",
+	"-//w3c//dtd html experimental 970421//",
+	"-//w3c//dtd w3 html//",
+	"-//w3o//dtd w3 html 3.0//",
+	"-//webtechs//dtd mozilla html 2.0//",
+	"-//webtechs//dtd mozilla html//",
+}
+
+// Public identifiers that trigger quirks mode.
+var quirksPublicIDs = map[string]bool{
+	"-//w3o//dtd w3 html strict 3.0//en//": true,
+	"-/w3c/dtd html 4.0 transitional/en":   true,
+	"html":                                 true,
+}
+
+// Public identifier prefixes that trigger quirks mode if there is no system
+// identifier, or limited-quirks mode otherwise.
+var html401PublicPrefixes = []string{
+	"-//w3c//dtd html 4.01 frameset//",
+	"-//w3c//dtd html 4.01 transitional//",
+}
+
+// Public identifier prefixes that trigger limited-quirks mode.
+var limitedQuirksPublicPrefixes = []string{
+	"-//w3c//dtd xhtml 1.0 frameset//",
+	"-//w3c//dtd xhtml 1.0 transitional//",
+}
+
+// Determine the quirks mode of a document from its tokens.  Documents without
+// a DOCTYPE before their first element are in quirks mode; comments and
+// processing instructions (e.g. <?xml ...?>) may precede it.
+func quirksMode(tokens []token) QuirksMode {
+	for _, tok := range tokens {
+		if tok.Kind == declarationToken {
+			return doctypeQuirksMode(string(tok.Data))
+		} else if tok.Kind != commentToken && tok.Kind != piToken && (tok.Kind != textToken || len(bytes.TrimSpace(tok.Data)) > 0) {
+			break
+		}
+	}
+	return Quirks
+}
+
+// Determine the quirks mode for the contents of a DOCTYPE declaration (e.g.
+// `DOCTYPE html PUBLIC "..." "..."`).
+func doctypeQuirksMode(data string) QuirksMode {
+	data = strings.TrimLeftFunc(data, isSpaceR)
+	if len(data) < len("doctype") || !strings.EqualFold(data[:len("doctype")], "doctype") {
+		return Quirks
+	}
+
+	fields := strings.FieldsFunc(data[len("doctype"):], isSpaceR)
+	if len(fields) == 0 || strings.ToLower(fields[0]) != "html" {
+		return Quirks
+	}
+
+	// public and system identifiers
+	rest := strings.TrimLeftFunc(data[len("doctype"):], isSpaceR)
+	rest = strings.TrimLeftFunc(rest[len(fields[0]):], isSpaceR)
+	var publicID, systemID string
+	var hasPublic, hasSystem, ok bool
+	if len(rest) >= 6 && strings.EqualFold(rest[:6], "public") {
+		hasPublic = true
+		if publicID, rest, ok = cutQuoted(rest[6:]); !ok {
+			return Quirks
+		}
+		systemID, _, hasSystem = cutQuoted(rest)
+	} else if len(rest) >= 6 && strings.EqualFold(rest[:6], "system") {
+		hasSystem = true
+		if systemID, _, ok = cutQuoted(rest[6:]); !ok {
+			return Quirks
+		}
+	}
+
+	publicID = strings.ToLower(publicID)
+	systemID = strings.ToLower(systemID)
+	hasPrefix := func(prefixes []string) bool {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(publicID, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case hasPublic && quirksPublicIDs[publicID]:
+		return Quirks
+	case hasSystem && systemID == "http://www.ibm.com/data/dtd/v11/ibmxhtml1-transitional.dtd":
+		return Quirks
+	case hasPublic && hasPrefix(quirksPublicPrefixes):
+		return Quirks
+	case hasPublic && !hasSystem && hasPrefix(html401PublicPrefixes):
+		return Quirks
+	case hasPublic && hasPrefix(limitedQuirksPublicPrefixes):
+		return LimitedQuirks
+	case hasPublic && hasSystem && hasPrefix(html401PublicPrefixes):
+		return LimitedQuirks
+	}
+	return NoQuirks
+}
+
+// Cut a quoted string, after any whitespace, from the start of s.  Returns the
+// string without quotes, the rest of s, and whether a quoted string was found.
+func cutQuoted(s string) (quoted string, rest string, found bool) {
+	s = strings.TrimLeftFunc(s, isSpaceR)
+	if s == "" || (s[0] != '"' && s[0] != '\'') {
+		return "", s, false
+	}
+
+	end := strings.IndexByte(s[1:], s[0])
+	if end < 0 {
+		return "", s, false
+	}
+	return s[1 : end+1], s[end+2:], true
+}
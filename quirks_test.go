@@ -0,0 +1,40 @@
+package gohtml
+
+import (
+	"testing"
+)
+
+func TestQuirksMode(t *testing.T) {
+	tests := []struct {
+		in   string
+		want QuirksMode
+	}{
+		{"<!DOCTYPE html><p>x", NoQuirks},
+		{"<!doctype HTML>", NoQuirks},
+		{"<!--c-->\n<!DOCTYPE html>", NoQuirks},
+		{`<?xml version="1.0"?><!DOCTYPE html>`, NoQuirks},
+		{"<p>x", Quirks},
+		{"<p>x</p><!DOCTYPE html>", Quirks},
+		{"<!DOCTYPE svg>", Quirks},
+		{`<!DOCTYPE html PUBLIC "-//W3C//DTD HTML 4.01//EN" "http://www.w3.org/TR/html4/strict.dtd">`, NoQuirks},
+		{`<!DOCTYPE html PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN">`, Quirks},
+		{`<!DOCTYPE html PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN" "http://www.w3.org/TR/html4/loose.dtd">`, LimitedQuirks},
+		{`<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">`, LimitedQuirks},
+		{`<!DOCTYPE html PUBLIC "-//IETF//DTD HTML 2.0//EN">`, Quirks},
+		{`<!DOCTYPE html PUBLIC 'html'>`, Quirks},
+		{`<!DOCTYPE html SYSTEM "http://www.ibm.com/data/dtd/v11/ibmxhtml1-transitional.dtd">`, Quirks},
+		{`<!DOCTYPE html SYSTEM "about:legacy-compat">`, NoQuirks},
+		{`<!DOCTYPE html PUBLIC "unterminated>`, Quirks},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		if doc.QuirksMode != test.want {
+			t.Errorf("Parse(%q).QuirksMode = %v, want %v", test.in, doc.QuirksMode, test.want)
+		}
+	}
+}
@@ -0,0 +1,802 @@
+package gohtml
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/transform"
+)
+
+// Renders nodes back to HTML.  The zero value renders nodes purely from their
+// contents.
+type Renderer struct {
+	// Original document the nodes were parsed from.  If non-nil, the renderer
+	// operates in whitespace fidelity mode: tags, text, comments, and
+	// declarations that still match their source are copied verbatim from
+	// Source using the nodes' recorded locations, as is the whitespace
+	// between them, so that only modified nodes are re-rendered, and only the
+	// changed attributes of modified opening tags.
+	Source []byte
+
+	// Size in bytes of the chunks in which output is written.  If positive,
+	// output is buffered and flushed to the writer each time a chunk fills up,
+	// so that memory use stays bounded regardless of document size.  Otherwise,
+	// each piece of output is written to the writer as soon as it is rendered.
+	ChunkSize int
+
+	// Whether to minify output: comments are dropped, runs of whitespace in
+	// text are collapsed to a single space, attribute values are unquoted
+	// where possible, and empty attribute values are dropped, leaving only
+	// the key.  Source is ignored when minifying.
+	Minify bool
+
+	// Raw text elements, keyed by lowercase tag name, whose text is written
+	// as is rather than escaped.  If nil, DefaultRawTextTags is used; should
+	// match the ParseOptions.RawTextTags the nodes were parsed with.
+	RawTextTags map[string]bool
+
+	// Void elements, keyed by lowercase tag name, which are written without a
+	// closing tag unless they have children.  If nil, DefaultVoidTags is used;
+	// should match the ParseOptions.VoidTags the nodes were parsed with.
+	VoidTags map[string]bool
+
+	// Policies for escaping text and attribute values that are rendered
+	// rather than copied from Source.
+	TextEscaping Escaping
+	AttrEscaping Escaping
+
+	// Label of the character encoding to write output in (e.g.
+	// "iso-8859-1"), per the WHATWG Encoding standard.  If empty, output is
+	// written in UTF-8.  Characters the encoding can't represent are written
+	// as numeric character references (e.g. &#8364;).  Source must still be
+	// UTF-8, and any <meta charset> element is written as is.
+	Encoding string
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "\"", "&quot;")
+)
+
+// Render the node and its descendants as HTML to w.  Text and attribute
+// values are escaped, except for the contents of <script> and <style>
+// elements, which are written as is.
+func (node *Node) Render(w io.Writer) error {
+	return (&Renderer{}).Render(w, node)
+}
+
+// Render the node and its descendants as HTML, returning the result.
+func (node *Node) RenderBytes() []byte {
+	buf := bytes.Buffer{}
+	node.Render(&buf)
+	return buf.Bytes()
+}
+
+// Render the node's children (but not the node itself) as HTML to w.
+func (node *Node) RenderChildren(w io.Writer) error {
+	return (&Renderer{}).RenderChildren(w, node)
+}
+
+// Render the node's children (but not the node itself) as HTML, returning the
+// result.
+func (node *Node) InnerHTMLBytes() []byte {
+	buf := bytes.Buffer{}
+	node.RenderChildren(&buf)
+	return buf.Bytes()
+}
+
+// Render node and its descendants as HTML to w.
+func (r *Renderer) Render(w io.Writer, node *Node) error {
+	return r.chunked(w, func(w io.Writer) error {
+		return r.render(w, node, false)
+	})
+}
+
+// Render node's children (but not node itself) as HTML to w.
+func (r *Renderer) RenderChildren(w io.Writer, node *Node) error {
+	verbatim := node.Kind == ElementNode && r.rawTextTags()[node.Content]
+	return r.chunked(w, func(w io.Writer) error {
+		return r.renderChildren(w, node, verbatim, nil)
+	})
+}
+
+// Raw text elements in effect.
+func (r *Renderer) rawTextTags() map[string]bool {
+	if r.RawTextTags == nil {
+		return DefaultRawTextTags
+	}
+	return r.RawTextTags
+}
+
+// Void elements in effect.
+func (r *Renderer) voidTags() map[string]bool {
+	if r.VoidTags == nil {
+		return DefaultVoidTags
+	}
+	return r.VoidTags
+}
+
+// Whether an element is one of the void elements in effect.  Foreign elements
+// are never void.
+func (r *Renderer) isVoid(node *Node) bool {
+	return node.Namespace == HTMLNamespace && r.voidTags()[node.Content]
+}
+
+// Call render with w, encoded according to r.Encoding and buffered into
+// chunks according to r.ChunkSize.
+func (r *Renderer) chunked(w io.Writer, render func(io.Writer) error) error {
+	if r.Source != nil {
+		render = withTails(render)
+	}
+
+	if r.ChunkSize <= 0 {
+		return r.encoded(w, render)
+	}
+
+	bw := bufio.NewWriterSize(w, r.ChunkSize)
+	if err := r.encoded(bw, render); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// Call render with w, transcoded from UTF-8 according to r.Encoding.
+func (r *Renderer) encoded(w io.Writer, render func(io.Writer) error) error {
+	if isUTF8Charset(r.Encoding) {
+		return render(w)
+	}
+
+	enc, err := htmlindex.Get(r.Encoding)
+	if err != nil {
+		return fmt.Errorf("error encoding output: %w: %q", CharsetErr, r.Encoding)
+	}
+	tw := transform.NewWriter(w, encoding.HTMLEscapeUnsupported(enc.NewEncoder()))
+	if err := render(tw); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// Writer holding back source left unterminated at the end of the source (e.g.
+// "<!-- x"), which may only be copied as is if nothing follows it.  Once
+// anything else is written, the held back source is written in its
+// terminated form first.
+type tailWriter struct {
+	w     io.Writer
+	tails []heldTail
+}
+
+type heldTail struct {
+	source     []byte // Written if nothing follows
+	terminated string // Written if anything follows
+}
+
+func (tw *tailWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for _, tail := range tw.tails {
+		if _, err := io.WriteString(tw.w, tail.terminated); err != nil {
+			return 0, err
+		}
+	}
+	tw.tails = tw.tails[:0]
+	return tw.w.Write(p)
+}
+
+// Write the held back source as is, since nothing follows it.
+func (tw *tailWriter) Close() error {
+	for _, tail := range tw.tails {
+		if _, err := tw.w.Write(tail.source); err != nil {
+			return err
+		}
+	}
+	tw.tails = nil
+	return nil
+}
+
+// Wrap render to write to a tailWriter.
+func withTails(render func(io.Writer) error) func(io.Writer) error {
+	return func(w io.Writer) error {
+		tw := &tailWriter{w: w}
+		if err := render(tw); err != nil {
+			return err
+		}
+		return tw.Close()
+	}
+}
+
+// Write source unterminated at the end of the source to w, or terminated if
+// anything follows it; see tailWriter.
+func writeTail(w io.Writer, source []byte, terminated string) error {
+	if tw, ok := w.(*tailWriter); ok {
+		tw.tails = append(tw.tails, heldTail{source, terminated})
+		return nil
+	}
+	_, err := w.Write(source)
+	return err
+}
+
+// Whether node has a valid location range within the source.
+func (r *Renderer) inSource(node *Node) bool {
+	return r.Source != nil && !r.Minify &&
+		node.Loc.Pos >= 0 &&
+		node.EndLoc.Pos > node.Loc.Pos &&
+		node.EndLoc.Pos <= len(r.Source)
+}
+
+func (r *Renderer) render(w io.Writer, node *Node, verbatim bool) error {
+	switch node.Kind {
+	case DocumentNode:
+		return r.renderChildren(w, node, false, nil)
+	case ElementNode:
+		return r.renderElement(w, node, nil)
+	case TextNode:
+		return r.renderText(w, node, verbatim)
+	case CommentNode:
+		return r.renderComment(w, node)
+	case DeclarationNode:
+		return r.renderDeclaration(w, node)
+	case CDATANode:
+		return r.renderCDATA(w, node)
+	case ProcessingInstructionNode:
+		return r.renderProcessingInstruction(w, node)
+	case ConditionalCommentNode:
+		return r.renderConditional(w, node)
+	default:
+		// stray closing tags and the like; keep them only if copying source
+		if r.inSource(node) {
+			_, err := w.Write(r.Source[node.Loc.Pos:node.EndLoc.Pos])
+			return err
+		}
+		return nil
+	}
+}
+
+// Children of node in the order of their source, for copying it: nodes
+// foster parented out of a table (e.g. "a" in "<table><tr>a<td>") are moved
+// back into the table, within which their source is, so that the source is
+// copied as written rather than reordered.  fostered are nodes foster parented
+// out of node or its descendants.  Returns the children, along with the nodes
+// foster parented out of each child.
+func (r *Renderer) sourceChildren(node *Node, fostered []*Node) ([]*Node, map[*Node][]*Node) {
+	children := node.Children
+	within := func(node *Node, container *Node) bool {
+		return container.Kind == ElementNode && r.inSource(node) && r.inSource(container) &&
+			node.Loc.Pos > container.Loc.Pos && node.EndLoc.Pos <= container.EndLoc.Pos
+	}
+
+	// nodes foster parented out of a table precede it, while their source is
+	// within its source
+	var into map[*Node][]*Node
+	var moved map[*Node]bool
+	var container *Node
+	for i := len(children) - 1; i >= 0; i-- {
+		child := children[i]
+		if container != nil && within(child, container) {
+			if into == nil {
+				into = make(map[*Node][]*Node)
+				moved = make(map[*Node]bool)
+			}
+			into[container] = append(into[container], child)
+			moved[child] = true
+		} else if r.inSource(child) {
+			container = child
+		}
+	}
+	if into == nil && len(fostered) == 0 {
+		return children, nil
+	} else if into == nil {
+		into = make(map[*Node][]*Node)
+	}
+
+	ordered := make([]*Node, 0, len(children)+len(fostered))
+	for _, child := range children {
+		if moved[child] {
+			continue
+		}
+
+		if r.inSource(child) {
+			for len(fostered) > 0 && fostered[0].Loc.Pos < child.Loc.Pos {
+				ordered = append(ordered, fostered[0])
+				fostered = fostered[1:]
+			}
+			for len(fostered) > 0 && within(fostered[0], child) {
+				into[child] = append(into[child], fostered[0])
+				fostered = fostered[1:]
+			}
+		}
+		ordered = append(ordered, child)
+	}
+	ordered = append(ordered, fostered...)
+
+	for _, nodes := range into {
+		slices.SortFunc(nodes, func(a, b *Node) int {
+			return a.Loc.Pos - b.Loc.Pos
+		})
+	}
+	return ordered, into
+}
+
+// Render node's children; see sourceChildren for fostered.
+func (r *Renderer) renderChildren(w io.Writer, node *Node, verbatim bool, fostered []*Node) error {
+	prevEnd := -1
+	if r.inSource(node) {
+		prevEnd = node.Loc.Pos
+		if node.Kind == ElementNode {
+			_, prevEnd = r.sourceOpenTag(node)
+		} else if node.Kind == ConditionalCommentNode {
+			prevEnd = -1
+			if n := conditionalStart(r.Source[node.Loc.Pos:node.EndLoc.Pos], 0, nil, nil); n > 0 {
+				prevEnd = node.Loc.Pos + n
+			}
+		}
+	}
+
+	children, into := r.sourceChildren(node, fostered)
+	for _, child := range children {
+		if prevEnd >= 0 && r.inSource(child) && child.Loc.Pos >= prevEnd {
+			// copy whitespace that the parser dropped between nodes
+			gap := r.Source[prevEnd:child.Loc.Pos]
+			if len(bytes.TrimSpace(gap)) == 0 {
+				if _, err := w.Write(gap); err != nil {
+					return err
+				}
+			}
+		}
+
+		var err error
+		if fosteredOut, ok := into[child]; ok && child.Kind == ElementNode {
+			err = r.renderElement(w, child, fosteredOut)
+		} else {
+			err = r.render(w, child, verbatim)
+		}
+		if err != nil {
+			return err
+		}
+
+		prevEnd = -1
+		if r.inSource(child) {
+			prevEnd = child.EndLoc.Pos
+		}
+	}
+
+	if node.Kind == DocumentNode && prevEnd >= 0 && r.inSource(node) && node.EndLoc.Pos >= prevEnd {
+		gap := r.Source[prevEnd:node.EndLoc.Pos]
+		if len(bytes.TrimSpace(gap)) == 0 {
+			if _, err := w.Write(gap); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Byte offset just past the node's opening tag in the source, or -1 if the
+// source does not contain an opening tag matching the node.
+func (r *Renderer) sourceOpenTagEnd(node *Node) int {
+	if !r.inSource(node) || !bytes.HasPrefix(r.Source[node.Loc.Pos:], tagStart) {
+		return -1
+	}
+
+	tok, loc, err := lexTagOpen(r.Source[:node.EndLoc.Pos], node.Loc)
+	if err != nil {
+		return -1
+	}
+
+	orig, err, _ := parseOpenTag(tok, 0, nil)
+	if err == nil {
+		orig.Namespace = node.Namespace
+		adjustForeignNames(orig)
+	}
+	if err != nil || orig.Content != node.Content || len(orig.Attrs) != len(node.Attrs) {
+		return -1
+	}
+	for key, val := range orig.Attrs {
+		if nodeVal, ok := node.Attrs[key]; !ok || nodeVal != val {
+			return -1
+		}
+	}
+
+	return loc.Pos
+}
+
+// Opening tag of the element as written in the source, with only the
+// attributes changed, added, or removed since parsing rewritten, so that the
+// rest of the tag keeps its spacing and quoting.  Returns the tag and the byte
+// offset just past it in the source, or nil and -1 if the source does not
+// contain an opening tag with the node's tag name.
+func (r *Renderer) sourceOpenTag(node *Node) ([]byte, int) {
+	if !r.inSource(node) || !bytes.HasPrefix(r.Source[node.Loc.Pos:], tagStart) {
+		return nil, -1
+	}
+
+	tok, loc, err := lexTagOpen(r.Source[:node.EndLoc.Pos], node.Loc)
+	if err != nil {
+		return nil, -1
+	}
+	orig, err, _ := parseOpenTag(tok, 0, nil)
+	if err != nil {
+		return nil, -1
+	}
+	orig.Namespace = node.Namespace
+	adjustForeignNames(orig)
+	if orig.Content != node.Content {
+		return nil, -1
+	}
+
+	tag := r.Source[node.Loc.Pos:loc.Pos]
+	if maps.Equal(orig.Attrs, node.Attrs) {
+		return tag, loc.Pos
+	}
+
+	buf := make([]byte, 0, len(tag)+32)
+	pos, attrsEnd := node.Loc.Pos, node.Loc.Pos
+	for _, key := range orig.AttrOrder {
+		start, end := r.sourceAttrSpan(orig.AttrLocs[key])
+		attrsEnd = end
+		val, ok := node.Attrs[key]
+		if ok && val == orig.Attrs[key] {
+			continue
+		}
+
+		if !ok {
+			// drop a removed attribute along with the spaces before it
+			for start > pos && isSpace(r.Source[start-1]) {
+				start--
+			}
+		}
+		buf = append(buf, r.Source[pos:start]...)
+		if ok {
+			buf = append(buf, r.attr(node, key)...)
+		}
+		pos = end
+	}
+
+	// add new attributes just before the end of the tag, e.g. the "/>" of a
+	// self-closing tag, unless the '/' is part of the last value
+	insert := loc.Pos - len(tagEnd)
+	if bytes.HasSuffix(tag, tagSelfcloseEnd) {
+		insert = max(loc.Pos-len(tagSelfcloseEnd), attrsEnd)
+	}
+	buf = append(buf, r.Source[pos:insert]...)
+	for _, key := range node.AttrKeys() {
+		if _, ok := orig.Attrs[node.attrKey(key)]; !ok {
+			buf = append(buf, ' ')
+			buf = append(buf, r.attr(node, key)...)
+		}
+	}
+	buf = append(buf, r.Source[insert:loc.Pos]...)
+	return buf, loc.Pos
+}
+
+// Byte offsets of the start and end of an attribute in the source, including
+// any quotes around its value.
+func (r *Renderer) sourceAttrSpan(loc AttrLoc) (start, end int) {
+	start, end = loc.Key.Start.Pos, loc.Key.End.Pos
+	if loc.Val == (Span{}) {
+		return start, end
+	}
+	end = loc.Val.End.Pos
+	if open := loc.Val.Start.Pos - 1; open > start && (r.Source[open] == '"' || r.Source[open] == '\'') &&
+		end < len(r.Source) && r.Source[end] == r.Source[open] {
+		end++
+	}
+	return start, end
+}
+
+// Whether the node's opening tag in the source is self-closing (e.g.
+// <circle/>).
+func (r *Renderer) sourceSelfcloses(node *Node) bool {
+	if !r.inSource(node) || !bytes.HasPrefix(r.Source[node.Loc.Pos:], tagStart) {
+		return false
+	}
+	_, loc, err := lexTagOpen(r.Source[:node.EndLoc.Pos], node.Loc)
+	return err == nil && bytes.HasSuffix(r.Source[:loc.Pos], tagSelfcloseEnd)
+}
+
+// Byte offset of the node's closing tag in the source, or -1 if the source
+// does not contain a closing tag matching the node.
+func (r *Renderer) sourceCloseTagStart(node *Node) int {
+	if !r.inSource(node) {
+		return -1
+	}
+
+	data := r.Source[node.Loc.Pos:node.EndLoc.Pos]
+	i := bytes.LastIndex(data, closeTagStart)
+	if i < 0 || !bytes.HasSuffix(data, tagEnd) {
+		return -1
+	}
+
+	name, _ := splitCloseTag(data[i+len(closeTagStart) : len(data)-len(tagEnd)])
+	if !strings.EqualFold(string(name), node.Content) {
+		return -1
+	}
+
+	// NOTE: the closing tag may instead belong to the last child, e.g. the
+	// inner </svg> of "<svg><svg></svg>"
+	for _, child := range node.Children {
+		if r.inSource(child) && child.EndLoc.Pos > node.Loc.Pos+i {
+			return -1
+		}
+	}
+
+	return node.Loc.Pos + i
+}
+
+// Render an element; see sourceChildren for fostered.
+func (r *Renderer) renderElement(w io.Writer, node *Node, fostered []*Node) error {
+	// foreign elements without children are written as self-closing tags,
+	// unless their source says otherwise
+	foreign := node.Namespace != HTMLNamespace
+	selfclosing := foreign && len(node.Children) == 0
+
+	tag, _ := r.sourceOpenTag(node)
+	if tag != nil && foreign && bytes.HasSuffix(tag, tagSelfcloseEnd) != selfclosing {
+		if selfclosing {
+			selfclosing = false
+		} else {
+			tag = nil
+		}
+	}
+
+	if tag != nil {
+		if _, err := w.Write(tag); err != nil {
+			return err
+		}
+	} else if err := r.renderOpenTag(w, node, selfclosing); err != nil {
+		return err
+	}
+
+	// NOTE: void elements given children are closed so as not to drop them
+	if r.isVoid(node) && len(node.Children) == 0 || selfclosing {
+		return nil
+	}
+
+	if err := r.renderChildren(w, node, r.rawTextTags()[node.Content], fostered); err != nil {
+		return err
+	}
+
+	if start := r.sourceCloseTagStart(node); start >= 0 {
+		_, err := w.Write(r.Source[start:node.EndLoc.Pos])
+		return err
+	} else if r.inSource(node) && !r.isVoid(node) && (!foreign || !r.sourceSelfcloses(node)) {
+		// NOTE: closing tag omitted or misnested in the source; don't add one,
+		// unless anything follows an unterminated raw text element
+		if !foreign && (r.rawTextTags()[node.Content] || rcdataTags[node.Content]) && node.EndLoc.Pos == len(r.Source) {
+			return writeTail(w, nil, "</"+tagName(node)+">")
+		}
+		return nil
+	}
+
+	_, err := io.WriteString(w, "</"+tagName(node)+">")
+	return err
+}
+
+// Render the opening tag of an element; as a self-closing tag (e.g.
+// <circle/>) if selfclosing.
+func (r *Renderer) renderOpenTag(w io.Writer, node *Node, selfclosing bool) error {
+	buf := strings.Builder{}
+	buf.WriteString("<")
+	buf.WriteString(tagName(node))
+
+	for _, key := range node.AttrKeys() {
+		buf.WriteString(" ")
+		buf.WriteString(r.attr(node, key))
+	}
+
+	if selfclosing {
+		buf.WriteString("/>")
+	} else {
+		buf.WriteString(">")
+	}
+	_, err := io.WriteString(w, buf.String())
+	return err
+}
+
+// Render the attribute key of an element, e.g. `class="a"`.
+func (r *Renderer) attr(node *Node, key string) string {
+	// NOTE: keys set directly in node.Attrs may be in any case
+	name := node.attrKey(key)
+
+	val := node.Attrs[key]
+	if r.Minify {
+		if val == "" {
+			return name
+		} else if strings.IndexFunc(val, needsQuotes) < 0 {
+			return name + "=" + r.AttrEscaping.escapeAttr(val)
+		}
+	} else if raw, ok := node.RawAttrs[key]; ok && rawAttrVal(raw) == val {
+		// keep the attribute as written if its value is unchanged
+		if raw != "" {
+			return name + "=" + raw
+		}
+		return name
+	}
+
+	return name + "=\"" + r.AttrEscaping.escapeAttr(val) + "\""
+}
+
+// Whether an attribute value containing r must be quoted.
+func needsQuotes(r rune) bool {
+	return isSpaceR(r) || strings.ContainsRune("\"'=<>`", r)
+}
+
+// Value of a raw attribute value, as parsed.
+func rawAttrVal(raw string) string {
+	val, _ := expandEntitysString(unquote(raw), Location{}, true)
+	return val
+}
+
+func (r *Renderer) renderText(w io.Writer, node *Node, verbatim bool) error {
+	if r.inSource(node) {
+		data := r.Source[node.Loc.Pos:node.EndLoc.Pos]
+		exp := string(data)
+		if !verbatim && !node.Unexpanded {
+			exp, _ = expandEntitys(data, node.Loc, false)
+		}
+		if exp == node.Content && !verbatim && node.EndLoc.Pos == len(r.Source) && bytes.ContainsAny(data, "<&") {
+			// NOTE: e.g. unterminated markup kept as text, which would
+			// swallow anything following it
+			terminated := r.TextEscaping.escapeText(node.Content)
+			if node.Unexpanded {
+				terminated = strings.ReplaceAll(node.Content, "<", "&lt;")
+			}
+			return writeTail(w, data, terminated)
+		} else if exp == node.Content {
+			_, err := w.Write(data)
+			return err
+		}
+	}
+
+	if verbatim {
+		_, err := io.WriteString(w, node.Content)
+		return err
+	}
+
+	content := node.Content
+	if r.Minify && !isPreformatted(node) {
+		if node.Parent != nil && spacelessTags[node.Parent.Content] && strings.TrimFunc(content, isSpaceR) == "" {
+			return nil
+		}
+		content = collapseSpace(content)
+	}
+
+	if node.Unexpanded {
+		// already escaped
+		_, err := io.WriteString(w, content)
+		return err
+	}
+	_, err := io.WriteString(w, r.TextEscaping.escapeText(content))
+	return err
+}
+
+// Elements whose text is rendered with its whitespace intact.
+var preformattedTags = map[string]bool{
+	"pre":      true,
+	"listing":  true,
+	"textarea": true,
+}
+
+// Whether node is within an element whose whitespace must be preserved.
+func isPreformatted(node *Node) bool {
+	for anc := node.Parent; anc != nil; anc = anc.Parent {
+		if anc.Kind == ElementNode && anc.Namespace == HTMLNamespace && preformattedTags[anc.Content] {
+			return true
+		}
+	}
+	return false
+}
+
+// Elements in which whitespace-only text is never rendered.
+var spacelessTags = map[string]bool{
+	"html":     true,
+	"head":     true,
+	"table":    true,
+	"thead":    true,
+	"tbody":    true,
+	"tfoot":    true,
+	"tr":       true,
+	"colgroup": true,
+	"ul":       true,
+	"ol":       true,
+	"dl":       true,
+	"select":   true,
+	"optgroup": true,
+}
+
+// Collapse runs of whitespace in s to a single space.
+func collapseSpace(s string) string {
+	buf := strings.Builder{}
+	buf.Grow(len(s))
+
+	inSpace := false
+	for _, c := range []byte(s) {
+		if !isSpace(c) {
+			buf.WriteByte(c)
+			inSpace = false
+		} else if !inSpace {
+			buf.WriteByte(' ')
+			inSpace = true
+		}
+	}
+
+	return buf.String()
+}
+
+func (r *Renderer) renderComment(w io.Writer, node *Node) error {
+	if r.Minify {
+		return nil
+	}
+
+	if r.inSource(node) {
+		data := r.Source[node.Loc.Pos:node.EndLoc.Pos]
+		if string(data) == "<!--"+node.Content+"-->" {
+			_, err := w.Write(data)
+			return err
+		} else if string(data) == "<!--"+node.Content && node.EndLoc.Pos == len(r.Source) {
+			// NOTE: comments left unterminated at the end of the source are
+			// copied as is, unless anything follows them
+			return writeTail(w, data, "<!--"+node.Content+"-->")
+		}
+	}
+
+	_, err := io.WriteString(w, "<!--"+node.Content+"-->")
+	return err
+}
+
+func (r *Renderer) renderCDATA(w io.Writer, node *Node) error {
+	_, err := io.WriteString(w, "<![CDATA["+node.Content+"]]>")
+	return err
+}
+
+func (r *Renderer) renderProcessingInstruction(w io.Writer, node *Node) error {
+	if r.inSource(node) {
+		// keep bogus <?...> forms as written, unless the content has changed
+		data := r.Source[node.Loc.Pos:node.EndLoc.Pos]
+		if string(data) == "<?"+node.Content+"?>" || string(data) == "<?"+node.Content+">" {
+			_, err := w.Write(data)
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "<?"+node.Content+"?>")
+	return err
+}
+
+// Render a conditional comment, which is kept even when minifying since
+// browsers that support it (e.g. Outlook) treat its contents as markup.
+func (r *Renderer) renderConditional(w io.Writer, node *Node) error {
+	open, close := conditionalDelims(node)
+	if _, err := io.WriteString(w, open); err != nil {
+		return err
+	}
+	if err := r.renderChildren(w, node, false, nil); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, close)
+	return err
+}
+
+func (r *Renderer) renderDeclaration(w io.Writer, node *Node) error {
+	if r.inSource(node) {
+		data := r.Source[node.Loc.Pos:node.EndLoc.Pos]
+		inner := bytes.TrimPrefix(data, declarationStart)
+		inner = bytes.TrimSuffix(inner, tagEnd)
+		if string(bytes.TrimSpace(inner)) == node.Content {
+			_, err := w.Write(data)
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "<!"+node.Content+">")
+	return err
+}
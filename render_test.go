@@ -0,0 +1,412 @@
+package gohtml
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"strings"
+	"testing"
+)
+
+// Render node in whitespace fidelity mode with src as its source.
+func renderFidelity(t *testing.T, src string, node *Node) string {
+	t.Helper()
+	buf := bytes.Buffer{}
+	if err := (&Renderer{Source: []byte(src)}).Render(&buf, node); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	return buf.String()
+}
+
+func TestRenderFidelityFostered(t *testing.T) {
+	tests := []string{
+		"<table>oops<tr><td>x</td></tr></table>",
+		"<p>a</p><table>b<tr>c <td>d</td>e</tr>f</table>g",
+		"<table><div>x<b>y</b></div><tr></tr></table>",
+	}
+
+	for _, src := range tests {
+		node, err, _ := Parse([]byte(src))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", src, err)
+		}
+		if got := renderFidelity(t, src, node); got != src {
+			t.Errorf("Render(%q) = %q", src, got)
+		}
+	}
+}
+
+func TestRenderFidelityFosteredModified(t *testing.T) {
+	src := "<table>oops<tr><td>x</td></tr></table>"
+	node, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.Children[0].Content = "OOPS"
+
+	want := "<table>OOPS<tr><td>x</td></tr></table>"
+	if got := renderFidelity(t, src, node); got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+}
+
+func TestRenderVoidTags(t *testing.T) {
+	voidTags := maps.Clone(DefaultVoidTags)
+	delete(voidTags, "wbr")
+	voidTags["x-icon"] = true
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<div><wbr>caption</wbr></div>", "<div><wbr>caption</wbr></div>"},
+		{"<div><x-icon>caption</div>", "<div><x-icon>caption</div>"},
+		{"<div><br>x</div>", "<div><br>x</div>"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := ParseWithOptions([]byte(test.in), WithVoidTags(voidTags))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.in, err)
+		}
+		buf := bytes.Buffer{}
+		if err := (&Renderer{VoidTags: voidTags}).Render(&buf, node); err != nil {
+			t.Fatalf("Render: %v", err)
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("Render(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestRenderVoidWithChildren(t *testing.T) {
+	node, err, _ := Parse([]byte("<p><br></p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	br := node.Children[0].Children[0]
+	br.Children = append(br.Children, &Node{Kind: TextNode, Content: "x", Parent: br})
+
+	if got, want := string(node.RenderBytes()), "<p><br>x</br></p>"; got != want {
+		t.Errorf("Render = %q, want %q", got, want)
+	}
+}
+
+func TestRenderFidelityOmittedEndTags(t *testing.T) {
+	tests := []string{
+		"<ul><li>a<li>b</ul>",
+		"<b><i>x</b>y</i>",
+		"<p>a",
+		"<div><p>a<p>b</div>c",
+		"<table><tr><td>a<td>b</table>",
+		"<svg><svg></svg>",
+		"<svg><circle/><g>x</svg>",
+	}
+
+	for _, src := range tests {
+		node, err, _ := Parse([]byte(src))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", src, err)
+		}
+		if got := renderFidelity(t, src, node); got != src {
+			t.Errorf("Render(%q) = %q", src, got)
+		}
+	}
+}
+
+func TestRenderFidelityClosesGivenChildren(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"<p><br></p>", "<p><br>x</br></p>"},
+		{"<svg><circle/></svg>", "<svg><circle>x</circle></svg>"},
+	}
+
+	for _, test := range tests {
+		node, err, _ := Parse([]byte(test.src))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		parent := node.Children[0].Children[0]
+		parent.Children = append(parent.Children, &Node{Kind: TextNode, Content: "x", Parent: parent})
+		if got := renderFidelity(t, test.src, node); got != test.want {
+			t.Errorf("Render(%q) = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
+
+func TestRenderFidelityUnterminated(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string // with an implied document
+	}{
+		{`<div class="x`, `<html><head></head><body>&lt;div class="x</body></html>`},
+		{`<!-- unterminated`, `<!-- unterminated--><html><head></head><body></body></html>`},
+		{`<p>a<!-- c`, `<html><head></head><body><p>a<!-- c--></body></html>`},
+		{`<p>a<script>if (a<b)`, `<html><head></head><body><p>a<script>if (a<b)</script></body></html>`},
+		{`<title>a<b`, `<html><head><title>a&lt;b</title></head><body></body></html>`},
+		{`<p>x &am`, `<html><head></head><body><p>x &amp;am</body></html>`},
+	}
+
+	for _, test := range tests {
+		// copied as is if nothing follows
+		node, err, _ := Parse([]byte(test.src))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		if got := renderFidelity(t, test.src, node); got != test.src {
+			t.Errorf("Render(%q) = %q", test.src, got)
+		}
+
+		// terminated if anything follows
+		node, err, _ = ParseWithOptions([]byte(test.src), WithImpliedDocument())
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		got := renderFidelity(t, test.src, node)
+		if got != test.want {
+			t.Errorf("Render(%q) = %q, want %q", test.src, got, test.want)
+		}
+		reparsed, _, _ := ParseWithOptions([]byte(got), WithImpliedDocument())
+		if a, b := string(reparsed.RenderBytes()), string(node.RenderBytes()); a != b {
+			t.Errorf("Render(%q) reparses as %q, want %q", test.src, a, b)
+		}
+	}
+}
+
+// Writer recording the size of each write.
+type writeSizes struct {
+	bytes.Buffer
+	sizes []int
+}
+
+func (w *writeSizes) Write(p []byte) (int, error) {
+	w.sizes = append(w.sizes, len(p))
+	return w.Buffer.Write(p)
+}
+
+func TestRenderChunked(t *testing.T) {
+	src := strings.Repeat(`<div class="a"><p>x &amp; y</p><!--c--></div>`, 200)
+	node, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const chunkSize = 256
+	w := writeSizes{}
+	if err := (&Renderer{ChunkSize: chunkSize}).Render(&w, node); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.String(); got != src {
+		t.Errorf("chunked output differs from the unchunked output")
+	}
+	for i, size := range w.sizes[:len(w.sizes)-1] {
+		if size != chunkSize {
+			t.Errorf("write %d of %d bytes, want %d", i, size, chunkSize)
+		}
+	}
+}
+
+func TestInnerHTML(t *testing.T) {
+	tests := []struct {
+		in   string
+		tag  string
+		want string
+	}{
+		{"<div><p>a &amp; b</p>c<br></div>", "div", "<p>a &amp; b</p>c<br>"},
+		{"<div></div>", "div", ""},
+		{"<script>if (a < b) {}</script>", "script", "if (a < b) {}"},
+		{"<ul><li>x<li>y</ul>", "ul", "<li>x</li><li>y</li>"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		node := doc.Find(test.tag)
+		if got := string(node.InnerHTMLBytes()); got != test.want {
+			t.Errorf("InnerHTMLBytes(%q) = %q, want %q", test.in, got, test.want)
+		}
+		var buf bytes.Buffer
+		if err := node.RenderChildren(&buf); err != nil || buf.String() != test.want {
+			t.Errorf("RenderChildren(%q) = %q, %v, want %q", test.in, buf.String(), err, test.want)
+		}
+	}
+
+	doc, _, _ := Parse([]byte("<p>a</p><p>b</p>"))
+	if got, want := string(doc.InnerHTMLBytes()), string(doc.RenderBytes()); got != want {
+		t.Errorf("document InnerHTMLBytes = %q, want %q", got, want)
+	}
+}
+
+func TestRenderEscaping(t *testing.T) {
+	tests := []struct {
+		node *Node
+		want string
+	}{
+		{&Node{Kind: TextNode, Content: `a < b & c > "d"`}, `a &lt; b &amp; c &gt; "d"`},
+		{&Node{Kind: ElementNode, Content: "a", Attrs: map[string]string{"title": `"q" & 'a' <b>`}}, `<a title="&quot;q&quot; &amp; 'a' <b>"></a>`},
+		{&Node{Kind: ElementNode, Content: "script", Children: []*Node{{Kind: TextNode, Content: "a < b && c"}}}, "<script>a < b && c</script>"},
+		{&Node{Kind: ElementNode, Content: "textarea", Children: []*Node{{Kind: TextNode, Content: "a < b"}}}, "<textarea>a &lt; b</textarea>"},
+		{&Node{Kind: CommentNode, Content: " note "}, "<!-- note -->"},
+		{&Node{Kind: DeclarationNode, Content: "DOCTYPE html"}, "<!DOCTYPE html>"},
+		{&Node{Kind: ElementNode, Content: "br"}, "<br>"},
+	}
+
+	for _, test := range tests {
+		if got := string(test.node.RenderBytes()); got != test.want {
+			t.Errorf("RenderBytes(%v %q) = %q, want %q", test.node.Kind, test.node.Content, got, test.want)
+		}
+	}
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	tests := []string{
+		`<!DOCTYPE html><html><head><title>a &amp; b</title></head><body><p class="x">1 &lt; 2</p></body></html>`,
+		`<div title="&quot;q&quot;"><!--c--><br><script>if (a < b) {}</script></div>`,
+	}
+
+	for _, src := range tests {
+		doc, err, _ := Parse([]byte(src))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", src, err)
+			continue
+		}
+		out := doc.RenderBytes()
+		reparsed, _, _ := Parse(out)
+		if again := reparsed.RenderBytes(); !bytes.Equal(again, out) {
+			t.Errorf("Render(Parse(%q)) = %q, re-rendered as %q", src, out, again)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestRenderWriterError(t *testing.T) {
+	doc, _, _ := Parse([]byte("<p>a</p>"))
+	if err := doc.Render(failingWriter{}); err == nil {
+		t.Error("Render to a failing writer returned no error")
+	}
+}
+
+func TestRenderMinify(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"<p>a  \n\t b</p>", "<p>a b</p>"},
+		{"<p>a<!-- note -->b</p>", "<p>ab</p>"},
+		{`<input type="text" disabled="" value="a b">`, `<input type=text disabled value="a b">`},
+		{`<a href='x=y' title="a&amp;b">x</a>`, `<a href="x=y" title=a&amp;b>x</a>`},
+		{"<pre>a  \n b</pre>", "<pre>a  \n b</pre>"},
+		{"<table>\n  <tr>\n    <td> x  y </td>\n  </tr>\n</table>", "<table><tr><td> x y </td></tr></table>"},
+		{"<!--[if mso]><p>a  b</p><![endif]-->", "<!--[if mso]><p>a b</p><![endif]-->"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.in))
+		if err != nil {
+			t.Errorf("Parse(%q): %v", test.in, err)
+			continue
+		}
+		buf := bytes.Buffer{}
+		if err := (&Renderer{Minify: true, Source: []byte(test.in)}).Render(&buf, doc); err != nil {
+			t.Errorf("Render(%q): %v", test.in, err)
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("Render(%q) minified = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestRenderEncoding(t *testing.T) {
+	src := `<p title="café €">café € 日本</p>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		encoding string
+		want     string
+	}{
+		{"", src},
+		{"utf-8", src},
+		{"iso-8859-2", "<p title=\"caf\xe9 &#8364;\">caf\xe9 &#8364; &#26085;&#26412;</p>"},
+		{"windows-1252", "<p title=\"caf\xe9 \x80\">caf\xe9 \x80 &#26085;&#26412;</p>"},
+		{"iso-8859-1", "<p title=\"caf\xe9 \x80\">caf\xe9 \x80 &#26085;&#26412;</p>"},
+	}
+
+	for _, test := range tests {
+		buf := bytes.Buffer{}
+		if err := (&Renderer{Encoding: test.encoding}).Render(&buf, doc); err != nil {
+			t.Errorf("Render in %q: %v", test.encoding, err)
+			continue
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("Render in %q = %q, want %q", test.encoding, got, test.want)
+		}
+		reparsed, _, _ := ParseWithOptions(buf.Bytes(), WithEncoding(test.encoding))
+		p := reparsed.Find("p")
+		if title, _ := p.Attr("title"); p.Text() != "café € 日本" || title != "café €" {
+			t.Errorf("Render in %q reparses with text %q and title %q", test.encoding, p.Text(), title)
+		}
+	}
+
+	if err := (&Renderer{Encoding: "no-such-encoding"}).Render(&bytes.Buffer{}, doc); !errors.Is(err, CharsetErr) {
+		t.Errorf("Render in an unknown encoding = %v, want %v", err, CharsetErr)
+	}
+}
+
+func TestRenderFidelityModifiedAttrs(t *testing.T) {
+	tests := []struct {
+		src    string
+		modify func(node *Node)
+		want   string
+	}{
+		{"<div   class=a\n  id=b>x</div>", func(node *Node) { node.SetAttr("id", "c") }, "<div   class=a\n  id=\"c\">x</div>"},
+		{"<div   class=a\n  id='b' >x</div>", func(node *Node) { node.SetAttr("class", "d") }, "<div   class=\"d\"\n  id='b' >x</div>"},
+		{"<div   class=a\n  id=b>x</div>", func(node *Node) { node.RemoveAttr("class") }, "<div\n  id=b>x</div>"},
+		{"<div   class=a\n  id=b>x</div>", func(node *Node) { node.RemoveAttr("id") }, "<div   class=a>x</div>"},
+		{"<div class=a >x</div>", func(node *Node) { node.SetAttr("title", "t") }, "<div class=a  title=\"t\">x</div>"},
+		{"<DIV hidden data-x=\"1\">x</DIV>", func(node *Node) { node.SetAttr("data-x", "2") }, "<DIV hidden data-x=\"2\">x</DIV>"},
+		{"<svg><circle  r=1 /></svg>", func(node *Node) { node.Find("circle").SetAttr("cx", "2") }, "<svg><circle  r=1  cx=\"2\"/></svg>"},
+		{"<a href=x/>y</a>", func(node *Node) { node.SetAttr("id", "z") }, "<a href=x id=\"z\"/>y</a>"},
+	}
+
+	for _, test := range tests {
+		doc, err, _ := Parse([]byte(test.src))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", test.src, err)
+		}
+		node := doc.Children[0]
+		test.modify(node)
+		if got := renderFidelity(t, test.src, doc); got != test.want {
+			t.Errorf("Render(%q) modified = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
+
+func TestRenderFidelityProcessingInstruction(t *testing.T) {
+	for _, src := range []string{"<?xml version=\"1.0\"?><p>a</p>", "<?bogus><p>a</p>"} {
+		doc, err, _ := Parse([]byte(src))
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", src, err)
+		}
+		if got := renderFidelity(t, src, doc); got != src {
+			t.Errorf("Render(%q) = %q", src, got)
+		}
+
+		doc.Children[0].Content = "changed"
+		if got, want := renderFidelity(t, src, doc), "<?changed?><p>a</p>"; got != want {
+			t.Errorf("Render(%q) modified = %q, want %q", src, got, want)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package gohtml
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Default URL schemes allowed in URL attributes.
+var defaultURLSchemes = []string{"http", "https", "mailto"}
+
+// Attributes whose values are URLs.
+var urlAttrs = map[string]bool{
+	"action":     true,
+	"background": true,
+	"cite":       true,
+	"codebase":   true,
+	"data":       true,
+	"dynsrc":     true,
+	"formaction": true,
+	"href":       true,
+	"longdesc":   true,
+	"lowsrc":     true,
+	"manifest":   true,
+	"poster":     true,
+	"src":        true,
+	"xlink:href": true,
+}
+
+// Attributes whose values are space-separated lists of URLs.
+var urlListAttrs = map[string]bool{
+	"ping": true,
+}
+
+// Elements removed with their contents, since they run scripts.
+var scriptTags = map[string]bool{
+	"script": true,
+}
+
+// Elements whose href is a link that may point elsewhere.
+var linkTags = map[string]bool{
+	"a":    true,
+	"area": true,
+}
+
+// Sanitizes node trees in place, e.g. for displaying user-generated rich text.
+// The zero value removes <script> elements, event handler attributes (e.g.
+// onclick), srcdoc attributes, and URL attributes with schemes other than
+// http, https, and mailto.  Other elements and attributes are kept, so e.g.
+// <iframe> and <form> elements should be removed beforehand if untrusted.
+type Sanitizer struct {
+	// Allowed URL schemes per URL attribute (e.g. "href": {"https"}), keyed
+	// by lowercase attribute name.  URL attributes whose values have a scheme
+	// not allowed for them (for srcset, in any of its URLs) are removed;
+	// relative URLs are always allowed.  URL attributes missing from the map
+	// allow http, https, and mailto, so javascript: and data: URLs are
+	// removed unless explicitly allowed.
+	URLSchemes map[string][]string
+
+	// Value of rel to force on links (<a> and <area>) with external targets,
+	// e.g. "noopener noreferrer nofollow".  Tokens already in rel are kept.
+	// Links are external if their href is an absolute URL whose host is not
+	// in InternalHosts.  No rel is forced if empty.
+	ExternalRel string
+
+	// Hosts whose links are not external.
+	InternalHosts []string
+
+	// Prefix for proxying image sources, e.g. "https://proxy.example/?url=".
+	// If non-empty, absolute <img> src URLs are replaced with the prefix
+	// followed by the query-escaped URL.
+	ImageProxy string
+
+	// Allowed CSS properties in style attributes (e.g. "color": true).  If
+	// non-nil, declarations of other properties are removed from style
+	// attributes, as are declarations with unsafe values: expression(),
+	// url() with a scheme not allowed by URLSchemes["style"], escapes, and
+	// values denied by DeniedStyleValues.  Style attributes left without any
+	// declarations are removed.  Style attributes are left as is if nil.
+	StyleProperties map[string]bool
+
+	// Denied values per CSS property (e.g. "position": {"fixed"}).  Only used
+	// if StyleProperties is non-nil.  Denies position:fixed and
+	// position:sticky if nil.
+	DeniedStyleValues map[string][]string
+}
+
+// Default denied values per CSS property.
+var defaultDeniedStyleValues = map[string][]string{
+	"position": {"fixed", "sticky"},
+}
+
+// Sanitize node and its descendants in place.
+func (s *Sanitizer) Sanitize(node *Node) {
+	stk := make(stack[*Node], 0, 16)
+	stk.Push(node)
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if node.Kind == ElementNode {
+			s.sanitizeElement(node)
+		}
+
+		node.Children = slices.DeleteFunc(node.Children, func(child *Node) bool {
+			return child.Kind == ElementNode && scriptTags[strings.ToLower(child.Content)]
+		})
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+}
+
+func (s *Sanitizer) sanitizeElement(node *Node) {
+	// NOTE: keys set directly in node.Attrs, and tag names set directly in
+	// node.Content, may be in any case
+	for key, val := range node.Attrs {
+		lower := strings.ToLower(key)
+		switch {
+		case strings.HasPrefix(lower, "on") || lower == "srcdoc":
+			node.RemoveAttr(key)
+		case urlAttrs[lower] && !s.allowURL(lower, val):
+			node.RemoveAttr(key)
+		case urlListAttrs[lower] && !s.allowURLs(lower, strings.FieldsFunc(val, isSpaceR)):
+			node.RemoveAttr(key)
+		case lower == "srcset" && !s.allowURLs(lower, srcsetURLs(val)):
+			node.RemoveAttr(key)
+		case lower == "style" && s.StyleProperties != nil:
+			if style := s.sanitizeStyle(val); style != "" {
+				node.SetAttr(key, style)
+			} else {
+				node.RemoveAttr(key)
+			}
+		}
+	}
+
+	name := strings.ToLower(node.Content)
+
+	if s.ExternalRel != "" && linkTags[name] && slices.ContainsFunc(foldedKeys(node, "href"), func(key string) bool {
+		return s.isExternal(node.Attrs[key])
+	}) {
+		rel := ""
+		for _, key := range foldedKeys(node, "rel") {
+			rel = mergeTokens(rel, node.Attrs[key])
+			node.RemoveAttr(key)
+		}
+		node.SetAttr("rel", mergeTokens(rel, s.ExternalRel))
+	}
+
+	if s.ImageProxy != "" && name == "img" {
+		for _, key := range foldedKeys(node, "src") {
+			if src := node.Attrs[key]; isAbsoluteURL(src) {
+				node.SetAttr(key, s.ImageProxy+url.QueryEscape(strings.TrimSpace(src)))
+			}
+		}
+	}
+}
+
+// Keys of the node's attributes equal to key regardless of case, in order.
+func foldedKeys(node *Node, key string) []string {
+	keys := make([]string, 0, 1)
+	for _, k := range node.AttrKeys() {
+		if strings.EqualFold(k, key) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// Whether all URLs of the attribute key are allowed, e.g. the image candidate
+// URLs of a srcset attribute.
+func (s *Sanitizer) allowURLs(key string, urls []string) bool {
+	for _, u := range urls {
+		if !s.allowURL(key, u) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the image candidate URLs of a srcset attribute, e.g. "a.png" and
+// "b.png" for "a.png 1x, b.png 2x".
+func srcsetURLs(srcset string) []string {
+	urls := make([]string, 0, 4)
+
+	rest := srcset
+	for {
+		rest = strings.TrimLeftFunc(rest, func(r rune) bool { return isSpaceR(r) || r == ',' })
+		if rest == "" {
+			break
+		}
+
+		end := strings.IndexFunc(rest, isSpaceR)
+		if end < 0 {
+			end = len(rest)
+		}
+		u := rest[:end]
+		rest = rest[end:]
+		if trimmed := strings.TrimRight(u, ","); trimmed != u {
+			// no descriptors
+			urls = append(urls, trimmed)
+			continue
+		}
+		urls = append(urls, u)
+
+		// skip descriptors up to the next comma outside parentheses
+		depth := 0
+		i := 0
+	descriptors:
+		for ; i < len(rest); i++ {
+			switch rest[i] {
+			case '(':
+				depth++
+			case ')':
+				if depth > 0 {
+					depth--
+				}
+			case ',':
+				if depth == 0 {
+					break descriptors
+				}
+			}
+		}
+		rest = rest[i:]
+	}
+
+	return urls
+}
+
+// Whether a URL is allowed as the value of the attribute key.
+func (s *Sanitizer) allowURL(key string, val string) bool {
+	scheme := urlScheme(val)
+	if scheme == "" {
+		return true
+	}
+
+	schemes, ok := s.URLSchemes[key]
+	if !ok {
+		schemes = defaultURLSchemes
+	}
+
+	for _, allowed := range schemes {
+		if strings.EqualFold(scheme, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter the declarations of a style attribute, returning the remaining
+// declarations.
+func (s *Sanitizer) sanitizeStyle(style string) string {
+	decls := make([]string, 0, 8)
+
+	for _, decl := range splitStyle(stripCSSComments(style)) {
+		prop, val, found := strings.Cut(decl, ":")
+		prop = strings.ToLower(strings.TrimSpace(prop))
+		val = strings.TrimSpace(val)
+		if !found || !s.StyleProperties[prop] || !s.allowStyleValue(prop, val) {
+			continue
+		}
+		decls = append(decls, prop+": "+val)
+	}
+
+	return strings.Join(decls, "; ")
+}
+
+// Whether a CSS value is safe for the property prop.
+func (s *Sanitizer) allowStyleValue(prop string, val string) bool {
+	lower := strings.ToLower(val)
+	compact := strings.Join(strings.FieldsFunc(lower, isSpaceR), "")
+	if val == "" || strings.Contains(compact, "expression(") || strings.ContainsAny(val, "\\<>") {
+		return false
+	}
+
+	denied, ok := s.DeniedStyleValues[prop]
+	if s.DeniedStyleValues == nil {
+		denied, ok = defaultDeniedStyleValues[prop]
+	}
+	if ok {
+		bare := strings.TrimSpace(strings.TrimSuffix(lower, "!important"))
+		for _, deny := range denied {
+			if bare == strings.ToLower(deny) {
+				return false
+			}
+		}
+	}
+
+	// check the URL of every url(...)
+	for rest := lower; ; {
+		i := strings.Index(rest, "url(")
+		if i < 0 {
+			break
+		}
+		rest = rest[i+len("url("):]
+		end := strings.IndexByte(rest, ')')
+		if end < 0 {
+			return false
+		}
+		u := strings.Trim(strings.TrimSpace(rest[:end]), "\"'")
+		if !s.allowURL("style", u) {
+			return false
+		}
+		rest = rest[end:]
+	}
+
+	return true
+}
+
+// Remove /* ... */ comments from CSS.
+func stripCSSComments(css string) string {
+	buf := strings.Builder{}
+	for {
+		before, after, found := strings.Cut(css, "/*")
+		buf.WriteString(before)
+		if !found {
+			break
+		}
+		// NOTE: comments are removed entirely rather than replaced with
+		// spaces, so that e.g. "expr/**/ession(" is caught as "expression("
+		_, css, found = strings.Cut(after, "*/")
+		if !found {
+			break
+		}
+	}
+	return buf.String()
+}
+
+// Split CSS declarations on semicolons, ignoring semicolons within quotes and
+// parentheses.
+func splitStyle(style string) []string {
+	decls := make([]string, 0, 8)
+
+	depth := 0
+	var quote rune
+	start := 0
+	for i, r := range style {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case r == ';' && depth == 0:
+			decls = append(decls, style[start:i])
+			start = i + 1
+		}
+	}
+	decls = append(decls, style[start:])
+
+	return decls
+}
+
+// Whether a link points to a host other than the internal hosts.
+func (s *Sanitizer) isExternal(href string) bool {
+	if !isAbsoluteURL(href) {
+		return false
+	}
+
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		// be conservative with links that can't be parsed
+		return true
+	}
+
+	for _, host := range s.InternalHosts {
+		if strings.EqualFold(u.Hostname(), host) {
+			return false
+		}
+	}
+	return true
+}
+
+// Return the lowercased scheme of a URL, or "" if it is relative.  Like
+// browsers, ignores whitespace and control characters, so that e.g.
+// "java\tscript:" is treated as "javascript:".
+func urlScheme(val string) string {
+	scheme := strings.Builder{}
+	for _, r := range val {
+		switch {
+		case r <= ' ' || r == 0x7f:
+			continue
+		case r == ':':
+			return strings.ToLower(scheme.String())
+		case r == '/' || r == '?' || r == '#':
+			return ""
+		}
+		scheme.WriteRune(r)
+	}
+	return ""
+}
+
+// Whether a URL is absolute, including scheme-relative URLs (e.g.
+// "//example.com").
+func isAbsoluteURL(val string) bool {
+	val = strings.TrimSpace(val)
+	return urlScheme(val) != "" || strings.HasPrefix(val, "//")
+}
+
+// Add the space-separated tokens of add to those of tokens that are missing.
+func mergeTokens(tokens string, add string) string {
+	fields := strings.FieldsFunc(tokens, isSpaceR)
+	for _, tok := range strings.FieldsFunc(add, isSpaceR) {
+		found := false
+		for _, field := range fields {
+			if strings.EqualFold(field, tok) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fields = append(fields, tok)
+		}
+	}
+	return strings.Join(fields, " ")
+}
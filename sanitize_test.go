@@ -0,0 +1,139 @@
+package gohtml
+
+import (
+	"testing"
+)
+
+// Parse src, sanitize it with s, and render the result.
+func sanitized(t *testing.T, s *Sanitizer, src string) string {
+	t.Helper()
+	node, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+	s.Sanitize(node)
+	return string(node.RenderBytes())
+}
+
+func TestSanitizeXSS(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`<a href="javascript:alert(1)">x</a>`, `<a>x</a>`},
+		{`<a HREF="javascript:alert(1)">x</a>`, `<a>x</a>`},
+		{`<a href="JaVaScRiPt:alert(1)">x</a>`, `<a>x</a>`},
+		{`<a href="java&#9;script:alert(1)">x</a>`, `<a>x</a>`},
+		{`<a href=" javascript:alert(1)">x</a>`, `<a>x</a>`},
+		{`<a href="&#x6A;avascript:alert(1)">x</a>`, `<a>x</a>`},
+		{`<img src="data:text/html;base64,PHNjcmlwdD4=">`, `<img>`},
+		{`<img srcset="javascript:alert(1)">`, `<img>`},
+		{`<img srcset="a.png 1x, javascript:alert(1) 2x">`, `<img>`},
+		{`<img srcset="a.png,javascript:alert(1)">`, `<img>`},
+		{`<img SRCSET="javascript:alert(1) 1x">`, `<img>`},
+		{`<img srcset="a.png 1x, https://example.com/b.png 2x">`, `<img srcset="a.png 1x, https://example.com/b.png 2x">`},
+		{`<svg><a xlink:href="javascript:alert(1)"><text>x</text></a></svg>`, `<svg><a><text>x</text></a></svg>`},
+		{`<svg><a XLINK:HREF="javascript:alert(1)"><text>x</text></a></svg>`, `<svg><a><text>x</text></a></svg>`},
+		{`<form><button formaction="javascript:alert(1)">x</button></form>`, `<form><button>x</button></form>`},
+		{`<object data="javascript:alert(1)"></object>`, `<object></object>`},
+		{`<object DATA="data:text/html,x"></object>`, `<object></object>`},
+		{`<iframe srcdoc="&lt;script&gt;alert(1)&lt;/script&gt;"></iframe>`, `<iframe></iframe>`},
+		{`<a ping="https://example.com/p javascript:alert(1)">x</a>`, `<a>x</a>`},
+		{`<a ping="https://example.com/p /q">x</a>`, `<a ping="https://example.com/p /q">x</a>`},
+		{`<html manifest="javascript:alert(1)"></html>`, `<html></html>`},
+		{`<form action="javascript:alert(1)"></form>`, `<form></form>`},
+		{`<p>a<script>alert(1)</script>b</p>`, `<p>ab</p>`},
+		{`<svg><script>alert(1)</script></svg>`, `<svg/>`},
+		{`<img src=x onerror="alert(1)">`, `<img src=x>`},
+		{`<body ONLOAD="alert(1)"><svg onload="alert(1)"></svg></body>`, `<body><svg/></body>`},
+		{`<a href="/relative">x</a>`, `<a href="/relative">x</a>`},
+	}
+
+	for _, test := range tests {
+		if got := sanitized(t, &Sanitizer{}, test.in); got != test.want {
+			t.Errorf("Sanitize(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestSanitizeDirectAttrs(t *testing.T) {
+	node := &Node{Kind: ElementNode, Content: "A", Attrs: map[string]string{
+		"HREF":  "javascript:alert(1)",
+		"Style": "color: red; position: fixed",
+	}}
+	s := Sanitizer{StyleProperties: map[string]bool{"color": true, "position": true}}
+	s.Sanitize(node)
+
+	if _, ok := node.Attrs["HREF"]; ok {
+		t.Errorf("HREF not removed: %v", node.Attrs)
+	}
+	if got, want := node.Attrs["Style"], "color: red"; got != want {
+		t.Errorf("Style = %q, want %q", got, want)
+	}
+}
+
+func TestSanitizeStyleXSS(t *testing.T) {
+	s := Sanitizer{StyleProperties: map[string]bool{"color": true, "background": true}}
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`<p style="color: expression(alert(1))">x</p>`, `<p>x</p>`},
+		{`<p style="color: expr/**/ession(alert(1))">x</p>`, `<p>x</p>`},
+		{`<p style="background: url(javascript:alert(1))">x</p>`, `<p>x</p>`},
+		{`<p STYLE="background: url(javascript:alert(1))">x</p>`, `<p>x</p>`},
+		{`<p style="color: \65 xpression(alert(1))">x</p>`, `<p>x</p>`},
+		{`<p style="color: red">x</p>`, `<p style="color: red">x</p>`},
+	}
+
+	for _, test := range tests {
+		if got := sanitized(t, &s, test.in); got != test.want {
+			t.Errorf("Sanitize(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestSanitizeExternalRel(t *testing.T) {
+	s := Sanitizer{ExternalRel: "noopener", InternalHosts: []string{"example.com"}}
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`<a href="https://evil.test/">x</a>`, `<a href="https://evil.test/" rel="noopener">x</a>`},
+		{`<A HREF="https://evil.test/" REL="nofollow">x</A>`, `<a HREF="https://evil.test/" rel="nofollow noopener">x</a>`},
+		{`<a href="https://example.com/">x</a>`, `<a href="https://example.com/">x</a>`},
+	}
+
+	for _, test := range tests {
+		if got := sanitized(t, &s, test.in); got != test.want {
+			t.Errorf("Sanitize(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestSanitizeStyle(t *testing.T) {
+	props := map[string]bool{"color": true, "position": true, "background": true, "font-family": true}
+	tests := []struct {
+		s    Sanitizer
+		in   string
+		want string
+	}{
+		{Sanitizer{StyleProperties: props}, `<p style="COLOR:red;margin:0">x</p>`, `<p style="color: red">x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="position: fixed; color: red">x</p>`, `<p style="color: red">x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="position: FIXED !important">x</p>`, `<p>x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="position: relative">x</p>`, `<p style="position: relative">x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="font-family: 'a;b', serif; color: red">x</p>`, `<p style="font-family: 'a;b', serif; color: red">x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="background: url('https://example.com/a.png')">x</p>`, `<p style="background: url('https://example.com/a.png')">x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="background: url(data:image/png;base64,AAAA)">x</p>`, `<p>x</p>`},
+		{Sanitizer{StyleProperties: props, URLSchemes: map[string][]string{"style": {"data"}}}, `<p style="background: url(data:image/png;base64,AAAA)">x</p>`, `<p style="background: url(data:image/png;base64,AAAA)">x</p>`},
+		{Sanitizer{StyleProperties: props, DeniedStyleValues: map[string][]string{"color": {"red"}}}, `<p style="color: red; position: fixed">x</p>`, `<p style="position: fixed">x</p>`},
+		{Sanitizer{StyleProperties: props}, `<p style="color: red; ; margin">x</p>`, `<p style="color: red">x</p>`},
+		{Sanitizer{}, `<p style="position: fixed">x</p>`, `<p style="position: fixed">x</p>`},
+	}
+
+	for _, test := range tests {
+		if got := sanitized(t, &test.s, test.in); got != test.want {
+			t.Errorf("Sanitize(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package gohtml
+
+import (
+	"slices"
+	"strings"
+)
+
+// Set of nodes with chainable methods for querying and filtering them, so
+// that scraping reads as a pipeline, e.g.:
+//
+//	NewSelection(doc).Find("ul.menu").Find("a").Not(".hidden").Map(...)
+//
+// Methods return a new Selection rather than modifying the one they're
+// called on.  Invalid selectors don't panic: the first error is kept through
+// the rest of the chain, as returned by Err, and selects nothing.
+type Selection struct {
+	// Nodes of the selection, in document order for selections from Find.
+	Nodes []*Node
+
+	err error
+}
+
+// Make a Selection of nodes, skipping any nil nodes.
+func NewSelection(nodes ...*Node) *Selection {
+	selected := make([]*Node, 0, len(nodes))
+	for _, node := range nodes {
+		if node != nil {
+			selected = append(selected, node)
+		}
+	}
+	return &Selection{Nodes: selected}
+}
+
+// First error from an invalid selector in the chain that led to the
+// selection, if any.
+func (s *Selection) Err() error {
+	return s.err
+}
+
+// Number of nodes in the selection.
+func (s *Selection) Len() int {
+	return len(s.Nodes)
+}
+
+// Make a Selection of nodes, carrying over the error of s.
+func (s *Selection) with(nodes []*Node) *Selection {
+	return &Selection{Nodes: nodes, err: s.err}
+}
+
+// Compile the selector, or return an empty selection with the error if it is
+// invalid or s already has an error.
+func (s *Selection) compile(selector string) (*Selector, *Selection) {
+	if s.err != nil {
+		return nil, s.with(make([]*Node, 0))
+	}
+	sel, err := CompileSelector(selector)
+	if err != nil {
+		return nil, &Selection{Nodes: make([]*Node, 0), err: err}
+	}
+	return sel, nil
+}
+
+// Select the descendants of the nodes that match the CSS selector, in
+// document order and without duplicates.
+func (s *Selection) Find(selector string) *Selection {
+	sel, empty := s.compile(selector)
+	if sel == nil {
+		return empty
+	}
+
+	matches := make([]*Node, 0, 16)
+	seen := make(map[*Node]bool)
+	for _, node := range s.Nodes {
+		for _, match := range sel.SelectAll(node) {
+			if !seen[match] {
+				seen[match] = true
+				matches = append(matches, match)
+			}
+		}
+	}
+	if len(s.Nodes) > 1 {
+		// NOTE: matches within different nodes may be out of order
+		slices.SortStableFunc(matches, CompareDocumentOrder)
+	}
+	return s.with(matches)
+}
+
+// Select the nodes that match the CSS selector.
+func (s *Selection) Filter(selector string) *Selection {
+	sel, empty := s.compile(selector)
+	if sel == nil {
+		return empty
+	}
+	return s.FilterFunc(func(_ int, node *Node) bool { return sel.Match(node) })
+}
+
+// Select the nodes for which keep returns true, called with the index of
+// each node in the selection.
+func (s *Selection) FilterFunc(keep func(i int, node *Node) bool) *Selection {
+	nodes := make([]*Node, 0, len(s.Nodes))
+	for i, node := range s.Nodes {
+		if keep(i, node) {
+			nodes = append(nodes, node)
+		}
+	}
+	return s.with(nodes)
+}
+
+// Select the nodes that don't match the CSS selector.
+func (s *Selection) Not(selector string) *Selection {
+	sel, empty := s.compile(selector)
+	if sel == nil {
+		return empty
+	}
+	return s.FilterFunc(func(_ int, node *Node) bool { return !sel.Match(node) })
+}
+
+// Select the first node, if any.
+func (s *Selection) First() *Selection {
+	return s.Eq(0)
+}
+
+// Select the i-th node, counting from 0, or from the end if i is negative
+// (e.g. -1 for the last node).  Selects nothing if i is out of range.
+func (s *Selection) Eq(i int) *Selection {
+	if i < 0 {
+		i += len(s.Nodes)
+	}
+	if i < 0 || i >= len(s.Nodes) {
+		return s.with(make([]*Node, 0))
+	}
+	return s.with([]*Node{s.Nodes[i]})
+}
+
+// Call fn with each node and its index in the selection.  Returns s, so that
+// the chain can go on.
+func (s *Selection) Each(fn func(i int, node *Node)) *Selection {
+	for i, node := range s.Nodes {
+		fn(i, node)
+	}
+	return s
+}
+
+// Return the results of calling fn with each node and its index in the
+// selection.
+func (s *Selection) Map(fn func(i int, node *Node) string) []string {
+	results := make([]string, 0, len(s.Nodes))
+	for i, node := range s.Nodes {
+		results = append(results, fn(i, node))
+	}
+	return results
+}
+
+// Return the value of the attribute key of the first node and whether it has
+// it; see Node.Attr.  Returns false if the selection is empty.
+func (s *Selection) Attr(key string) (string, bool) {
+	if len(s.Nodes) == 0 {
+		return "", false
+	}
+	return s.Nodes[0].Attr(key)
+}
+
+// Return the concatenated text of all the nodes; see Node.Text.
+func (s *Selection) Text() string {
+	var b strings.Builder
+	for _, node := range s.Nodes {
+		b.WriteString(node.Text())
+	}
+	return b.String()
+}
@@ -0,0 +1,149 @@
+package gohtml
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewSelectionNil(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div><p class="a">x</p><p>y</p></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sel := NewSelection(nil, doc, nil)
+	if sel.Len() != 1 {
+		t.Fatalf("Len() = %d, want 1", sel.Len())
+	}
+	if got := NewSelection(nil).Find("p"); got.Len() != 0 || got.Err() != nil {
+		t.Errorf("Find on a nil node = %d nodes, %v", got.Len(), got.Err())
+	}
+	if got, want := sel.Find("p").Filter(".a").Text(), "x"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
+
+func TestSelection(t *testing.T) {
+	src := `<div id=a><p class=x>1</p><p>2</p></div><div id=b><p class=x>3</p><span><p>4</p></span></div>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	divs := NewSelection(doc).Find("div").Nodes
+	a, b := divs[0], divs[1]
+	span := NewSelection(doc).Find("span").Nodes[0]
+
+	tests := []struct {
+		name string
+		sel  *Selection
+		want []string
+	}{
+		{"find", NewSelection(doc).Find("p"), []string{"1", "2", "3", "4"}},
+		{"find in reverse roots", NewSelection(b, a).Find("p"), []string{"1", "2", "3", "4"}},
+		{"find in duplicate roots", NewSelection(a, a).Find("p"), []string{"1", "2"}},
+		{"find in nested roots", NewSelection(span, b).Find("p"), []string{"3", "4"}},
+		{"find nothing", NewSelection(doc).Find("ul"), []string{}},
+		{"filter", NewSelection(doc).Find("p").Filter(".x"), []string{"1", "3"}},
+		{"not", NewSelection(doc).Find("p").Not(".x"), []string{"2", "4"}},
+		{"filter func", NewSelection(doc).Find("p").FilterFunc(func(i int, _ *Node) bool { return i%2 == 1 }), []string{"2", "4"}},
+		{"first", NewSelection(doc).Find("p").First(), []string{"1"}},
+		{"eq", NewSelection(doc).Find("p").Eq(2), []string{"3"}},
+		{"eq last", NewSelection(doc).Find("p").Eq(-1), []string{"4"}},
+		{"eq first from the end", NewSelection(doc).Find("p").Eq(-4), []string{"1"}},
+		{"eq past the end", NewSelection(doc).Find("p").Eq(4), []string{}},
+		{"eq before the start", NewSelection(doc).Find("p").Eq(-5), []string{}},
+		{"first of nothing", NewSelection().First(), []string{}},
+	}
+	for _, test := range tests {
+		got := test.sel.Map(func(_ int, node *Node) string { return node.Text() })
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%s: selected %q, want %q", test.name, got, test.want)
+		}
+		if err := test.sel.Err(); err != nil {
+			t.Errorf("%s: Err() = %v", test.name, err)
+		}
+	}
+}
+
+func TestSelectionValues(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<a href=x>1</a><a>2</a><a href=z>3</a>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	links := NewSelection(doc).Find("a")
+
+	indexes := make([]int, 0, 3)
+	if got := links.Each(func(i int, _ *Node) { indexes = append(indexes, i) }); got != links {
+		t.Errorf("Each() = %p, want the selection %p", got, links)
+	}
+	if want := []int{0, 1, 2}; !slices.Equal(indexes, want) {
+		t.Errorf("Each() called with %v, want %v", indexes, want)
+	}
+
+	hrefs := links.Map(func(_ int, node *Node) string {
+		href, _ := node.Attr("href")
+		return href
+	})
+	if want := []string{"x", "", "z"}; !slices.Equal(hrefs, want) {
+		t.Errorf("Map() = %q, want %q", hrefs, want)
+	}
+
+	tests := []struct {
+		sel  *Selection
+		key  string
+		val  string
+		ok   bool
+		text string
+	}{
+		{links, "href", "x", true, "123"},
+		{links.Eq(1), "href", "", false, "2"},
+		{links.Eq(-1), "href", "z", true, "3"},
+		{links.Eq(3), "href", "", false, ""},
+		{NewSelection(), "href", "", false, ""},
+	}
+	for i, test := range tests {
+		if val, ok := test.sel.Attr(test.key); val != test.val || ok != test.ok {
+			t.Errorf("%d: Attr(%q) = %q, %v, want %q, %v", i, test.key, val, ok, test.val, test.ok)
+		}
+		if text := test.sel.Text(); text != test.text {
+			t.Errorf("%d: Text() = %q, want %q", i, text, test.text)
+		}
+	}
+	if got := NewSelection().Map(func(_ int, node *Node) string { return node.Text() }); got == nil || len(got) != 0 {
+		t.Errorf("Map() on an empty selection = %#v, want an empty slice", got)
+	}
+}
+
+func TestSelectionErr(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<p class=x>1</p><p>2</p>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		sel  *Selection
+	}{
+		{"find", NewSelection(doc).Find("p[")},
+		{"filter", NewSelection(doc).Find("p").Filter("::")},
+		{"not", NewSelection(doc).Find("p").Not("p[")},
+		{"find after", NewSelection(doc).Find("p[").Find("p")},
+		{"filter after", NewSelection(doc).Find("p[").Filter("p")},
+		{"not after", NewSelection(doc).Find("p[").Not(".x")},
+		{"eq after", NewSelection(doc).Find("p[").Eq(0)},
+		{"filter func after", NewSelection(doc).Find("p[").FilterFunc(func(int, *Node) bool { return true })},
+	}
+	for _, test := range tests {
+		if test.sel.Err() == nil {
+			t.Errorf("%s: Err() = nil, want an error", test.name)
+		}
+		if test.sel.Len() != 0 {
+			t.Errorf("%s: selected %d nodes, want none", test.name, test.sel.Len())
+		}
+	}
+
+	first := NewSelection(doc).Find("p[")
+	if got := first.Find("::").Err(); got != first.Err() {
+		t.Errorf("Err() = %v after a second invalid selector, want the first error %v", got, first.Err())
+	}
+}
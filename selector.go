@@ -0,0 +1,716 @@
+package gohtml
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Compiled CSS selector, for matching nodes repeatedly without re-parsing the
+// selector string.
+//
+// Supports type (e.g. div), universal (*), id (#id), class (.class), and
+// attribute selectors (with =, ~=, |=, ^=, $=, and *=, and the i flag); the
+// descendant, child (>), next-sibling (+), and subsequent-sibling (~)
+// combinators; selector lists (a, b); and the pseudo-classes :root, :empty,
+// :first-child, :last-child, :only-child, :first-of-type, :last-of-type,
+// :only-of-type, :nth-child(), :nth-last-child(), :nth-of-type(),
+// :nth-last-of-type(), and :not().
+//
+// Combinators are matched through Node.Parent links.
+type Selector struct {
+	text  string
+	group []complexSel
+}
+
+// Selector made of compound selectors joined by combinators.
+type complexSel struct {
+	parts []compoundSel
+	combs []byte // combs[i] joins parts[i] and parts[i+1]
+}
+
+// Sequence of simple selectors that all must match the same element.
+type compoundSel struct {
+	tag     string // "" matches any tag
+	ids     []string
+	classes []string
+	attrs   []attrSel
+	pseudos []pseudoSel
+}
+
+type attrSel struct {
+	key      string
+	op       string // "" for presence
+	val      string
+	foldCase bool
+}
+
+type pseudoSel struct {
+	name string
+	a, b int           // for :nth-*()
+	not  []compoundSel // for :not()
+}
+
+// Compile a CSS selector.
+func CompileSelector(text string) (*Selector, error) {
+	p := selectorParser{text: text}
+	group, err := p.parseGroup()
+	if err != nil {
+		return nil, err
+	}
+	return &Selector{text: text, group: group}, nil
+}
+
+// String representation; the selector as originally written.
+func (sel *Selector) String() string {
+	return sel.text
+}
+
+// Whether node matches the selector.
+func (sel *Selector) Match(node *Node) bool {
+	if node.Kind != ElementNode {
+		return false
+	}
+	for _, cs := range sel.group {
+		if cs.match(node, len(cs.parts)-1) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find the first descendant of root that matches the selector.  Returns an
+// empty, non-nil *Node of InvalidNode kind if there is none.
+func (sel *Selector) Select(root *Node) *Node {
+	stk := make(stack[*Node], 0, 16)
+	for i := len(root.Children) - 1; i >= 0; i-- {
+		stk.Push(root.Children[i])
+	}
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if sel.Match(node) {
+			return node
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return EmptyNode()
+}
+
+// Find all descendants of root that match the selector, in document order.
+// Returns an empty, non-nil slice of *Node if there are none.
+func (sel *Selector) SelectAll(root *Node) []*Node {
+	matches := make([]*Node, 0, 16)
+
+	stk := make(stack[*Node], 0, 16)
+	for i := len(root.Children) - 1; i >= 0; i-- {
+		stk.Push(root.Children[i])
+	}
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		if sel.Match(node) {
+			matches = append(matches, node)
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+
+	return matches
+}
+
+// Find the closest inclusive ancestor of node that matches the selector: the
+// node itself or the nearest of its ancestors, as with closest() in the DOM.
+// Returns an empty, non-nil *Node of InvalidNode kind if there is none.
+func (sel *Selector) Closest(node *Node) *Node {
+	for ; node != nil; node = node.Parent {
+		if sel.Match(node) {
+			return node
+		}
+	}
+	return EmptyNode()
+}
+
+// Find the first descendant node that matches the CSS selector.  Returns an
+// empty, non-nil *Node of InvalidNode kind if no matching descendant was
+// found, or an error if the selector is invalid.
+func (node *Node) Query(selector string) (*Node, error) {
+	sel, err := CompileSelector(selector)
+	if err != nil {
+		return EmptyNode(), err
+	}
+	return sel.Select(node), nil
+}
+
+// Find all descendant nodes that match the CSS selector, in document order.
+// Returns an empty, non-nil slice of *Node if no matching descendants were
+// found, or an error if the selector is invalid.
+func (node *Node) QueryAll(selector string) ([]*Node, error) {
+	sel, err := CompileSelector(selector)
+	if err != nil {
+		return make([]*Node, 0), err
+	}
+	return sel.SelectAll(node), nil
+}
+
+// Find the node itself or the nearest of its ancestors that matches the CSS
+// selector, e.g. "nav, footer" to tell whether a link is within either;
+// see Selector.Closest.  Returns an empty, non-nil *Node of InvalidNode kind
+// if there is none, or an error if the selector is invalid.
+func (node *Node) Closest(selector string) (*Node, error) {
+	sel, err := CompileSelector(selector)
+	if err != nil {
+		return EmptyNode(), err
+	}
+	return sel.Closest(node), nil
+}
+
+// Whether node matches parts[:i+1], with parts[i] matching node itself.
+func (cs *complexSel) match(node *Node, i int) bool {
+	if !cs.parts[i].match(node) {
+		return false
+	} else if i == 0 {
+		return true
+	}
+
+	switch cs.combs[i-1] {
+	case '>':
+		parent := node.Parent
+		return parent != nil && parent.Kind == ElementNode && cs.match(parent, i-1)
+	case ' ':
+		for anc := node.Parent; anc != nil && anc.Kind == ElementNode; anc = anc.Parent {
+			if cs.match(anc, i-1) {
+				return true
+			}
+		}
+	case '+':
+		prev := prevElementSibling(node)
+		return prev != nil && cs.match(prev, i-1)
+	case '~':
+		for prev := prevElementSibling(node); prev != nil; prev = prevElementSibling(prev) {
+			if cs.match(prev, i-1) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (c *compoundSel) match(node *Node) bool {
+	if node.Kind != ElementNode {
+		return false
+	}
+	// NOTE: the names of foreign elements may be adjusted, e.g. foreignObject
+	if c.tag != "" && c.tag != node.Content && (node.Namespace == HTMLNamespace || !strings.EqualFold(c.tag, node.Content)) {
+		return false
+	}
+
+	for _, id := range c.ids {
+		if val, ok := attrFold(node, "id"); !ok || val != id {
+			return false
+		}
+	}
+
+	if len(c.classes) > 0 {
+		val, _ := attrFold(node, "class")
+		classes := strings.FieldsFunc(val, isSpaceR)
+		for _, class := range c.classes {
+			found := false
+			for _, nodeClass := range classes {
+				if nodeClass == class {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	for _, attr := range c.attrs {
+		if !attr.match(node) {
+			return false
+		}
+	}
+
+	for _, pseudo := range c.pseudos {
+		if !pseudo.match(node) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (attr *attrSel) match(node *Node) bool {
+	val, ok := attrFold(node, attr.key)
+	if !ok {
+		return false
+	} else if attr.op == "" {
+		return true
+	}
+
+	want := attr.val
+	if attr.foldCase {
+		val = strings.ToLower(val)
+		want = strings.ToLower(want)
+	}
+
+	switch attr.op {
+	case "=":
+		return val == want
+	case "~=":
+		for _, field := range strings.FieldsFunc(val, isSpaceR) {
+			if field == want {
+				return true
+			}
+		}
+		return false
+	case "|=":
+		return val == want || strings.HasPrefix(val, want+"-")
+	case "^=":
+		return want != "" && strings.HasPrefix(val, want)
+	case "$=":
+		return want != "" && strings.HasSuffix(val, want)
+	case "*=":
+		return want != "" && strings.Contains(val, want)
+	}
+
+	return false
+}
+
+func (pseudo *pseudoSel) match(node *Node) bool {
+	switch pseudo.name {
+	case "root":
+		return node.Parent == nil || node.Parent.Kind == DocumentNode
+	case "empty":
+		for _, child := range node.Children {
+			if child.Kind == ElementNode || ((child.Kind == TextNode || child.Kind == CDATANode) && child.Content != "") {
+				return false
+			}
+		}
+		return true
+	case "not":
+		for i := range pseudo.not {
+			if pseudo.not[i].match(node) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// positional pseudo-classes; elements without a parent have no siblings
+	pos, count := 1, 1
+	ofType := strings.HasSuffix(pseudo.name, "of-type")
+	if parent := node.Parent; parent != nil {
+		pos, count = 0, 0
+		for _, sib := range parent.Children {
+			if sib.Kind != ElementNode || (ofType && sib.Content != node.Content) {
+				continue
+			}
+			count++
+			if sib == node {
+				pos = count
+			}
+		}
+	}
+	last := count - pos + 1
+
+	switch pseudo.name {
+	case "first-child", "first-of-type":
+		return pos == 1
+	case "last-child", "last-of-type":
+		return last == 1
+	case "only-child", "only-of-type":
+		return count == 1
+	case "nth-child", "nth-of-type":
+		return nthMatch(pseudo.a, pseudo.b, pos)
+	case "nth-last-child", "nth-last-of-type":
+		return nthMatch(pseudo.a, pseudo.b, last)
+	}
+
+	return false
+}
+
+// Whether the 1-indexed position pos is a*n+b for some n >= 0.
+func nthMatch(a int, b int, pos int) bool {
+	if a == 0 {
+		return pos == b
+	}
+	n := pos - b
+	return n%a == 0 && n/a >= 0
+}
+
+// Look up an attribute, ignoring the case of the key.
+func attrFold(node *Node, key string) (string, bool) {
+	if val, ok := node.Attrs[key]; ok {
+		return val, true
+	}
+	for nodeKey, val := range node.Attrs {
+		if strings.EqualFold(nodeKey, key) {
+			return val, true
+		}
+	}
+	return "", false
+}
+
+// Previous sibling of node that is an ElementNode, or nil if there is none.
+func prevElementSibling(node *Node) *Node {
+	if node.Parent == nil {
+		return nil
+	}
+
+	var prev *Node
+	for _, sib := range node.Parent.Children {
+		if sib == node {
+			return prev
+		} else if sib.Kind == ElementNode {
+			prev = sib
+		}
+	}
+	return nil
+}
+
+type selectorParser struct {
+	text string
+	pos  int
+}
+
+func (p *selectorParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("error parsing selector %q at offset %d: %w: %s", p.text, p.pos, SelectorErr, fmt.Sprintf(format, args...))
+}
+
+func (p *selectorParser) eof() bool {
+	return p.pos >= len(p.text)
+}
+
+func (p *selectorParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.text[p.pos]
+}
+
+// Skip whitespace, returning whether any was skipped.
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for !p.eof() && isSpace(p.text[p.pos]) {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func isIdentChar(c byte) bool {
+	return c == '-' || c == '_' || c >= 0x80 ||
+		('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// Escape s for use as an identifier in a selector, e.g. `o\:p` for "o:p".
+func escapeIdent(s string) string {
+	buf := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		if !isIdentChar(s[i]) {
+			buf.WriteByte('\\')
+		}
+		buf.WriteByte(s[i])
+	}
+	return buf.String()
+}
+
+func (p *selectorParser) parseIdent() (string, error) {
+	buf := strings.Builder{}
+	for !p.eof() {
+		c := p.text[p.pos]
+		if c == '\\' && p.pos+1 < len(p.text) {
+			// escaped character
+			buf.WriteByte(p.text[p.pos+1])
+			p.pos += 2
+		} else if isIdentChar(c) {
+			buf.WriteByte(c)
+			p.pos++
+		} else {
+			break
+		}
+	}
+
+	if buf.Len() == 0 {
+		return "", p.errorf("expected identifier")
+	}
+	return buf.String(), nil
+}
+
+func (p *selectorParser) parseString() (string, error) {
+	quote := p.text[p.pos]
+	p.pos++
+
+	buf := strings.Builder{}
+	for !p.eof() && p.text[p.pos] != quote {
+		if p.text[p.pos] == '\\' && p.pos+1 < len(p.text) {
+			p.pos++
+		}
+		buf.WriteByte(p.text[p.pos])
+		p.pos++
+	}
+
+	if p.eof() {
+		return "", p.errorf("unterminated string")
+	}
+	p.pos++
+	return buf.String(), nil
+}
+
+func (p *selectorParser) parseGroup() ([]complexSel, error) {
+	group := make([]complexSel, 0, 1)
+	for {
+		p.skipSpace()
+		cs, err := p.parseComplex()
+		if err != nil {
+			return nil, err
+		}
+		group = append(group, cs)
+
+		p.skipSpace()
+		if p.eof() {
+			return group, nil
+		} else if p.peek() != ',' {
+			return nil, p.errorf("unexpected %q", p.peek())
+		}
+		p.pos++
+	}
+}
+
+func (p *selectorParser) parseComplex() (complexSel, error) {
+	var cs complexSel
+	for {
+		compound, err := p.parseCompound()
+		if err != nil {
+			return cs, err
+		}
+		cs.parts = append(cs.parts, compound)
+
+		comb := byte(0)
+		if p.skipSpace() {
+			comb = ' '
+		}
+		switch p.peek() {
+		case '>', '+', '~':
+			comb = p.peek()
+			p.pos++
+			p.skipSpace()
+		case ',', 0:
+			return cs, nil
+		}
+
+		if comb == 0 {
+			return cs, p.errorf("unexpected %q", p.peek())
+		}
+		cs.combs = append(cs.combs, comb)
+	}
+}
+
+func (p *selectorParser) parseCompound() (compoundSel, error) {
+	var c compoundSel
+	start := p.pos
+
+	if p.peek() == '*' {
+		p.pos++
+	} else if isIdentChar(p.peek()) || p.peek() == '\\' {
+		tag, err := p.parseIdent()
+		if err != nil {
+			return c, err
+		}
+		c.tag = InternTagName(strings.ToLower(tag))
+	}
+
+	for !p.eof() {
+		switch p.peek() {
+		case '#':
+			p.pos++
+			id, err := p.parseIdent()
+			if err != nil {
+				return c, err
+			}
+			c.ids = append(c.ids, id)
+		case '.':
+			p.pos++
+			class, err := p.parseIdent()
+			if err != nil {
+				return c, err
+			}
+			c.classes = append(c.classes, class)
+		case '[':
+			attr, err := p.parseAttr()
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, attr)
+		case ':':
+			pseudo, err := p.parsePseudo()
+			if err != nil {
+				return c, err
+			}
+			c.pseudos = append(c.pseudos, pseudo)
+		default:
+			if p.pos == start {
+				return c, p.errorf("expected selector")
+			}
+			return c, nil
+		}
+	}
+
+	if p.pos == start {
+		return c, p.errorf("expected selector")
+	}
+	return c, nil
+}
+
+func (p *selectorParser) parseAttr() (attrSel, error) {
+	var attr attrSel
+	p.pos++ // '['
+	p.skipSpace()
+
+	key, err := p.parseIdent()
+	if err != nil {
+		return attr, err
+	}
+	attr.key = key
+	p.skipSpace()
+
+	if p.peek() == ']' {
+		p.pos++
+		return attr, nil
+	}
+
+	for _, op := range []string{"=", "~=", "|=", "^=", "$=", "*="} {
+		if strings.HasPrefix(p.text[p.pos:], op) {
+			attr.op = op
+			p.pos += len(op)
+			break
+		}
+	}
+	if attr.op == "" {
+		return attr, p.errorf("expected attribute operator")
+	}
+	p.skipSpace()
+
+	if p.peek() == '"' || p.peek() == '\'' {
+		attr.val, err = p.parseString()
+	} else {
+		attr.val, err = p.parseIdent()
+	}
+	if err != nil {
+		return attr, err
+	}
+	p.skipSpace()
+
+	if c := p.peek(); c == 'i' || c == 'I' || c == 's' || c == 'S' {
+		attr.foldCase = c == 'i' || c == 'I'
+		p.pos++
+		p.skipSpace()
+	}
+
+	if p.peek() != ']' {
+		return attr, p.errorf("expected ']'")
+	}
+	p.pos++
+	return attr, nil
+}
+
+func (p *selectorParser) parsePseudo() (pseudoSel, error) {
+	var pseudo pseudoSel
+	p.pos++ // ':'
+
+	name, err := p.parseIdent()
+	if err != nil {
+		return pseudo, err
+	}
+	pseudo.name = strings.ToLower(name)
+
+	switch pseudo.name {
+	case "root", "empty", "first-child", "last-child", "only-child",
+		"first-of-type", "last-of-type", "only-of-type":
+		return pseudo, nil
+	case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type", "not":
+	default:
+		return pseudo, p.errorf("unsupported pseudo-class %q", name)
+	}
+
+	if p.peek() != '(' {
+		return pseudo, p.errorf("expected '('")
+	}
+	p.pos++
+	p.skipSpace()
+
+	if pseudo.name == "not" {
+		for {
+			c, err := p.parseCompound()
+			if err != nil {
+				return pseudo, err
+			}
+			pseudo.not = append(pseudo.not, c)
+			p.skipSpace()
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+			p.skipSpace()
+		}
+	} else {
+		end := strings.IndexByte(p.text[p.pos:], ')')
+		if end < 0 {
+			return pseudo, p.errorf("expected ')'")
+		}
+		pseudo.a, pseudo.b, err = parseNth(p.text[p.pos : p.pos+end])
+		if err != nil {
+			return pseudo, p.errorf("%s", err)
+		}
+		p.pos += end
+	}
+
+	if p.peek() != ')' {
+		return pseudo, p.errorf("expected ')'")
+	}
+	p.pos++
+	return pseudo, nil
+}
+
+// Parse the argument of an :nth-*() pseudo-class, e.g. "2n+1", "odd", or "3".
+func parseNth(arg string) (a int, b int, err error) {
+	arg = strings.ToLower(strings.Join(strings.FieldsFunc(arg, isSpaceR), ""))
+	switch arg {
+	case "odd":
+		return 2, 1, nil
+	case "even":
+		return 2, 0, nil
+	}
+
+	aStr, bStr, found := strings.Cut(arg, "n")
+	if !found {
+		b, err = strconv.Atoi(arg)
+		return 0, b, err
+	}
+
+	switch aStr {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		if a, err = strconv.Atoi(aStr); err != nil {
+			return
+		}
+	}
+
+	if bStr != "" {
+		b, err = strconv.Atoi(bStr)
+	}
+	return
+}
@@ -0,0 +1,121 @@
+package gohtml
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSelectAdjustedForeignNames(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<svg><foreignObject><p>x</p></foreignObject></svg><foreignobject>y</foreignobject>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"svg > foreignObject", "svg > foreignobject"} {
+		sel, err := CompileSelector(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if found := sel.SelectAll(doc); len(found) != 1 || found[0] != doc.Children[0].Children[0] {
+			t.Errorf("%q selects %d elements, want the <foreignObject>", text, len(found))
+		}
+	}
+}
+
+func TestSelectorSelectAll(t *testing.T) {
+	src := `<div id=d1 class="a b"><p id=p1 lang=en-US>x</p><p id=p2 title="Hello World"></p><span id=s1></span>` +
+		`<p id=p3 data-x="pre-mid-suf"><em id=e1>y</em></p></div><div id=d2><p id=p4 class=b></p></div>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		sel  string
+		want string
+	}{
+		{"p", "p1 p2 p3 p4"},
+		{"#p2", "p2"},
+		{".b", "d1 p4"},
+		{"div.a.b > p", "p1 p2 p3"},
+		{"div em", "e1"},
+		{"p + p", "p2"},
+		{"p ~ p", "p2 p3"},
+		{"span ~ *", "p3"},
+		{"[lang|=en]", "p1"},
+		{"[title~=World]", "p2"},
+		{"[title='hello world' i]", "p2"},
+		{"[data-x^=pre][data-x$=suf][data-x*=mid]", "p3"},
+		{"div > :first-child", "p1 p4"},
+		{"div > :last-child", "p3 p4"},
+		{":only-child", "e1 p4"},
+		{"p:nth-child(2n+1)", "p1 p4"},
+		{"p:nth-of-type(2)", "p2"},
+		{"p:nth-last-of-type(1)", "p3 p4"},
+		{"p:empty", "p2 p4"},
+		{"p:not(.b, #p1)", "p2 p3"},
+		{":root", "d1 d2"},
+		{"em, span", "s1 e1"},
+	}
+
+	for _, test := range tests {
+		sel, err := CompileSelector(test.sel)
+		if err != nil {
+			t.Errorf("CompileSelector(%q): %v", test.sel, err)
+			continue
+		}
+		ids := make([]string, 0)
+		for _, node := range sel.SelectAll(doc) {
+			ids = append(ids, node.Attrs["id"])
+		}
+		if got := strings.Join(ids, " "); got != test.want {
+			t.Errorf("%q selects %q, want %q", test.sel, got, test.want)
+		}
+		if first := sel.Select(doc); test.want != "" && first.Attrs["id"] != ids[0] {
+			t.Errorf("%q: Select = %q, want %q", test.sel, first.Attrs["id"], ids[0])
+		}
+	}
+}
+
+func TestCompileSelectorInvalid(t *testing.T) {
+	tests := []string{"", "p >", "[x", "a:bogus", ":nth-child(x)", "p,", "#"}
+
+	for _, text := range tests {
+		if _, err := CompileSelector(text); !errors.Is(err, SelectorErr) {
+			t.Errorf("CompileSelector(%q) = %v, want %v", text, err, SelectorErr)
+		}
+	}
+}
+
+func TestClosest(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<nav class="top"><ul><li><a>x</a></li></ul></nav><footer><p><a>y</a></p></footer><a>z</a>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	links := doc.FindAll("a", false)
+
+	tests := []struct {
+		link     int
+		selector string
+		want     string
+	}{
+		{0, "nav, footer", "nav"},
+		{1, "nav, footer", "footer"},
+		{2, "nav, footer", ""},
+		{0, "a", "a"},
+		{0, "nav.top > ul li", "li"},
+		{0, ".missing", ""},
+	}
+	for _, test := range tests {
+		got, err := links[test.link].Closest(test.selector)
+		if err != nil {
+			t.Errorf("Closest(%q) error: %v", test.selector, err)
+		} else if got == nil || got.Content != test.want || (test.want == "") != (got.Kind == InvalidNode) {
+			t.Errorf("link %d: Closest(%q) = %q, want %q", test.link, test.selector, got.Content, test.want)
+		}
+	}
+
+	if node, err := links[0].Closest("a["); !errors.Is(err, SelectorErr) || node == nil {
+		t.Errorf("Closest(%q) error = %v, want %v", "a[", err, SelectorErr)
+	}
+}
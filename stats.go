@@ -0,0 +1,88 @@
+package gohtml
+
+import "unsafe"
+
+// Statistics of a tree; see Node.Stats.
+type Stats struct {
+	// Number of nodes, including the root.
+	Nodes int
+
+	// Number of nodes of each kind.
+	Kinds map[NodeKind]int
+
+	// Number of elements with each tag name.
+	Tags map[string]int
+
+	// Greatest depth of any node, counting the root as depth 0.
+	MaxDepth int
+
+	// Total length in bytes of the contents of TextNodes and CDATANodes.
+	TextBytes int
+
+	// Approximate memory in bytes taken by the tree: its nodes, their
+	// contents, attributes, and children slices.  Memory shared between
+	// nodes or with the original document (e.g. with ParseFile) is counted
+	// once per node, so this is an upper bound.
+	Memory int
+}
+
+// Approximate memory taken by each entry of a Go map, beyond its key and
+// value, for Stats.Memory.
+const mapEntryOverhead = 16
+
+// Compute statistics of the tree rooted at node, e.g. to spot suspiciously
+// large or deep documents.
+func (node *Node) Stats() Stats {
+	stats := Stats{
+		Kinds: make(map[NodeKind]int),
+		Tags:  make(map[string]int),
+	}
+
+	type entry struct {
+		node  *Node
+		depth int
+	}
+	stk := make(stack[entry], 0, 16)
+	stk.Push(entry{node, 0})
+
+	for e, ok := stk.Pop(); ok; e, ok = stk.Pop() {
+		node := e.node
+		stats.Nodes++
+		stats.Kinds[node.Kind]++
+		stats.MaxDepth = max(stats.MaxDepth, e.depth)
+		if node.Kind == ElementNode {
+			stats.Tags[node.Content]++
+		} else if node.Kind == TextNode || node.Kind == CDATANode {
+			stats.TextBytes += len(node.Content)
+		}
+		stats.Memory += node.memory()
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(entry{node.Children[i], e.depth + 1})
+		}
+	}
+
+	return stats
+}
+
+// Approximate memory in bytes taken by node itself, not its children.
+func (node *Node) memory() int {
+	const (
+		stringSize  = int(unsafe.Sizeof(""))
+		pointerSize = int(unsafe.Sizeof(node))
+		attrLocSize = int(unsafe.Sizeof(AttrLoc{}))
+	)
+
+	n := int(unsafe.Sizeof(*node)) + len(node.Content)
+	for key, val := range node.Attrs {
+		n += 2*stringSize + len(key) + len(val) + mapEntryOverhead
+	}
+	for key, val := range node.RawAttrs {
+		n += 2*stringSize + len(key) + len(val) + mapEntryOverhead
+	}
+	n += len(node.AttrLocs) * (stringSize + attrLocSize + mapEntryOverhead)
+	n += cap(node.AttrOrder) * stringSize
+	n += cap(node.Children) * pointerSize
+	return n
+}
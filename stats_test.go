@@ -0,0 +1,45 @@
+package gohtml
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	src := "<div><p>ab<b>cde</b></p><!--c--><p>f</p></div>"
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q) error: %v", src, err)
+	}
+
+	stats := doc.Stats()
+	if stats.Nodes != 9 {
+		t.Errorf("Stats(%q).Nodes = %d, want 9", src, stats.Nodes)
+	}
+	wantKinds := map[NodeKind]int{DocumentNode: 1, ElementNode: 4, TextNode: 3, CommentNode: 1}
+	if !maps.Equal(stats.Kinds, wantKinds) {
+		t.Errorf("Stats(%q).Kinds = %v, want %v", src, stats.Kinds, wantKinds)
+	}
+	wantTags := map[string]int{"div": 1, "p": 2, "b": 1}
+	if !maps.Equal(stats.Tags, wantTags) {
+		t.Errorf("Stats(%q).Tags = %v, want %v", src, stats.Tags, wantTags)
+	}
+	if stats.MaxDepth != 4 {
+		t.Errorf("Stats(%q).MaxDepth = %d, want 4", src, stats.MaxDepth)
+	}
+	if stats.TextBytes != 6 {
+		t.Errorf("Stats(%q).TextBytes = %d, want 6", src, stats.TextBytes)
+	}
+
+	// memory grows with the contents of the tree
+	p := doc.Children[0].Children[2].Stats()
+	if p.Nodes != 2 || p.MaxDepth != 1 || p.Memory <= 0 || p.Memory >= stats.Memory {
+		t.Errorf("Stats of %q = %+v, want 2 nodes, depth 1, less memory than %d", "<p>f</p>", p, stats.Memory)
+	}
+	small, _, _ := Parse([]byte("<div><p>x</p></div>"))
+	big, _, _ := Parse([]byte("<div><p>" + strings.Repeat("x", 1000) + "</p></div>"))
+	if got, want := big.Stats().Memory, small.Stats().Memory+999; got != want {
+		t.Errorf("Stats of 1000 bytes of text: Memory = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,329 @@
+package gohtml
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// Size of the reads of ParseStream.
+const streamChunkSize = 64 << 10
+
+// Number of bytes ParseStream keeps past the end of a token before taking it
+// as complete, since lexing a token may look ahead of it, e.g. to tell
+// "</script>" from "</scripts>" at the end of raw text.
+const streamLookahead = 64
+
+// Parse HTML read from r with options applied, delivering each element that
+// matches sel to fn as soon as it is complete, and discarding the rest of the
+// document as it is parsed; see ParseOptions.ParseStream.
+func ParseStream(r io.Reader, sel *Selector, fn func(node *Node) error, opts ...Option) (err error, warns []error) {
+	var parseOpts ParseOptions
+	for _, opt := range opts {
+		opt(&parseOpts)
+	}
+	return parseOpts.ParseStream(r, sel, fn)
+}
+
+// Parse HTML read from r according to the options, in bounded memory: each
+// element that matches sel is delivered to fn, subtree and all, as soon as
+// its end is parsed, and then discarded along with the rest of the document
+// parsed so far.  Matches within a matching element aren't delivered on their
+// own.  Parsing stops with any error fn returns.
+//
+// Elements are matched as soon as they are opened, against their open
+// ancestors only: combinators through ancestors work, but sibling combinators
+// and pseudo-classes that depend on siblings or children (e.g. :last-child or
+// :empty) don't see discarded or unparsed nodes.  Nodes delivered to fn may
+// be kept; their Parent links lead to their ancestors as of when they were
+// delivered.
+//
+// The input is decoded from its character encoding as with Parse, but invalid
+// byte sequences are replaced without warnings, and locations are byte
+// offsets in the decoded input.  Arena, Workers, and ImplyDocument are
+// ignored.  Tokens are buffered whole, so MaxTokenSize bounds memory use on
+// untrusted input.
+func (opts ParseOptions) ParseStream(r io.Reader, sel *Selector, fn func(node *Node) error) (err error, warns []error) {
+	if sel == nil {
+		return fmt.Errorf("error parsing document: %w: nil selector", SelectorErr), warns
+	}
+
+	s := opts.newStreamer(r, sel, fn)
+	defer s.b.release()
+	err = s.run()
+	return err, s.b.warns
+}
+
+// Make a streamer for ParseStream reading from r, with the options applied.
+func (opts ParseOptions) newStreamer(r io.Reader, sel *Selector, fn func(node *Node) error) *streamer {
+	var warns []error
+	br := bufio.NewReaderSize(r, streamChunkSize)
+	head, _ := br.Peek(charsetPrescanLen)
+
+	charset := opts.Encoding
+	if charset == "" {
+		charset = DetectCharset(head)
+	}
+	if isUTF8Charset(charset) {
+		charset = "utf-8"
+	}
+	enc, encErr := htmlindex.Get(charset)
+	if encErr != nil {
+		// NOTE: parsed as UTF-8 instead
+		warn := errorAt(opts.startLoc(), "error decoding input: %w: %q", CharsetErr, charset)
+		warns = append(warns, warn)
+		charset = "utf-8"
+		enc, _ = htmlindex.Get(charset)
+	}
+
+	s := streamer{
+		sel:   sel,
+		fn:    fn,
+		opts:  &opts,
+		r:     enc.NewDecoder().Reader(br),
+		buf:   make([]byte, 0, streamChunkSize),
+		loc:   opts.startLoc(),
+		depth: make(map[*Node]int),
+	}
+	if charset == "utf-8" && bytes.HasPrefix(head, utf8BOM) {
+		// NOTE: the byte order mark isn't part of the document's content
+		br.Discard(len(utf8BOM))
+		s.base = len(utf8BOM)
+	}
+
+	opts.Arena = false
+	opts.optionalEnds = opts.optionalEndTags()
+	s.lx = lexer{opts: &opts}
+	s.b = newTreeBuilder(&opts)
+	s.b.warns = warns
+	return &s
+}
+
+// State of ParseStream.
+type streamer struct {
+	sel  *Selector
+	fn   func(node *Node) error
+	opts *ParseOptions
+
+	r   io.Reader
+	eof bool
+
+	// input read but not yet lexed, starting at the byte offset base
+	buf  []byte
+	base int
+	// location in buf to lex from, with Pos relative to buf
+	loc Location
+
+	lx lexer
+	b  *treeBuilder
+
+	// matching element being parsed, or one parsed but not yet delivered
+	match *Node
+	// number of tokens lexed, for cancellation checks
+	tokens int
+
+	// open elements as of the last delivery, and the depth in that stack of
+	// each, so that only the elements whose children changed are revisited
+	open  []*Node
+	depth map[*Node]int
+
+	// bytes lexed plus children visited, which should grow linearly with the
+	// input
+	work int
+}
+
+// Lex and parse the input, delivering matches until its end.
+func (s *streamer) run() error {
+	for {
+		if err := s.read(); err != nil {
+			return err
+		}
+
+		for s.loc.Pos < len(s.buf) {
+			if s.tokens++; s.tokens%cancelCheckInterval == 0 {
+				if err := s.opts.canceled(s.loc); err != nil {
+					return err
+				}
+			}
+
+			lx := s.lx
+			tok, next, err, warn := lx.next(s.buf, s.loc)
+			s.work += next.Pos - s.loc.Pos
+			if !s.eof && next.Pos+streamLookahead > len(s.buf) {
+				// NOTE: the token may continue past buf, or the lexer may
+				// have looked past it; lex it again once more is read
+				break
+			}
+			s.lx = lx
+			// NOTE: error policies are applied to lexing warnings as the
+			// tree is built
+			if warn != nil {
+				s.b.warns = append(s.b.warns, warn)
+			}
+			if err != nil {
+				return err
+			} else if err = s.lx.checkSize(tok, next); err != nil {
+				return err
+			}
+			s.loc = next
+
+			if tok.Kind == invalidToken {
+				continue
+			}
+			tok.Loc.Pos += s.base
+			tok.End.Pos += s.base
+			if _, err := s.b.push(tok); err != nil {
+				return err
+			} else if err := s.deliver(); err != nil {
+				return err
+			}
+		}
+
+		if s.eof {
+			break
+		}
+	}
+
+	end := s.loc
+	end.Pos += s.base
+	eofToken := token{Kind: eofToken, Loc: end, End: end}
+	if _, err := s.b.push(eofToken); err != nil {
+		return err
+	} else if err := s.b.finish(end, end); err != nil {
+		return err
+	} else if err := s.deliver(); err != nil {
+		return err
+	}
+
+	// NOTE: elements left open are complete at the end of the input
+	if match := s.match; match != nil {
+		s.match = nil
+		return s.fn(match)
+	}
+	return nil
+}
+
+// Drop the lexed part of buf and read more input into it.
+func (s *streamer) read() error {
+	n := copy(s.buf, s.buf[s.loc.Pos:])
+	s.base += s.loc.Pos
+	s.buf = s.buf[:n]
+	s.loc.Pos = 0
+	// NOTE: searches ahead are memoized by offset in buf, and more input
+	// may hold what they didn't find
+	s.lx.ifEnds, s.lx.endifs, s.lx.piEnds = search{}, search{}, search{}
+
+	if limit := s.opts.MaxTokenSize; limit > 0 && len(s.buf) > limit+streamLookahead {
+		loc := s.loc
+		loc.Pos += s.base
+		return errorAt(loc, "error lexing document: %w: token of more than %d bytes", SizeLimitErr, limit)
+	}
+
+	// NOTE: input left over is a token too long to lex yet, which is lexed
+	// again from its start once more is read; reading at least as much again
+	// keeps lexing long tokens linear overall
+	if free := max(streamChunkSize/2, len(s.buf)); cap(s.buf)-len(s.buf) < free {
+		s.buf = slices.Grow(s.buf, max(streamChunkSize, len(s.buf)))
+	}
+	n, err := io.ReadFull(s.r, s.buf[len(s.buf):cap(s.buf)])
+	s.buf = s.buf[:len(s.buf)+n]
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		s.eof = true
+	} else if err != nil {
+		return fmt.Errorf("error reading document: %w", err)
+	}
+
+	if limit := s.opts.MaxInputSize; limit > 0 && s.base+len(s.buf) > limit {
+		return fmt.Errorf("error parsing document: %w: more than %d bytes of input", SizeLimitErr, limit)
+	} else if s.eof && s.base+len(s.buf) == 0 {
+		return EmptyInputErr
+	}
+	return nil
+}
+
+// Deliver the match once complete, and discard every complete node outside
+// of it, after a token is parsed.
+func (s *streamer) deliver() error {
+	tags := s.b.tags
+
+	// NOTE: elements are only pushed and popped at the top of the stack, and
+	// each token adds at most one node (s.b.last), so only the parents of the
+	// popped elements and of the new node may have children to discard
+	n := min(len(tags), len(s.open))
+	for i, open := range s.open[:n] {
+		if tags[i] != open {
+			n = i
+			break
+		}
+	}
+	changed := make([]*Node, 0, 2)
+	mark := func(node *Node) {
+		if _, ok := s.depth[node]; ok && !slices.Contains(changed, node) {
+			changed = append(changed, node)
+		}
+	}
+	popped := s.open[n:]
+	for _, node := range popped {
+		delete(s.depth, node)
+	}
+	for i, node := range tags[n:] {
+		s.depth[node] = n + i
+	}
+	for _, node := range popped {
+		mark(node.Parent)
+	}
+	if last := s.b.last; last != nil {
+		mark(last.Parent)
+	}
+	clear(popped)
+	s.open = append(s.open[:n], tags[n:]...)
+
+	for _, open := range changed {
+		// keep the children of the open match and of elements within it
+		if depth, ok := s.depth[s.match]; ok && s.depth[open] >= depth {
+			continue
+		}
+
+		kept := open.Children[:0]
+		for _, child := range open.Children {
+			s.work++
+			if _, ok := s.depth[child]; ok {
+				kept = append(kept, child)
+			} else if err := s.complete(child); err != nil {
+				return err
+			}
+		}
+		clear(open.Children[len(kept):])
+		open.Children = kept
+	}
+
+	// match an element once opened, so that its subtree is kept
+	if last := s.b.last; s.match == nil && last != nil {
+		if _, ok := s.depth[last]; ok && s.sel.Match(last) {
+			s.match = last
+		}
+	}
+	return nil
+}
+
+// Deliver a complete node about to be discarded if it is or contains the
+// match, or if it is a new matching node without children.
+func (s *streamer) complete(node *Node) error {
+	if s.match != nil {
+		for m := s.match; m != nil; m = m.Parent {
+			if m == node {
+				match := s.match
+				s.match = nil
+				return s.fn(match)
+			}
+		}
+		return nil
+	} else if node == s.b.last && s.sel.Match(node) {
+		return s.fn(node)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package gohtml
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// Work done streaming data, in bytes lexed and children visited.
+func streamWork(t *testing.T, data []byte) int {
+	t.Helper()
+	sel, err := CompileSelector("p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := ParseOptions{}.newStreamer(bytes.NewReader(data), sel, func(node *Node) error { return nil })
+	defer s.b.release()
+	if err := s.run(); err != nil {
+		t.Fatal(err)
+	}
+	return s.work
+}
+
+func TestParseStreamLinear(t *testing.T) {
+	const n = 1 << 16
+	inputs := map[string]string{
+		"comment":  "<!--" + strings.Repeat("x", n) + "-->",
+		"text":     "<p>" + strings.Repeat("x", n) + "</p>",
+		"script":   "<script>" + strings.Repeat("x", n) + "</script>",
+		"tag":      `<p title="` + strings.Repeat("x", n) + `">`,
+		"nested":   strings.Repeat("<div>", n/5) + "<a>x</a>",
+		"closed":   strings.Repeat("<div>", n/10) + strings.Repeat("</div>", n/10),
+		"siblings": "<div>" + strings.Repeat("<p>x</p>", n/8) + "</div>",
+	}
+	for name, input := range inputs {
+		work := streamWork(t, []byte(input))
+		t.Logf("%s: %d bytes, work %d", name, len(input), work)
+		if work > 4*len(input) {
+			t.Errorf("%s: streaming %d bytes took %d steps of work, want at most %d", name, len(input), work, 4*len(input))
+		}
+	}
+}
+
+func TestParseStreamNilSelector(t *testing.T) {
+	err, _ := ParseStream(strings.NewReader("<p>x</p>"), nil, func(node *Node) error { return nil })
+	if !errors.Is(err, SelectorErr) {
+		t.Errorf("ParseStream with nil selector = %v, want %v", err, SelectorErr)
+	}
+}
+
+func TestParseStreamAcrossReads(t *testing.T) {
+	long := strings.Repeat("x", 3*streamChunkSize)
+	data := "<div><p>a</p><!--" + long + "--><p>" + long + "</p><p title='" + long + "'>b</p></div>"
+	sel, err := CompileSelector("p")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var texts []string
+	err, _ = ParseStream(strings.NewReader(data), sel, func(node *Node) error {
+		texts = append(texts, node.Text())
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(texts) != 3 || texts[0] != "a" || texts[1] != long || texts[2] != "b" {
+		t.Errorf("delivered %d elements, want 3 with texts %q, %d bytes, and %q", len(texts), "a", len(long), "b")
+	}
+}
+
+func TestParseStreamSizeLimits(t *testing.T) {
+	sel, err := CompileSelector("p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	long := strings.Repeat("x", 2*streamChunkSize)
+	noop := func(node *Node) error { return nil }
+
+	tests := []struct {
+		name string
+		in   string
+		opt  Option
+	}{
+		{"input", "<p>" + long + "</p>", WithMaxInputSize(streamChunkSize)},
+		{"token", "<!--" + long + "-->", WithMaxTokenSize(streamChunkSize)},
+	}
+
+	for _, test := range tests {
+		err, _ := ParseStream(strings.NewReader(test.in), sel, noop, test.opt)
+		if !errors.Is(err, SizeLimitErr) {
+			t.Errorf("ParseStream over %s limit error = %v, want %v", test.name, err, SizeLimitErr)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package gohtml
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Iterate over the descendants of the node (excluding the node itself) in
+// document order, i.e. depth-first, with each node before its children.
+// Stopping the iteration early, e.g. with break, skips the rest of the tree.
+//
+// Nodes must not be added or removed from the tree during iteration.
+func (node *Node) Descendants() iter.Seq[*Node] {
+	return node.descendants(func(*Node) bool { return true })
+}
+
+// Iterate over the descendant ElementNodes of the node in document order, as
+// with Descendants.
+func (node *Node) Elements() iter.Seq[*Node] {
+	return node.descendants(func(node *Node) bool { return node.Kind == ElementNode })
+}
+
+// Iterate over the descendant TextNodes of the node in document order, as
+// with Descendants.
+func (node *Node) TextNodes() iter.Seq[*Node] {
+	return node.descendants(func(node *Node) bool { return node.Kind == TextNode })
+}
+
+// Iterate over the descendants of the node for which keep returns true, in
+// document order.
+func (node *Node) descendants(keep func(node *Node) bool) iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		stk := make(stack[*Node], 0, 16)
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+
+		for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+			if keep(node) && !yield(node) {
+				return
+			}
+
+			// reverse iteration so that first child is pushed last
+			for i := len(node.Children) - 1; i >= 0; i-- {
+				stk.Push(node.Children[i])
+			}
+		}
+	}
+}
+
+// Iterate over the descendants of the node (excluding the node itself)
+// breadth-first, i.e. level by level, each level in document order, e.g. to
+// find the shallowest match when similar elements are nested.  Stopping the
+// iteration early skips the rest of the tree.
+//
+// Nodes must not be added or removed from the tree during iteration.
+func (node *Node) DescendantsBFS() iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		queue := append(make([]*Node, 0, 16), node.Children...)
+		for i := 0; i < len(queue); i++ {
+			if !yield(queue[i]) {
+				return
+			}
+			queue = append(queue, queue[i].Children...)
+		}
+	}
+}
+
+// Find the shallowest descendant node that has the tag name tagName, or the
+// first in document order of the shallowest ones, searching breadth-first.
+// Returns an empty, non-nil *Node of InvalidNode kind if no matching
+// descendant was found.
+func (node *Node) FindBFS(tagName string) *Node {
+	return node.FindFuncBFS(func(node *Node) bool {
+		return node.Kind == ElementNode && node.Content == tagName
+	})
+}
+
+// Find the shallowest descendant node for which pred returns true, or the
+// first in document order of the shallowest ones, searching breadth-first.
+// Returns an empty, non-nil *Node of InvalidNode kind if no matching
+// descendant was found.
+func (node *Node) FindFuncBFS(pred func(node *Node) bool) *Node {
+	if pred(node) {
+		return node
+	}
+	for node := range node.DescendantsBFS() {
+		if pred(node) {
+			return node
+		}
+	}
+	return EmptyNode()
+}
+
+// Action for Walk to take after visiting a node.
+type WalkAction int
+
+const (
+	// Go on to the node's children, then the rest of the tree.
+	ContinueWalk WalkAction = iota
+	// Skip the node's children, going on to the rest of the tree.
+	SkipChildren
+	// Stop walking the tree.
+	StopWalk
+)
+
+// Error message-friendly string representation.
+func (action WalkAction) String() string {
+	switch action {
+	case SkipChildren:
+		return "SkipChildren"
+	case StopWalk:
+		return "StopWalk"
+	default:
+		return "ContinueWalk"
+	}
+}
+
+// Visit the node and its descendants in document order, calling fn with each
+// node and its depth below the node, which has depth 0.  What fn returns
+// decides whether to visit the node's children and whether to go on; see
+// WalkAction.  Reports whether the walk was stopped by StopWalk.
+//
+// fn may modify the children of the node it visits, which are visited as
+// modified, but not those of any other node.
+func (node *Node) Walk(fn func(node *Node, depth int) WalkAction) bool {
+	type entry struct {
+		node  *Node
+		depth int
+	}
+	stk := make(stack[entry], 0, 16)
+	stk.Push(entry{node, 0})
+
+	for e, ok := stk.Pop(); ok; e, ok = stk.Pop() {
+		switch fn(e.node, e.depth) {
+		case StopWalk:
+			return true
+		case SkipChildren:
+			continue
+		}
+
+		// reverse iteration so that first child is pushed last
+		for i := len(e.node.Children) - 1; i >= 0; i-- {
+			stk.Push(entry{e.node.Children[i], e.depth + 1})
+		}
+	}
+
+	return false
+}
+
+// Iterate over the ancestors of the node (excluding the node itself) through
+// their Parent links, from its parent up to the root of its tree.
+func (node *Node) Ancestors() iter.Seq[*Node] {
+	return func(yield func(*Node) bool) {
+		for node := node.Parent; node != nil; node = node.Parent {
+			if !yield(node) {
+				return
+			}
+		}
+	}
+}
+
+// Return the node's children that are ElementNodes, skipping text, comments,
+// and other kinds of nodes.  Returns an empty, non-nil slice of *Node if
+// there are none.
+func (node *Node) ElementChildren() []*Node {
+	children := make([]*Node, 0, len(node.Children))
+	for _, child := range node.Children {
+		if child.Kind == ElementNode {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+// Return the node's first child that is an ElementNode.  Returns an empty,
+// non-nil *Node of InvalidNode kind if there is none.
+func (node *Node) FirstElementChild() *Node {
+	for _, child := range node.Children {
+		if child.Kind == ElementNode {
+			return child
+		}
+	}
+	return EmptyNode()
+}
+
+// Return the node's last child that is an ElementNode.  Returns an empty,
+// non-nil *Node of InvalidNode kind if there is none.
+func (node *Node) LastElementChild() *Node {
+	for i := len(node.Children) - 1; i >= 0; i-- {
+		if child := node.Children[i]; child.Kind == ElementNode {
+			return child
+		}
+	}
+	return EmptyNode()
+}
+
+// Return the 0-indexed position of the node among its parent's children, or
+// -1 if it has no parent.
+func (node *Node) Index() int {
+	if node.Parent == nil {
+		return -1
+	}
+	for i, sib := range node.Parent.Children {
+		if sib == node {
+			return i
+		}
+	}
+	return -1
+}
+
+// Return the 0-indexed position of the element among its parent's children
+// that are ElementNodes, or -1 if it isn't an ElementNode or has no parent.
+func (node *Node) ElementIndex() int {
+	if node.Kind != ElementNode || node.Parent == nil {
+		return -1
+	}
+	i := 0
+	for _, sib := range node.Parent.Children {
+		if sib == node {
+			return i
+		} else if sib.Kind == ElementNode {
+			i++
+		}
+	}
+	return -1
+}
+
+// Return the node's n-th child that is an ElementNode, counting from 1 as
+// with :nth-child().  Returns an empty, non-nil *Node of InvalidNode kind if
+// there is none.
+func (node *Node) NthChild(n int) *Node {
+	for _, child := range node.Children {
+		if child.Kind == ElementNode {
+			if n--; n == 0 {
+				return child
+			}
+		}
+	}
+	return EmptyNode()
+}
+
+// Return the node's n-th child element with the tag name tagName, counting
+// from 1 as with :nth-of-type().  Returns an empty, non-nil *Node of
+// InvalidNode kind if there is none.
+func (node *Node) NthOfType(tagName string, n int) *Node {
+	for _, child := range node.Children {
+		if child.Kind == ElementNode && child.Content == tagName {
+			if n--; n == 0 {
+				return child
+			}
+		}
+	}
+	return EmptyNode()
+}
+
+// Return a CSS selector locating the element by its ancestry, e.g.
+// "html:root > body > div:nth-child(3) > a:nth-child(1)", for diffs, error
+// messages, or finding the element again in another parse of the same
+// document.  Elements are qualified with :nth-child() if they have sibling
+// elements with the same tag name, and the topmost element with :root if it
+// is the root of the document.  Only applicable to ElementNode; returns ""
+// for any other NodeKind.
+func (node *Node) Path() string {
+	if node.Kind != ElementNode {
+		return ""
+	}
+
+	var parts []string
+	for ; node != nil && node.Kind == ElementNode; node = node.Parent {
+		part := escapeIdent(node.Content)
+		if node.hasSameTagSibling() {
+			part += ":nth-child(" + strconv.Itoa(node.ElementIndex()+1) + ")"
+		}
+		if node.Parent == nil || node.Parent.Kind == DocumentNode {
+			part += ":root"
+		}
+		parts = append(parts, part)
+	}
+
+	slices.Reverse(parts)
+	return strings.Join(parts, " > ")
+}
+
+// Whether the element has a sibling element with the same tag name.
+func (node *Node) hasSameTagSibling() bool {
+	if node.Parent == nil {
+		return false
+	}
+	for _, sib := range node.Parent.Children {
+		if sib != node && sib.Kind == ElementNode && sib.Content == node.Content {
+			return true
+		}
+	}
+	return false
+}
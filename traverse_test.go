@@ -0,0 +1,318 @@
+package gohtml
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+func TestPath(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<section><div><a>0</a></div></section><div><a>1</a></div>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := doc.Children[1].Children[0]
+	if got, want := a.Path(), "div:root > a"; got != want {
+		t.Errorf("Path() = %q, want %q", got, want)
+	}
+}
+
+func TestPathSelects(t *testing.T) {
+	tests := []string{
+		`<section><div><a>0</a></div></section><div><a>1</a></div>`,
+		`<ul><li>a<li>b<li><ul><li>c</ul></ul><ul><li>d</ul>`,
+		`<p><o:p>x</o:p><o:p>y</o:p></p>`,
+		`<svg><foreignObject><p>x</p></foreignObject><foreignObject><p>y</p></foreignObject></svg>`,
+		`<math><mi>x</mi></math><svg><clipPath><rect/></clipPath></svg>`,
+		`<table><tr><td>a<td>b</table><table><tr><td>c</table>`,
+	}
+
+	for _, src := range tests {
+		for _, opts := range [][]Option{nil, {WithImpliedDocument()}} {
+			doc, err, _ := ParseWithOptions([]byte(src), opts...)
+			if err != nil {
+				t.Fatalf("Parse(%q): %v", src, err)
+			}
+			for node := range doc.Descendants() {
+				if node.Kind != ElementNode {
+					continue
+				}
+				path := node.Path()
+				sel, err := CompileSelector(path)
+				if err != nil {
+					t.Errorf("%q: CompileSelector(%q): %v", src, path, err)
+					continue
+				}
+				if found := sel.SelectAll(doc); len(found) != 1 || found[0] != node {
+					t.Errorf("%q: %q selects %d elements, want only %q", src, path, len(found), node.Content)
+				}
+			}
+		}
+	}
+}
+
+// Contents of the nodes in seq, e.g. tag names of elements.
+func contents(seq iter.Seq[*Node]) []string {
+	var got []string
+	for node := range seq {
+		got = append(got, node.Content)
+	}
+	return got
+}
+
+func TestDescendants(t *testing.T) {
+	doc, err, _ := Parse([]byte("<div><p>a<b>b</b></p><!--c--><ul><li>d</ul></div>e"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		seq  iter.Seq[*Node]
+		want []string
+	}{
+		{"Descendants", doc.Descendants(), []string{"div", "p", "a", "b", "b", "c", "ul", "li", "d", "e"}},
+		{"Elements", doc.Elements(), []string{"div", "p", "b", "ul", "li"}},
+		{"TextNodes", doc.TextNodes(), []string{"a", "b", "d", "e"}},
+		{"ul.Descendants", doc.Find("ul").Descendants(), []string{"li", "d"}},
+		{"li.Elements", doc.Find("li").Elements(), nil},
+	}
+	for _, test := range tests {
+		if got := contents(test.seq); !slices.Equal(got, test.want) {
+			t.Errorf("%s = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	// breaking early stops the iteration
+	var got []string
+	for node := range doc.Elements() {
+		got = append(got, node.Content)
+		if node.Content == "b" {
+			break
+		}
+	}
+	if want := []string{"div", "p", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Elements until b = %q, want %q", got, want)
+	}
+}
+
+func TestDescendantsBFS(t *testing.T) {
+	doc, err, _ := Parse([]byte(`<div class="w"><p>a</p><div class="w"><p>b</p></div></div><p>c</p>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"div", "p", "p", "div", "c", "a", "p", "b"}
+	if got := contents(doc.DescendantsBFS()); !slices.Equal(got, want) {
+		t.Errorf("DescendantsBFS = %q, want %q", got, want)
+	}
+	for node := range doc.DescendantsBFS() {
+		if node.Content != "div" {
+			t.Errorf("DescendantsBFS didn't stop at the first node: got %q", node.Content)
+		}
+		break
+	}
+
+	// the shallowest p is the last in document order
+	if got := doc.FindBFS("p"); got.Text() != "c" {
+		t.Errorf("FindBFS(p) = %q, want %q", got.Text(), "c")
+	}
+	if got := doc.Find("p"); got.Text() != "a" {
+		t.Errorf("Find(p) = %q, want %q", got.Text(), "a")
+	}
+	inner := func(node *Node) bool { return node.HasClass("w") && node.Parent.Kind == ElementNode }
+	if got := doc.FindFuncBFS(inner); got.Text() != "b" {
+		t.Errorf("FindFuncBFS(inner) = %q, want %q", got.Text(), "b")
+	}
+	if got := doc.FindFuncBFS(func(node *Node) bool { return node.Kind == DocumentNode }); got != doc {
+		t.Errorf("FindFuncBFS(document) = %v, want the node itself", got)
+	}
+	if got := doc.FindBFS("table"); got == nil || got.Kind != InvalidNode {
+		t.Errorf("FindBFS(table) = %v, want empty node", got)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	doc, err, _ := Parse([]byte("<div><p>a<b>b</b></p><nav><a>x</a></nav><p>c</p></div>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type visit struct {
+		content string
+		depth   int
+	}
+	tests := []struct {
+		name    string
+		action  func(node *Node) WalkAction
+		want    []visit
+		stopped bool
+	}{
+		{
+			"continue",
+			func(node *Node) WalkAction { return ContinueWalk },
+			[]visit{{"", 0}, {"div", 1}, {"p", 2}, {"a", 3}, {"b", 3}, {"b", 4}, {"nav", 2}, {"a", 3}, {"x", 4}, {"p", 2}, {"c", 3}},
+			false,
+		},
+		{
+			"skip",
+			func(node *Node) WalkAction {
+				if node.Content == "p" || node.Content == "nav" {
+					return SkipChildren
+				}
+				return ContinueWalk
+			},
+			[]visit{{"", 0}, {"div", 1}, {"p", 2}, {"nav", 2}, {"p", 2}},
+			false,
+		},
+		{
+			"stop",
+			func(node *Node) WalkAction {
+				if node.Content == "nav" {
+					return StopWalk
+				}
+				return ContinueWalk
+			},
+			[]visit{{"", 0}, {"div", 1}, {"p", 2}, {"a", 3}, {"b", 3}, {"b", 4}, {"nav", 2}},
+			true,
+		},
+	}
+
+	for _, test := range tests {
+		var got []visit
+		stopped := doc.Walk(func(node *Node, depth int) WalkAction {
+			got = append(got, visit{node.Content, depth})
+			return test.action(node)
+		})
+		if !slices.Equal(got, test.want) {
+			t.Errorf("Walk(%s) visited %v, want %v", test.name, got, test.want)
+		}
+		if stopped != test.stopped {
+			t.Errorf("Walk(%s) = %v, want %v", test.name, stopped, test.stopped)
+		}
+	}
+
+	// children modified by fn are visited as modified
+	var got []string
+	doc.Find("nav").Walk(func(node *Node, depth int) WalkAction {
+		if node.Content == "nav" {
+			node.Children = node.Children[:0]
+		}
+		got = append(got, node.Content)
+		return ContinueWalk
+	})
+	if want := []string{"nav"}; !slices.Equal(got, want) {
+		t.Errorf("Walk after removing children visited %q, want %q", got, want)
+	}
+
+	for action, want := range map[WalkAction]string{ContinueWalk: "ContinueWalk", SkipChildren: "SkipChildren", StopWalk: "StopWalk"} {
+		if got := action.String(); got != want {
+			t.Errorf("WalkAction(%d).String() = %q, want %q", action, got, want)
+		}
+	}
+}
+
+func TestAncestors(t *testing.T) {
+	doc, err, _ := Parse([]byte("<nav><ul><li><a>x</a></li></ul></nav>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := doc.Find("a")
+
+	if got, want := contents(a.Ancestors()), []string{"li", "ul", "nav", ""}; !slices.Equal(got, want) {
+		t.Errorf("Ancestors = %q, want %q", got, want)
+	}
+	for node := range a.Ancestors() {
+		if node.Content != "li" {
+			t.Errorf("Ancestors didn't stop at the parent: got %q", node.Content)
+		}
+		break
+	}
+	if got := contents(doc.Ancestors()); got != nil {
+		t.Errorf("Ancestors of the document = %q, want none", got)
+	}
+}
+
+func TestElementChildren(t *testing.T) {
+	doc, err, _ := Parse([]byte("<div>a<!--b--><p>c</p>d<span>e</span>f</div><p>g</p>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	div := doc.Find("div")
+
+	if got, want := contents(slices.Values(div.ElementChildren())), []string{"p", "span"}; !slices.Equal(got, want) {
+		t.Errorf("ElementChildren = %q, want %q", got, want)
+	}
+	if got := div.FirstElementChild(); got.Content != "p" {
+		t.Errorf("FirstElementChild = %q, want p", got.Content)
+	}
+	if got := div.LastElementChild(); got.Content != "span" {
+		t.Errorf("LastElementChild = %q, want span", got.Content)
+	}
+
+	p := doc.Find("p")
+	if got := p.ElementChildren(); got == nil || len(got) != 0 {
+		t.Errorf("ElementChildren of p = %v, want empty slice", got)
+	}
+	for name, got := range map[string]*Node{"FirstElementChild": p.FirstElementChild(), "LastElementChild": p.LastElementChild()} {
+		if got == nil || got.Kind != InvalidNode {
+			t.Errorf("%s of p = %v, want empty node", name, got)
+		}
+	}
+}
+
+func TestSiblingPositions(t *testing.T) {
+	doc, err, _ := Parse([]byte("<tr>a<td>1</td><!--c--><th>2</th><td>3</td></tr>"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := doc.Find("tr")
+
+	tests := []struct {
+		child   int
+		index   int
+		elIndex int
+	}{
+		{0, 0, -1},
+		{1, 1, 0},
+		{2, 2, -1},
+		{3, 3, 1},
+		{4, 4, 2},
+	}
+	for _, test := range tests {
+		node := tr.Children[test.child]
+		if got := node.Index(); got != test.index {
+			t.Errorf("child %d: Index() = %d, want %d", test.child, got, test.index)
+		}
+		if got := node.ElementIndex(); got != test.elIndex {
+			t.Errorf("child %d: ElementIndex() = %d, want %d", test.child, got, test.elIndex)
+		}
+	}
+	if doc.Index() != -1 || doc.ElementIndex() != -1 {
+		t.Errorf("document: Index(), ElementIndex() = %d, %d, want -1, -1", doc.Index(), doc.ElementIndex())
+	}
+	if orphan := (&Node{Kind: ElementNode, Content: "p"}); orphan.Index() != -1 || orphan.ElementIndex() != -1 {
+		t.Errorf("orphan: Index(), ElementIndex() = %d, %d, want -1, -1", orphan.Index(), orphan.ElementIndex())
+	}
+
+	nth := []struct {
+		name string
+		got  *Node
+		want string
+	}{
+		{"NthChild(1)", tr.NthChild(1), "1"},
+		{"NthChild(2)", tr.NthChild(2), "2"},
+		{"NthChild(3)", tr.NthChild(3), "3"},
+		{"NthChild(4)", tr.NthChild(4), ""},
+		{"NthChild(0)", tr.NthChild(0), ""},
+		{"NthOfType(td, 1)", tr.NthOfType("td", 1), "1"},
+		{"NthOfType(td, 2)", tr.NthOfType("td", 2), "3"},
+		{"NthOfType(th, 1)", tr.NthOfType("th", 1), "2"},
+		{"NthOfType(th, 2)", tr.NthOfType("th", 2), ""},
+	}
+	for _, test := range nth {
+		if test.got == nil || test.got.Text() != test.want || (test.want == "") != (test.got.Kind == InvalidNode) {
+			t.Errorf("%s = %q, want %q", test.name, test.got.Text(), test.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package gohtml
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Transaction of mutations on a node tree, which can be committed or rolled
+// back as a whole, so that a multi-step transformation that fails halfway
+// doesn't leave the tree in an intermediate state.
+//
+// Mutations are applied immediately and undone on rollback.  Mutations made to
+// the tree other than through the transaction are not undone.  A transaction
+// is not safe for concurrent use.
+type Tx struct {
+	undo []func()
+	done bool
+}
+
+// Begin a transaction of mutations, which may span several trees.  Typical
+// usage:
+//
+//	tx := gohtml.Begin()
+//	defer tx.Rollback()
+//	// ... mutations through tx ...
+//	return tx.Commit()
+func Begin() *Tx {
+	return &Tx{undo: make([]func(), 0, 16)}
+}
+
+func (tx *Tx) check(op string) error {
+	if tx.done {
+		return fmt.Errorf("error applying %s: %w", op, TxDoneErr)
+	}
+	return nil
+}
+
+// Set the attribute key of node to val.
+func (tx *Tx) SetAttr(node *Node, key string, val string) error {
+	if err := tx.check("SetAttr"); err != nil {
+		return err
+	}
+
+	key = node.attrKey(key)
+	oldVal, ok := node.Attrs[key]
+	tx.undo = append(tx.undo, func() {
+		if ok {
+			node.SetAttr(key, oldVal)
+		} else {
+			node.RemoveAttr(key)
+		}
+	})
+
+	node.SetAttr(key, val)
+	return nil
+}
+
+// Remove the attribute key from node.
+func (tx *Tx) RemoveAttr(node *Node, key string) error {
+	if err := tx.check("RemoveAttr"); err != nil {
+		return err
+	}
+
+	key = node.attrKey(key)
+	oldVal, ok := node.Attrs[key]
+	if !ok {
+		return nil
+	}
+	oldRaw, rawOk := node.RawAttrs[key]
+	oldLoc, locOk := node.AttrLocs[key]
+	oldOrder := slices.Clone(node.AttrOrder)
+	tx.undo = append(tx.undo, func() {
+		node.SetAttr(key, oldVal)
+		node.AttrOrder = oldOrder
+		if rawOk {
+			node.RawAttrs[key] = oldRaw
+		}
+		if locOk {
+			node.AttrLocs[key] = oldLoc
+		}
+	})
+
+	node.RemoveAttr(key)
+	return nil
+}
+
+// Set the content (text or tag name) of node.
+func (tx *Tx) SetContent(node *Node, content string) error {
+	if err := tx.check("SetContent"); err != nil {
+		return err
+	}
+
+	oldContent := node.Content
+	tx.undo = append(tx.undo, func() {
+		node.Content = oldContent
+	})
+
+	node.Content = content
+	return nil
+}
+
+// Insert child into the children of parent at index i, first removing it from
+// the children of its old parent, if any.  The index is into the children of
+// parent as they are after the removal.
+func (tx *Tx) InsertChild(parent *Node, i int, child *Node) error {
+	if err := tx.check("InsertChild"); err != nil {
+		return err
+	}
+
+	oldParent := child.Parent
+	j := -1
+	if oldParent != nil {
+		j = slices.Index(oldParent.Children, child)
+	}
+	n := len(parent.Children)
+	if oldParent == parent && j >= 0 {
+		n--
+	}
+	if i < 0 || i > n {
+		return fmt.Errorf("error applying InsertChild: %w: %d", IndexErr, i)
+	}
+
+	tx.undo = append(tx.undo, func() {
+		parent.Children = slices.Delete(parent.Children, i, i+1)
+		if j >= 0 {
+			oldParent.Children = slices.Insert(oldParent.Children, j, child)
+		}
+		child.Parent = oldParent
+	})
+
+	if j >= 0 {
+		oldParent.Children = slices.Delete(oldParent.Children, j, j+1)
+	}
+	parent.Children = slices.Insert(parent.Children, i, child)
+	child.Parent = parent
+	return nil
+}
+
+// Append child to the children of parent, first removing it from the children
+// of its old parent, if any.
+func (tx *Tx) AppendChild(parent *Node, child *Node) error {
+	i := len(parent.Children)
+	if child.Parent == parent && slices.Contains(parent.Children, child) {
+		i--
+	}
+	return tx.InsertChild(parent, i, child)
+}
+
+// Remove the child at index i from the children of parent, returning it.
+func (tx *Tx) RemoveChild(parent *Node, i int) (*Node, error) {
+	if err := tx.check("RemoveChild"); err != nil {
+		return nil, err
+	} else if i < 0 || i >= len(parent.Children) {
+		return nil, fmt.Errorf("error applying RemoveChild: %w: %d", IndexErr, i)
+	}
+
+	child := parent.Children[i]
+	oldParent := child.Parent
+	tx.undo = append(tx.undo, func() {
+		parent.Children = slices.Insert(parent.Children, i, child)
+		child.Parent = oldParent
+	})
+
+	parent.Children = slices.Delete(parent.Children, i, i+1)
+	child.Parent = nil
+	return child, nil
+}
+
+// Commit the transaction, keeping its mutations.  Returns an error if the
+// transaction was already committed or rolled back.
+func (tx *Tx) Commit() error {
+	if err := tx.check("Commit"); err != nil {
+		return err
+	}
+	tx.done = true
+	tx.undo = nil
+	return nil
+}
+
+// Roll back the transaction, undoing its mutations in reverse order.  Does
+// nothing if the transaction was already committed or rolled back, so that it
+// may be deferred.
+func (tx *Tx) Rollback() {
+	if tx.done {
+		return
+	}
+	for i := len(tx.undo) - 1; i >= 0; i-- {
+		tx.undo[i]()
+	}
+	tx.done = true
+	tx.undo = nil
+}
@@ -0,0 +1,151 @@
+package gohtml
+
+import (
+	"errors"
+	"testing"
+)
+
+// Parse src and mutate it in a transaction, returning the document.
+func mutate(t *testing.T, src string, commit bool) *Node {
+	t.Helper()
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("Parse(%q): %v", src, err)
+	}
+
+	tx := Begin()
+	defer tx.Rollback()
+	div := doc.Find("div")
+	steps := []error{
+		tx.SetAttr(div, "id", "new"),
+		tx.SetAttr(div, "title", "added"),
+		tx.RemoveAttr(div, "class"),
+		tx.SetContent(div.Children[0].Children[0], "changed"),
+		tx.AppendChild(div, &Node{Kind: TextNode, Content: "appended"}),
+		tx.InsertChild(div, 0, &Node{Kind: CommentNode, Content: "inserted"}),
+	}
+	_, err = tx.RemoveChild(div, 1)
+	steps = append(steps, err)
+	for i, err := range steps {
+		if err != nil {
+			t.Fatalf("step %d: %v", i, err)
+		}
+	}
+	if commit {
+		if err := tx.Commit(); err != nil {
+			t.Fatalf("Commit: %v", err)
+		}
+	}
+	return doc
+}
+
+func TestTxRollback(t *testing.T) {
+	src := `<div class='a' id=old><p>text</p><br></div>`
+	doc := mutate(t, src, false)
+	if got := string(doc.RenderBytes()); got != src {
+		t.Errorf("rolled back = %q, want %q", got, src)
+	}
+	div := doc.Find("div")
+	for _, child := range div.Children {
+		if child.Parent != div {
+			t.Errorf("child %q has parent %v, want the div", child.Content, child.Parent)
+		}
+	}
+	if loc, ok := div.AttrLoc("class"); !ok || src[loc.Key.Start.Pos:loc.Key.End.Pos] != "class" {
+		t.Errorf("AttrLoc(%q) = %v, %v after rollback", "class", loc, ok)
+	}
+}
+
+func TestTxCommit(t *testing.T) {
+	doc := mutate(t, `<div class='a' id=old><p>text</p><br></div>`, true)
+	want := `<div id="new" title="added"><!--inserted--><br>appended</div>`
+	if got := string(doc.RenderBytes()); got != want {
+		t.Errorf("committed = %q, want %q", got, want)
+	}
+}
+
+func TestTxDone(t *testing.T) {
+	doc, _, _ := Parse([]byte("<p>a</p>"))
+	p := doc.Find("p")
+
+	tx := Begin()
+	if err := tx.InsertChild(p, 5, &Node{Kind: TextNode}); !errors.Is(err, IndexErr) {
+		t.Errorf("InsertChild out of range = %v, want %v", err, IndexErr)
+	}
+	if _, err := tx.RemoveChild(p, 1); !errors.Is(err, IndexErr) {
+		t.Errorf("RemoveChild out of range = %v, want %v", err, IndexErr)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	if err := tx.Commit(); !errors.Is(err, TxDoneErr) {
+		t.Errorf("second Commit = %v, want %v", err, TxDoneErr)
+	}
+	if err := tx.SetAttr(p, "id", "x"); !errors.Is(err, TxDoneErr) {
+		t.Errorf("SetAttr after Commit = %v, want %v", err, TxDoneErr)
+	}
+	tx.Rollback()
+	if _, ok := p.Attr("id"); ok {
+		t.Error("SetAttr after Commit was applied")
+	}
+}
+
+func TestTxMove(t *testing.T) {
+	tests := []struct {
+		name string
+		move func(tx *Tx, a, b *Node) error
+		want string
+	}{
+		{"append", func(tx *Tx, a, b *Node) error {
+			return tx.AppendChild(b, a.Children[0])
+		}, `<div id="a"><i>y</i></div><div id="b"><br><p>x</p></div>`},
+		{"insert", func(tx *Tx, a, b *Node) error {
+			return tx.InsertChild(b, 0, a.Children[0])
+		}, `<div id="a"><i>y</i></div><div id="b"><p>x</p><br></div>`},
+		{"append to same parent", func(tx *Tx, a, b *Node) error {
+			return tx.AppendChild(a, a.Children[0])
+		}, `<div id="a"><i>y</i><p>x</p></div><div id="b"><br></div>`},
+		{"insert into same parent", func(tx *Tx, a, b *Node) error {
+			return tx.InsertChild(a, 1, a.Children[0])
+		}, `<div id="a"><i>y</i><p>x</p></div><div id="b"><br></div>`},
+	}
+
+	byID := func(doc *Node, id string) *Node {
+		return doc.FindTag(Tag{Name: "div", Attrs: map[string]string{"id": id}})
+	}
+	src := `<div id="a"><p>x</p><i>y</i></div><div id="b"><br></div>`
+	for _, test := range tests {
+		doc, _, _ := Parse([]byte(src))
+		a, b := byID(doc, "a"), byID(doc, "b")
+
+		tx := Begin()
+		if err := test.move(tx, a, b); err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if got := string(doc.RenderBytes()); got != test.want {
+			t.Errorf("%s: moved = %q, want %q", test.name, got, test.want)
+		}
+		for _, div := range []*Node{a, b} {
+			for _, child := range div.Children {
+				if child.Parent != div {
+					t.Errorf("%s: child %q has parent %v, want %v", test.name, child.Content, child.Parent, div)
+				}
+			}
+		}
+
+		tx.Rollback()
+		if got := string(doc.RenderBytes()); got != src {
+			t.Errorf("%s: rolled back = %q, want %q", test.name, got, src)
+		}
+		if p := doc.Find("p"); p.Parent != a {
+			t.Errorf("%s: p has parent %v after rollback, want #a", test.name, p.Parent)
+		}
+	}
+
+	doc, _, _ := Parse([]byte(src))
+	a := byID(doc, "a")
+	if err := Begin().InsertChild(a, 2, a.Children[0]); !errors.Is(err, IndexErr) {
+		t.Errorf("InsertChild past the end of the same parent = %v, want %v", err, IndexErr)
+	}
+}
@@ -0,0 +1,1224 @@
+package gohtml
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Compiled XPath 1.0 expression.
+//
+// Supports location paths with all axes except namespace, predicates, and
+// the XPath 1.0 operators and core function library, except lang().
+// Variables are not supported.  Element and attribute names are matched
+// case-insensitively, and paths are evaluated through Node.Parent links.
+type XPath struct {
+	text string
+	expr xpExpr
+}
+
+// Result of evaluating an XPath expression.
+type XPathResult struct {
+	// Nodes selected by a node-set expression, in document order.  Selected
+	// attributes are represented by the element they belong to.
+	Nodes []*Node
+
+	// String value of each selected node (for attributes, the attribute
+	// value), or the single value of a string, number, or boolean expression.
+	Values []string
+}
+
+// Compile an XPath 1.0 expression.
+func CompileXPath(text string) (*XPath, error) {
+	tokens, err := lexXPath(text)
+	if err != nil {
+		return nil, err
+	}
+
+	p := xpParser{text: text, tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	} else if tok := p.peek(); tok.kind != xpEOF {
+		return nil, p.errorf(tok, "unexpected %q", tok.val)
+	}
+
+	return &XPath{text: text, expr: expr}, nil
+}
+
+// String representation; the expression as originally written.
+func (xp *XPath) String() string {
+	return xp.text
+}
+
+// Evaluate the expression with node as the context node.
+func (xp *XPath) Eval(node *Node) XPathResult {
+	root := node
+	for root.Parent != nil {
+		root = root.Parent
+	}
+
+	ev := xpEvaluator{root: root}
+	val := xp.expr.eval(&xpContext{ev: &ev, item: xpItem{node: node}, pos: 1, size: 1})
+
+	var res XPathResult
+	if items, ok := val.([]xpItem); ok {
+		res.Nodes = make([]*Node, len(items))
+		res.Values = make([]string, len(items))
+		for i, item := range items {
+			res.Nodes[i] = item.node
+			res.Values[i] = item.String()
+		}
+	} else {
+		res.Nodes = make([]*Node, 0)
+		res.Values = []string{xpString(val)}
+	}
+	return res
+}
+
+// Evaluate an XPath 1.0 expression with node as the context node, e.g.
+// node.XPath("//div[@id='main']//a/@href").  Returns an error if the
+// expression is invalid.
+func (node *Node) XPath(expr string) (XPathResult, error) {
+	xp, err := CompileXPath(expr)
+	if err != nil {
+		return XPathResult{Nodes: make([]*Node, 0)}, err
+	}
+	return xp.Eval(node), nil
+}
+
+// Node in the XPath data model; either a *Node or one of its attributes.
+type xpItem struct {
+	node *Node
+	attr string // attribute key; "" if the item is node itself
+}
+
+// XPath string value.
+func (item xpItem) String() string {
+	if item.attr != "" {
+		return item.node.Attrs[item.attr]
+	}
+	switch item.node.Kind {
+	case TextNode, CommentNode, DeclarationNode, CDATANode, ProcessingInstructionNode:
+		return item.node.Content
+	default:
+		return item.node.Text()
+	}
+}
+
+type xpEvaluator struct {
+	root  *Node
+	order map[*Node]int // position of each node in document order
+}
+
+// Sort items in document order, removing duplicates.
+func (ev *xpEvaluator) sortItems(items []xpItem) []xpItem {
+	if ev.order == nil {
+		ev.order = make(map[*Node]int)
+		stk := make(stack[*Node], 0, 16)
+		stk.Push(ev.root)
+		for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+			ev.order[node] = len(ev.order)
+
+			// reverse iteration so that first child is pushed last
+			for i := len(node.Children) - 1; i >= 0; i-- {
+				stk.Push(node.Children[i])
+			}
+		}
+	}
+
+	// attributes come after their element, but before its children
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.node != b.node {
+			return ev.order[a.node] < ev.order[b.node]
+		}
+		return a.attr < b.attr
+	})
+
+	result := items[:0]
+	for i, item := range items {
+		if i == 0 || item != items[i-1] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+type xpContext struct {
+	ev   *xpEvaluator
+	item xpItem
+	pos  int // 1-indexed context position
+	size int
+}
+
+// Value of an XPath expression: []xpItem (node-set, in document order),
+// string, float64, or bool.
+type xpValue any
+
+type xpExpr interface {
+	eval(c *xpContext) xpValue
+	nodes() bool // whether the expression evaluates to a node-set
+}
+
+type xpLiteral struct {
+	val xpValue
+}
+
+func (e *xpLiteral) eval(c *xpContext) xpValue {
+	return e.val
+}
+
+func (e *xpLiteral) nodes() bool {
+	return false
+}
+
+type xpBinary struct {
+	op   string
+	l, r xpExpr
+}
+
+func (e *xpBinary) eval(c *xpContext) xpValue {
+	switch e.op {
+	case "or":
+		return xpBool(e.l.eval(c)) || xpBool(e.r.eval(c))
+	case "and":
+		return xpBool(e.l.eval(c)) && xpBool(e.r.eval(c))
+	case "=", "!=", "<", "<=", ">", ">=":
+		return xpCompare(e.op, e.l.eval(c), e.r.eval(c))
+	}
+
+	l, r := xpNumber(e.l.eval(c)), xpNumber(e.r.eval(c))
+	switch e.op {
+	case "+":
+		return l + r
+	case "-":
+		return l - r
+	case "*":
+		return l * r
+	case "div":
+		return l / r
+	case "mod":
+		return math.Mod(l, r)
+	}
+	return math.NaN()
+}
+
+func (e *xpBinary) nodes() bool {
+	return false
+}
+
+type xpNeg struct {
+	x xpExpr
+}
+
+func (e *xpNeg) eval(c *xpContext) xpValue {
+	return -xpNumber(e.x.eval(c))
+}
+
+func (e *xpNeg) nodes() bool {
+	return false
+}
+
+type xpUnion struct {
+	l, r xpExpr
+}
+
+func (e *xpUnion) eval(c *xpContext) xpValue {
+	l, r := e.l.eval(c).([]xpItem), e.r.eval(c).([]xpItem)
+	items := make([]xpItem, 0, len(l)+len(r))
+	items = append(items, l...)
+	items = append(items, r...)
+	return c.ev.sortItems(items)
+}
+
+func (e *xpUnion) nodes() bool {
+	return true
+}
+
+// Primary expression filtered by predicates.
+type xpFilter struct {
+	primary xpExpr
+	preds   []xpExpr
+}
+
+func (e *xpFilter) eval(c *xpContext) xpValue {
+	val := e.primary.eval(c)
+	if len(e.preds) == 0 {
+		return val
+	}
+	return xpPredicates(c.ev, val.([]xpItem), e.preds)
+}
+
+func (e *xpFilter) nodes() bool {
+	return e.primary.nodes()
+}
+
+type xpAxis int
+
+const (
+	xpChild xpAxis = iota
+	xpDescendant
+	xpDescendantOrSelf
+	xpParent
+	xpAncestor
+	xpAncestorOrSelf
+	xpFollowingSibling
+	xpPrecedingSibling
+	xpFollowing
+	xpPreceding
+	xpAttribute
+	xpSelf
+)
+
+var xpAxes = map[string]xpAxis{
+	"child":              xpChild,
+	"descendant":         xpDescendant,
+	"descendant-or-self": xpDescendantOrSelf,
+	"parent":             xpParent,
+	"ancestor":           xpAncestor,
+	"ancestor-or-self":   xpAncestorOrSelf,
+	"following-sibling":  xpFollowingSibling,
+	"preceding-sibling":  xpPrecedingSibling,
+	"following":          xpFollowing,
+	"preceding":          xpPreceding,
+	"attribute":          xpAttribute,
+	"self":               xpSelf,
+}
+
+type xpStep struct {
+	axis  xpAxis
+	test  string // name, "*", or node type: "node()", "text()", etc.
+	preds []xpExpr
+}
+
+// Location path, optionally starting from the node-set of a filter
+// expression.
+type xpPath struct {
+	filter xpExpr // nil for location paths
+	abs    bool
+	steps  []xpStep
+}
+
+func (e *xpPath) eval(c *xpContext) xpValue {
+	var items []xpItem
+	if e.filter != nil {
+		items = e.filter.eval(c).([]xpItem)
+	} else if e.abs {
+		items = []xpItem{{node: c.ev.root}}
+	} else {
+		items = []xpItem{c.item}
+	}
+
+	for _, step := range e.steps {
+		next := make([]xpItem, 0, len(items))
+		for _, item := range items {
+			candidates := make([]xpItem, 0, 4)
+			xpAxisItems(item, step.axis, func(item xpItem) {
+				if xpTest(item, step.axis, step.test) {
+					candidates = append(candidates, item)
+				}
+			})
+			next = append(next, xpPredicates(c.ev, candidates, step.preds)...)
+		}
+		items = c.ev.sortItems(next)
+	}
+
+	return items
+}
+
+func (e *xpPath) nodes() bool {
+	return true
+}
+
+// Filter items, in the order that determines their context positions, by
+// each predicate in turn.
+func xpPredicates(ev *xpEvaluator, items []xpItem, preds []xpExpr) []xpItem {
+	for _, pred := range preds {
+		kept := make([]xpItem, 0, len(items))
+		for i, item := range items {
+			val := pred.eval(&xpContext{ev: ev, item: item, pos: i + 1, size: len(items)})
+			if num, ok := val.(float64); ok {
+				if num == float64(i+1) {
+					kept = append(kept, item)
+				}
+			} else if xpBool(val) {
+				kept = append(kept, item)
+			}
+		}
+		items = kept
+	}
+	return items
+}
+
+// Call yield with each item on the axis from item, in axis order (i.e.
+// reverse document order for reverse axes).
+func xpAxisItems(item xpItem, axis xpAxis, yield func(xpItem)) {
+	node := item.node
+	isAttr := item.attr != ""
+
+	switch axis {
+	case xpSelf:
+		yield(item)
+	case xpChild:
+		if !isAttr {
+			for _, child := range node.Children {
+				yield(xpItem{node: child})
+			}
+		}
+	case xpDescendantOrSelf:
+		yield(item)
+		fallthrough
+	case xpDescendant:
+		if !isAttr {
+			xpDescendants(node, yield)
+		}
+	case xpParent:
+		if isAttr {
+			yield(xpItem{node: node})
+		} else if node.Parent != nil {
+			yield(xpItem{node: node.Parent})
+		}
+	case xpAncestorOrSelf:
+		yield(item)
+		fallthrough
+	case xpAncestor:
+		if isAttr {
+			yield(xpItem{node: node})
+		}
+		for anc := node.Parent; anc != nil; anc = anc.Parent {
+			yield(xpItem{node: anc})
+		}
+	case xpFollowingSibling, xpPrecedingSibling:
+		if isAttr || node.Parent == nil {
+			return
+		}
+		siblings := node.Parent.Children
+		i := xpChildIndex(node)
+		if axis == xpFollowingSibling {
+			for _, sib := range siblings[i+1:] {
+				yield(xpItem{node: sib})
+			}
+		} else {
+			for j := i - 1; j >= 0; j-- {
+				yield(xpItem{node: siblings[j]})
+			}
+		}
+	case xpFollowing:
+		if isAttr {
+			xpDescendants(node, yield)
+		}
+		for n := node; n.Parent != nil; n = n.Parent {
+			for _, sib := range n.Parent.Children[xpChildIndex(n)+1:] {
+				yield(xpItem{node: sib})
+				xpDescendants(sib, yield)
+			}
+		}
+	case xpPreceding:
+		for n := node; n.Parent != nil; n = n.Parent {
+			siblings := n.Parent.Children
+			for j := xpChildIndex(n) - 1; j >= 0; j-- {
+				subtree := []xpItem{{node: siblings[j]}}
+				xpDescendants(siblings[j], func(item xpItem) {
+					subtree = append(subtree, item)
+				})
+				for k := len(subtree) - 1; k >= 0; k-- {
+					yield(subtree[k])
+				}
+			}
+		}
+	case xpAttribute:
+		if isAttr || node.Kind != ElementNode {
+			return
+		}
+		keys := make([]string, 0, len(node.Attrs))
+		for key := range node.Attrs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			yield(xpItem{node: node, attr: key})
+		}
+	}
+}
+
+// Call yield with each descendant of node, in document order.
+func xpDescendants(node *Node, yield func(xpItem)) {
+	stk := make(stack[*Node], 0, 16)
+	for i := len(node.Children) - 1; i >= 0; i-- {
+		stk.Push(node.Children[i])
+	}
+
+	for node, ok := stk.Pop(); ok; node, ok = stk.Pop() {
+		yield(xpItem{node: node})
+
+		// reverse iteration so that first child is pushed last
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			stk.Push(node.Children[i])
+		}
+	}
+}
+
+// Index of node among its parent's children.
+func xpChildIndex(node *Node) int {
+	for i, child := range node.Parent.Children {
+		if child == node {
+			return i
+		}
+	}
+	return len(node.Parent.Children)
+}
+
+// Whether item passes the node test on the axis.
+func xpTest(item xpItem, axis xpAxis, test string) bool {
+	switch test {
+	case "node()":
+		return true
+	case "text()":
+		return item.attr == "" && (item.node.Kind == TextNode || item.node.Kind == CDATANode)
+	case "comment()":
+		return item.attr == "" && item.node.Kind == CommentNode
+	case "processing-instruction()":
+		return item.attr == "" && item.node.Kind == ProcessingInstructionNode
+	}
+	if target, ok := strings.CutPrefix(test, "processing-instruction:"); ok {
+		return item.attr == "" && item.node.Kind == ProcessingInstructionNode &&
+			piTarget(item.node.Content) == target
+	}
+
+	// name test, for the principal node type of the axis
+	if axis == xpAttribute {
+		return item.attr != "" && (test == "*" || strings.EqualFold(item.attr, test))
+	}
+	return item.attr == "" && item.node.Kind == ElementNode &&
+		(test == "*" || strings.EqualFold(item.node.Content, test))
+}
+
+type xpCall struct {
+	fn   *xpFunc
+	args []xpExpr
+}
+
+func (e *xpCall) eval(c *xpContext) xpValue {
+	args := make([]xpValue, len(e.args))
+	for i, arg := range e.args {
+		args[i] = arg.eval(c)
+	}
+	return e.fn.call(c, args)
+}
+
+func (e *xpCall) nodes() bool {
+	return e.fn.retNodes
+}
+
+type xpFunc struct {
+	minArgs, maxArgs int // maxArgs < 0 for unlimited
+	nodeArg          bool
+	retNodes         bool
+	call             func(c *xpContext, args []xpValue) xpValue
+}
+
+// Core function library.
+var xpFuncs map[string]*xpFunc
+
+func init() {
+	// functions taking an optional argument that defaults to the context node
+	contextArg := func(c *xpContext, args []xpValue) xpValue {
+		if len(args) > 0 {
+			return args[0]
+		}
+		return []xpItem{c.item}
+	}
+	name := func(c *xpContext, args []xpValue) xpValue {
+		items := contextArg(c, args).([]xpItem)
+		if len(items) == 0 {
+			return ""
+		} else if items[0].attr != "" {
+			return items[0].attr
+		} else if items[0].node.Kind == ElementNode {
+			return items[0].node.Content
+		}
+		return ""
+	}
+
+	xpFuncs = map[string]*xpFunc{
+		"last": {0, 0, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return float64(c.size)
+		}},
+		"position": {0, 0, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return float64(c.pos)
+		}},
+		"count": {1, 1, true, false, func(c *xpContext, args []xpValue) xpValue {
+			return float64(len(args[0].([]xpItem)))
+		}},
+		"id": {1, 1, false, true, func(c *xpContext, args []xpValue) xpValue {
+			var ids []string
+			if items, ok := args[0].([]xpItem); ok {
+				for _, item := range items {
+					ids = append(ids, strings.FieldsFunc(item.String(), isSpaceR)...)
+				}
+			} else {
+				ids = strings.FieldsFunc(xpString(args[0]), isSpaceR)
+			}
+
+			items := make([]xpItem, 0, len(ids))
+			xpDescendants(c.ev.root, func(item xpItem) {
+				if id, ok := item.node.Attrs["id"]; ok && item.node.Kind == ElementNode {
+					for _, want := range ids {
+						if id == want {
+							items = append(items, item)
+							break
+						}
+					}
+				}
+			})
+			return items
+		}},
+		"local-name":    {0, 1, true, false, name},
+		"name":          {0, 1, true, false, name},
+		"namespace-uri": {0, 1, true, false, func(c *xpContext, args []xpValue) xpValue { return "" }},
+		"string": {0, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return xpString(contextArg(c, args))
+		}},
+		"concat": {2, -1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			buf := strings.Builder{}
+			for _, arg := range args {
+				buf.WriteString(xpString(arg))
+			}
+			return buf.String()
+		}},
+		"starts-with": {2, 2, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return strings.HasPrefix(xpString(args[0]), xpString(args[1]))
+		}},
+		"contains": {2, 2, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return strings.Contains(xpString(args[0]), xpString(args[1]))
+		}},
+		"substring-before": {2, 2, false, false, func(c *xpContext, args []xpValue) xpValue {
+			before, _, _ := strings.Cut(xpString(args[0]), xpString(args[1]))
+			return before
+		}},
+		"substring-after": {2, 2, false, false, func(c *xpContext, args []xpValue) xpValue {
+			_, after, _ := strings.Cut(xpString(args[0]), xpString(args[1]))
+			return after
+		}},
+		"substring": {2, 3, false, false, func(c *xpContext, args []xpValue) xpValue {
+			start := xpRound(xpNumber(args[1]))
+			end := math.Inf(1)
+			if len(args) > 2 {
+				end = start + xpRound(xpNumber(args[2]))
+			}
+			buf := strings.Builder{}
+			for i, r := range []rune(xpString(args[0])) {
+				if pos := float64(i + 1); pos >= start && pos < end {
+					buf.WriteRune(r)
+				}
+			}
+			return buf.String()
+		}},
+		"string-length": {0, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return float64(len([]rune(xpString(contextArg(c, args)))))
+		}},
+		"normalize-space": {0, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return strings.Join(strings.FieldsFunc(xpString(contextArg(c, args)), isSpaceR), " ")
+		}},
+		"translate": {3, 3, false, false, func(c *xpContext, args []xpValue) xpValue {
+			from, to := []rune(xpString(args[1])), []rune(xpString(args[2]))
+			return strings.Map(func(r rune) rune {
+				for i, f := range from {
+					if f != r {
+						continue
+					} else if i < len(to) {
+						return to[i]
+					}
+					return -1
+				}
+				return r
+			}, xpString(args[0]))
+		}},
+		"boolean": {1, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return xpBool(args[0])
+		}},
+		"not": {1, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return !xpBool(args[0])
+		}},
+		"true": {0, 0, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return true
+		}},
+		"false": {0, 0, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return false
+		}},
+		"number": {0, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return xpNumber(contextArg(c, args))
+		}},
+		"sum": {1, 1, true, false, func(c *xpContext, args []xpValue) xpValue {
+			sum := 0.0
+			for _, item := range args[0].([]xpItem) {
+				sum += xpNumber(item.String())
+			}
+			return sum
+		}},
+		"floor": {1, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return math.Floor(xpNumber(args[0]))
+		}},
+		"ceiling": {1, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return math.Ceil(xpNumber(args[0]))
+		}},
+		"round": {1, 1, false, false, func(c *xpContext, args []xpValue) xpValue {
+			return xpRound(xpNumber(args[0]))
+		}},
+	}
+}
+
+func xpRound(num float64) float64 {
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return num
+	}
+	return math.Floor(num + 0.5)
+}
+
+// Convert a value to a string, per the XPath string() function.
+func xpString(val xpValue) string {
+	switch val := val.(type) {
+	case []xpItem:
+		if len(val) == 0 {
+			return ""
+		}
+		return val[0].String()
+	case string:
+		return val
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		switch {
+		case math.IsNaN(val):
+			return "NaN"
+		case math.IsInf(val, 1):
+			return "Infinity"
+		case math.IsInf(val, -1):
+			return "-Infinity"
+		case val == 0:
+			return "0"
+		}
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	}
+	return ""
+}
+
+// Convert a value to a number, per the XPath number() function.
+func xpNumber(val xpValue) float64 {
+	switch val := val.(type) {
+	case float64:
+		return val
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	}
+
+	str := strings.TrimFunc(xpString(val), isSpaceR)
+	digits := strings.TrimPrefix(str, "-")
+	if digits == "" || digits == "." || strings.Trim(digits, "0123456789.") != "" || strings.Count(digits, ".") > 1 {
+		return math.NaN()
+	}
+	num, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return math.NaN()
+	}
+	return num
+}
+
+// Convert a value to a boolean, per the XPath boolean() function.
+func xpBool(val xpValue) bool {
+	switch val := val.(type) {
+	case []xpItem:
+		return len(val) > 0
+	case string:
+		return val != ""
+	case float64:
+		return val != 0 && !math.IsNaN(val)
+	case bool:
+		return val
+	}
+	return false
+}
+
+// Compare values; node-sets compare true if any of their nodes do.
+func xpCompare(op string, l xpValue, r xpValue) bool {
+	lItems, lIsSet := l.([]xpItem)
+	rItems, rIsSet := r.([]xpItem)
+
+	if _, ok := r.(bool); ok && lIsSet {
+		return xpCompare(op, xpBool(l), r)
+	} else if _, ok := l.(bool); ok && rIsSet {
+		return xpCompare(op, l, xpBool(r))
+	} else if lIsSet {
+		for _, item := range lItems {
+			if xpCompare(op, item.String(), r) {
+				return true
+			}
+		}
+		return false
+	} else if rIsSet {
+		for _, item := range rItems {
+			if xpCompare(op, l, item.String()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if op == "=" || op == "!=" {
+		var equal bool
+		_, lBool := l.(bool)
+		_, rBool := r.(bool)
+		_, lNum := l.(float64)
+		_, rNum := r.(float64)
+		if lBool || rBool {
+			equal = xpBool(l) == xpBool(r)
+		} else if lNum || rNum {
+			equal = xpNumber(l) == xpNumber(r)
+		} else {
+			equal = xpString(l) == xpString(r)
+		}
+		return equal == (op == "=")
+	}
+
+	lNum, rNum := xpNumber(l), xpNumber(r)
+	switch op {
+	case "<":
+		return lNum < rNum
+	case "<=":
+		return lNum <= rNum
+	case ">":
+		return lNum > rNum
+	case ">=":
+		return lNum >= rNum
+	}
+	return false
+}
+
+type xpTokenKind int
+
+const (
+	xpEOF xpTokenKind = iota
+	xpName
+	xpNumberTok
+	xpLiteralTok
+	xpOp // punctuation and operators
+)
+
+type xpToken struct {
+	kind xpTokenKind
+	val  string
+	pos  int
+}
+
+// Operators and punctuation, longest first.
+var xpOps = []string{"//", "::", "..", "!=", "<=", ">=", "/", "(", ")", "[", "]", ".", "@", ",", "|", "+", "-", "=", "<", ">", "*", "$"}
+
+func isXPathNameChar(c byte) bool {
+	return isIdentChar(c) || c == '.'
+}
+
+func lexXPath(text string) ([]xpToken, error) {
+	tokens := make([]xpToken, 0, 16)
+
+	for pos := 0; pos < len(text); {
+		c := text[pos]
+		start := pos
+
+		switch {
+		case isSpace(c):
+			pos++
+			continue
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(text[pos+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("error parsing XPath %q at offset %d: %w: unterminated string", text, pos, XPathErr)
+			}
+			pos += end + 2
+			tokens = append(tokens, xpToken{xpLiteralTok, text[start+1 : pos-1], start})
+			continue
+		case '0' <= c && c <= '9' || (c == '.' && pos+1 < len(text) && '0' <= text[pos+1] && text[pos+1] <= '9'):
+			for pos < len(text) && ('0' <= text[pos] && text[pos] <= '9' || text[pos] == '.') {
+				pos++
+			}
+			tokens = append(tokens, xpToken{xpNumberTok, text[start:pos], start})
+			continue
+		case isIdentChar(c) && !('0' <= c && c <= '9') && c != '-':
+			for pos < len(text) {
+				if isXPathNameChar(text[pos]) {
+					pos++
+				} else if text[pos] == ':' && pos+1 < len(text) && text[pos+1] != ':' {
+					// prefixed name
+					pos++
+				} else {
+					break
+				}
+			}
+			tokens = append(tokens, xpToken{xpName, text[start:pos], start})
+			continue
+		}
+
+		found := false
+		for _, op := range xpOps {
+			if strings.HasPrefix(text[pos:], op) {
+				tokens = append(tokens, xpToken{xpOp, op, start})
+				pos += len(op)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("error parsing XPath %q at offset %d: %w: unexpected %q", text, pos, XPathErr, c)
+		}
+	}
+
+	tokens = append(tokens, xpToken{xpEOF, "", len(text)})
+	return tokens, nil
+}
+
+type xpParser struct {
+	text   string
+	tokens []xpToken
+	pos    int
+}
+
+func (p *xpParser) errorf(tok xpToken, format string, args ...any) error {
+	return fmt.Errorf("error parsing XPath %q at offset %d: %w: %s", p.text, tok.pos, XPathErr, fmt.Sprintf(format, args...))
+}
+
+func (p *xpParser) peek() xpToken {
+	return p.tokens[p.pos]
+}
+
+func (p *xpParser) peekN(n int) xpToken {
+	if p.pos+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.pos+n]
+}
+
+func (p *xpParser) next() xpToken {
+	tok := p.tokens[p.pos]
+	if tok.kind != xpEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// Consume the next token if it is the operator or operator name op.
+func (p *xpParser) accept(op string) bool {
+	if tok := p.peek(); (tok.kind == xpOp || tok.kind == xpName) && tok.val == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *xpParser) expect(op string) error {
+	if !p.accept(op) {
+		tok := p.peek()
+		return p.errorf(tok, "expected %q, found %q", op, tok.val)
+	}
+	return nil
+}
+
+func (p *xpParser) parseExpr() (xpExpr, error) {
+	return p.parseBinary(0)
+}
+
+// Binary operators by precedence, lowest first.
+var xpBinaryOps = [][]string{
+	{"or"},
+	{"and"},
+	{"=", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "div", "mod"},
+}
+
+func (p *xpParser) parseBinary(level int) (xpExpr, error) {
+	if level == len(xpBinaryOps) {
+		return p.parseUnary()
+	}
+
+	l, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := ""
+		for _, candidate := range xpBinaryOps[level] {
+			if p.accept(candidate) {
+				op = candidate
+				break
+			}
+		}
+		if op == "" {
+			return l, nil
+		}
+
+		r, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = &xpBinary{op, l, r}
+	}
+}
+
+func (p *xpParser) parseUnary() (xpExpr, error) {
+	if p.accept("-") {
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &xpNeg{x}, nil
+	}
+
+	l, err := p.parsePathExpr()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == xpOp && p.peek().val == "|" {
+		tok := p.next()
+		r, err := p.parsePathExpr()
+		if err != nil {
+			return nil, err
+		} else if !l.nodes() || !r.nodes() {
+			return nil, p.errorf(tok, "union of non-node-sets")
+		}
+		l = &xpUnion{l, r}
+	}
+	return l, nil
+}
+
+var xpNodeTypes = map[string]bool{
+	"node":                   true,
+	"text":                   true,
+	"comment":                true,
+	"processing-instruction": true,
+}
+
+func (p *xpParser) parsePathExpr() (xpExpr, error) {
+	tok := p.peek()
+	isFilter := tok.kind == xpLiteralTok || tok.kind == xpNumberTok ||
+		(tok.kind == xpOp && (tok.val == "(" || tok.val == "$")) ||
+		(tok.kind == xpName && !xpNodeTypes[tok.val] && p.peekN(1).val == "(")
+	if !isFilter {
+		return p.parseLocationPath(nil)
+	}
+
+	filter, err := p.parseFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind == xpOp && (next.val == "/" || next.val == "//") {
+		if !filter.nodes() {
+			return nil, p.errorf(next, "path from non-node-set")
+		}
+		return p.parseLocationPath(filter)
+	}
+	return filter, nil
+}
+
+func (p *xpParser) parseFilter() (xpExpr, error) {
+	primary, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().val != "[" || p.peek().kind != xpOp {
+		return primary, nil
+	} else if !primary.nodes() {
+		return nil, p.errorf(p.peek(), "predicate on non-node-set")
+	}
+
+	preds, err := p.parsePredicates()
+	if err != nil {
+		return nil, err
+	}
+	return &xpFilter{primary, preds}, nil
+}
+
+func (p *xpParser) parsePrimary() (xpExpr, error) {
+	tok := p.next()
+	switch tok.kind {
+	case xpLiteralTok:
+		return &xpLiteral{tok.val}, nil
+	case xpNumberTok:
+		num, err := strconv.ParseFloat(tok.val, 64)
+		if err != nil {
+			return nil, p.errorf(tok, "invalid number %q", tok.val)
+		}
+		return &xpLiteral{num}, nil
+	case xpName:
+		return p.parseCall(tok)
+	}
+
+	switch tok.val {
+	case "(":
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		} else if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	case "$":
+		return nil, p.errorf(tok, "variables are not supported")
+	}
+	return nil, p.errorf(tok, "unexpected %q", tok.val)
+}
+
+func (p *xpParser) parseCall(name xpToken) (xpExpr, error) {
+	fn, ok := xpFuncs[name.val]
+	if !ok {
+		return nil, p.errorf(name, "unsupported function %q", name.val)
+	}
+	p.next() // '('
+
+	args := make([]xpExpr, 0, 2)
+	if !p.accept(")") {
+		for {
+			arg, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.accept(")") {
+				break
+			} else if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, p.errorf(name, "wrong number of arguments to %s()", name.val)
+	} else if fn.nodeArg && len(args) > 0 && !args[0].nodes() {
+		return nil, p.errorf(name, "%s() requires a node-set", name.val)
+	}
+	return &xpCall{fn, args}, nil
+}
+
+// Parse a location path, or the rest of a path after filter.
+func (p *xpParser) parseLocationPath(filter xpExpr) (xpExpr, error) {
+	path := &xpPath{filter: filter}
+	descendants := xpStep{axis: xpDescendantOrSelf, test: "node()"}
+
+	if filter == nil {
+		if p.accept("/") {
+			path.abs = true
+			if !p.startsStep() {
+				return path, nil
+			}
+		} else if p.accept("//") {
+			path.abs = true
+			path.steps = append(path.steps, descendants)
+		}
+	} else if p.next().val == "//" {
+		path.steps = append(path.steps, descendants)
+	}
+
+	for {
+		step, err := p.parseStep()
+		if err != nil {
+			return nil, err
+		}
+		path.steps = append(path.steps, step)
+
+		if p.accept("//") {
+			path.steps = append(path.steps, descendants)
+		} else if !p.accept("/") {
+			return path, nil
+		}
+	}
+}
+
+// Whether the next token starts a location step.
+func (p *xpParser) startsStep() bool {
+	tok := p.peek()
+	switch tok.kind {
+	case xpName:
+		return true
+	case xpOp:
+		return tok.val == "." || tok.val == ".." || tok.val == "@" || tok.val == "*"
+	}
+	return false
+}
+
+func (p *xpParser) parseStep() (xpStep, error) {
+	step := xpStep{axis: xpChild}
+	if p.accept(".") {
+		return xpStep{axis: xpSelf, test: "node()"}, nil
+	} else if p.accept("..") {
+		return xpStep{axis: xpParent, test: "node()"}, nil
+	}
+
+	if p.accept("@") {
+		step.axis = xpAttribute
+	} else if tok := p.peek(); tok.kind == xpName && p.peekN(1).val == "::" {
+		axis, ok := xpAxes[tok.val]
+		if !ok {
+			return step, p.errorf(tok, "unsupported axis %q", tok.val)
+		}
+		step.axis = axis
+		p.pos += 2
+	}
+
+	tok := p.next()
+	switch {
+	case tok.kind == xpOp && tok.val == "*":
+		step.test = "*"
+	case tok.kind == xpName && xpNodeTypes[tok.val] && p.peek().val == "(":
+		p.next()
+		step.test = tok.val + "()"
+		if tok.val == "processing-instruction" && p.peek().kind == xpLiteralTok {
+			step.test = "processing-instruction:" + p.next().val
+		}
+		if err := p.expect(")"); err != nil {
+			return step, err
+		}
+	case tok.kind == xpName:
+		step.test = tok.val
+	default:
+		return step, p.errorf(tok, "expected node test, found %q", tok.val)
+	}
+
+	preds, err := p.parsePredicates()
+	if err != nil {
+		return step, err
+	}
+	step.preds = preds
+	return step, nil
+}
+
+func (p *xpParser) parsePredicates() ([]xpExpr, error) {
+	var preds []xpExpr
+	for p.accept("[") {
+		pred, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		} else if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		preds = append(preds, pred)
+	}
+	return preds, nil
+}
+
+// Target of a processing instruction with the given content, e.g. "php" for
+// "php echo 1;".
+func piTarget(content string) string {
+	content = strings.TrimLeftFunc(content, isSpaceR)
+	if i := strings.IndexFunc(content, isSpaceR); i >= 0 {
+		return content[:i]
+	}
+	return content
+}
@@ -0,0 +1,72 @@
+package gohtml
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestXPath(t *testing.T) {
+	src := `<html><body><div id=main><a href="/one">One</a><p>text <a href="/two">Two</a></p></div>` +
+		`<div class=side><a href="/three">Three</a><!--note--></div></body></html>`
+	doc, err, _ := Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"//div[@id='main']//a/@href", []string{"/one", "/two"}},
+		{"//a", []string{"One", "Two", "Three"}},
+		{"//A/@HREF", []string{"/one", "/two", "/three"}},
+		{"//div[2]/a/text()", []string{"Three"}},
+		{"//a[last()]", []string{"One", "Two", "Three"}},
+		{"(//a)[last()]", []string{"Three"}},
+		{"//p/a/ancestor::div/@id", []string{"main"}},
+		{"//a[.='Two']/preceding::a", []string{"One"}},
+		{"//a[@href='/one']/following-sibling::*/a", []string{"Two"}},
+		{"//div[@class='side']/comment()", []string{"note"}},
+		{"//a[contains(@href, 't')] | //p", []string{"text Two", "Two", "Three"}},
+		{"count(//a)", []string{"3"}},
+		{"string-length(//p)", []string{"8"}},
+		{"concat(name(//div[1]), '-', //div[1]/@id)", []string{"div-main"}},
+		{"count(//a) > 2 and not(//table)", []string{"true"}},
+		{"sum(//div/@id) = 0", []string{"false"}},
+		{"10 div 4 - -1 mod 3", []string{"3.5"}},
+		{"translate(normalize-space('  a  b '), 'ab', 'AB')", []string{"A B"}},
+		{"substring-before(//a[1]/@href, 'ne')", []string{"/o"}},
+		{"//missing", []string{}},
+	}
+
+	for _, test := range tests {
+		res, err := doc.XPath(test.expr)
+		if err != nil {
+			t.Errorf("XPath(%q): %v", test.expr, err)
+			continue
+		}
+		if !slices.Equal(res.Values, test.want) {
+			t.Errorf("XPath(%q) = %q, want %q", test.expr, res.Values, test.want)
+		}
+	}
+
+	xp, err := CompileXPath("a/@href")
+	if err != nil {
+		t.Fatal(err)
+	}
+	div := doc.FindByID("main")
+	if res := xp.Eval(div); len(res.Nodes) != 1 || res.Nodes[0] != div.Children[0] {
+		t.Errorf("Eval(%q) relative to #main = %v, want its first <a>", xp, res.Nodes)
+	}
+}
+
+func TestXPathInvalid(t *testing.T) {
+	tests := []string{"", "//", "//a[", "//a]", "foo(", "//a/@", "$var", "'unterminated", "1 +"}
+
+	for _, expr := range tests {
+		if _, err := CompileXPath(expr); !errors.Is(err, XPathErr) {
+			t.Errorf("CompileXPath(%q) = %v, want %v", expr, err, XPathErr)
+		}
+	}
+}